@@ -210,7 +210,8 @@ func Run() {
 		bt.TransformUncle,
 		bt.TransformWithdrawals,
 		bt.TransformEnsNameRegistered,
-		bt.TransformContract)
+		bt.TransformContract,
+		bt.TransformSafe)
 
 	cache := freecache.NewCache(100 * 1024 * 1024) // 100 MB limit
 
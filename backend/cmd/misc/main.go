@@ -1500,7 +1500,7 @@ func indexOldEth1Blocks(startBlock uint64, endBlock uint64, batchSize uint64, co
 	log.Infof("transformerFlag: %v", transformerFlag)
 	transformerList := strings.Split(transformerFlag, ",")
 	if transformerFlag == "all" {
-		transformerList = []string{"TransformBlock", "TransformTx", "TransformBlobTx", "TransformItx", "TransformERC20", "TransformERC721", "TransformERC1155", "TransformWithdrawals", "TransformUncle", "TransformEnsNameRegistered", "TransformContract"}
+		transformerList = []string{"TransformBlock", "TransformTx", "TransformBlobTx", "TransformItx", "TransformERC20", "TransformERC721", "TransformERC1155", "TransformWithdrawals", "TransformUncle", "TransformEnsNameRegistered", "TransformContract", "TransformSafe"}
 	} else if len(transformerList) == 0 {
 		log.Error(nil, "no transformer functions provided", 0)
 		return
@@ -1535,6 +1535,8 @@ func indexOldEth1Blocks(startBlock uint64, endBlock uint64, batchSize uint64, co
 			importENSChanges = true
 		case "TransformContract":
 			transforms = append(transforms, bt.TransformContract)
+		case "TransformSafe":
+			transforms = append(transforms, bt.TransformSafe)
 		default:
 			log.Error(nil, "Invalid transformer flag %v", 0)
 			return
@@ -0,0 +1,827 @@
+package dataaccess
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AccountDashboardRepository interface {
+	GetAccountDashboardUser(ctx context.Context, dashboardId t.ADBIdPrimary) (*t.DashboardUser, error)
+	GetAccountDashboardIdByPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBIdPrimary, error)
+	GetUserAccountDashboardCount(ctx context.Context, userId uint64) (uint64, error)
+	CreateAccountDashboard(ctx context.Context, userId uint64, name string) (*t.ADBPostReturnData, error)
+	RemoveAccountDashboard(ctx context.Context, dashboardId t.ADBIdPrimary) error
+	GetAccountDashboardOverview(ctx context.Context, dashboardId t.ADBId) (*t.ADBOverviewData, error)
+
+	CreateAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, name string) (*t.ADBPostCreateGroupData, error)
+	RemoveAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) error
+	GetAccountDashboardGroupCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error)
+	GetAccountDashboardGroupExists(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) (bool, error)
+
+	GetAccountDashboardAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error)
+	GetAccountDashboardExistingAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) (uint64, error)
+	AddAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64, addresses []string) ([]t.ADBPostAccountsData, error)
+	UpdateAccountDashboardAccount(ctx context.Context, dashboardId t.ADBIdPrimary, address string, groupId uint64) (*t.ADBManageAccountsTableRow, error)
+	RemoveAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) error
+	GetAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, colSort t.Sort[enums.ADBManageAccountsColumn], search string, limit uint64) ([]t.ADBManageAccountsTableRow, *t.Paging, error)
+
+	CreateAccountDashboardPublicId(ctx context.Context, dashboardId t.ADBIdPrimary, name string, shareGroups bool) (*t.ADBPublicId, error)
+	GetAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBPublicId, error)
+	UpdateAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic, name string, shareGroups bool) (*t.ADBPublicId, error)
+	RemoveAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) error
+	GetAccountDashboardPublicIdCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error)
+
+	GetAccountDashboardTransactions(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, limit uint64) ([]t.ADBTransactionTableRow, *t.Paging, error)
+	UpdateAccountDashboardTransactionsSettings(ctx context.Context, dashboardId t.ADBIdPrimary, settings t.ADBTransactionsSettings) (*t.ADBTransactionsSettings, error)
+}
+
+// settings used if the user has not changed them yet
+var defaultADBTransactionsSettings = t.ADBTransactionsSettings{
+	ShowTokenTransfers:          true,
+	HideZeroValueTokenTransfers: false,
+}
+
+// the transactions settings are stored in the user_settings column of users_acc_dashboards
+type adbUserSettings struct {
+	Transactions t.ADBTransactionsSettings `json:"transactions"`
+}
+
+func decodeAccountAddresses(addresses []string) ([][]byte, error) {
+	result := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		decoded, err := hexutil.Decode("0x" + strings.TrimPrefix(strings.ToLower(address), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding address %s: %w", address, err)
+		}
+		result = append(result, decoded)
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetAccountDashboardUser(ctx context.Context, dashboardId t.ADBIdPrimary) (*t.DashboardUser, error) {
+	result := &t.DashboardUser{}
+
+	err := d.alloyReader.GetContext(ctx, result, `
+		SELECT
+			id,
+			user_id
+		FROM users_acc_dashboards
+		WHERE id = $1
+	`, dashboardId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId)
+	}
+	return result, err
+}
+
+func (d *DataAccessService) GetAccountDashboardIdByPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBIdPrimary, error) {
+	var result t.ADBIdPrimary
+
+	err := d.alloyReader.GetContext(ctx, &result, `
+		SELECT dashboard_id
+		FROM users_acc_dashboards_sharing
+		WHERE public_id = $1
+	`, publicDashboardId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: public id %v not found", ErrNotFound, publicDashboardId)
+	}
+	return &result, err
+}
+
+func (d *DataAccessService) GetUserAccountDashboardCount(ctx context.Context, userId uint64) (uint64, error) {
+	var count uint64
+	err := d.alloyReader.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM users_acc_dashboards WHERE user_id = $1
+	`, userId)
+	return count, err
+}
+
+func (d *DataAccessService) CreateAccountDashboard(ctx context.Context, userId uint64, name string) (*t.ADBPostReturnData, error) {
+	result := &t.ADBPostReturnData{}
+
+	tx, err := d.alloyWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting db transactions to create an account dashboard: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	// Create account dashboard for user
+	err = tx.GetContext(ctx, result, `
+		INSERT INTO users_acc_dashboards (user_id, name)
+			VALUES ($1, $2)
+		RETURNING id, user_id, name, (EXTRACT(epoch FROM created_at))::BIGINT as created_at
+	`, userId, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a default group for the new dashboard
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO users_acc_dashboards_groups (id, dashboard_id, name)
+			VALUES ($1, $2, $3)
+	`, t.DefaultGroupId, result.Id, t.DefaultGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("error committing tx to create an account dashboard: %w", err)
+	}
+
+	return result, nil
+}
+
+func (d *DataAccessService) RemoveAccountDashboard(ctx context.Context, dashboardId t.ADBIdPrimary) error {
+	_, err := d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_acc_dashboards WHERE id = $1
+	`, dashboardId)
+	return err
+}
+
+func (d *DataAccessService) getAccountDashboardTransactionsSettings(ctx context.Context, dashboardId t.ADBIdPrimary) (*t.ADBTransactionsSettings, error) {
+	var userSettings []byte
+	err := d.alloyReader.GetContext(ctx, &userSettings, `
+		SELECT COALESCE(user_settings, '{}'::jsonb) FROM users_acc_dashboards WHERE id = $1
+	`, dashboardId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// missing settings keep their default value
+	settings := adbUserSettings{Transactions: defaultADBTransactionsSettings}
+	if err := json.Unmarshal(userSettings, &settings); err != nil {
+		return nil, fmt.Errorf("error parsing user settings of account dashboard %v: %w", dashboardId, err)
+	}
+	return &settings.Transactions, nil
+}
+
+func (d *DataAccessService) GetAccountDashboardOverview(ctx context.Context, dashboardId t.ADBId) (*t.ADBOverviewData, error) {
+	data := t.ADBOverviewData{}
+	eg := errgroup.Group{}
+
+	eg.Go(func() error {
+		return d.alloyReader.GetContext(ctx, &data.Name, `
+			SELECT name FROM users_acc_dashboards WHERE id = $1
+		`, dashboardId.Id)
+	})
+
+	eg.Go(func() error {
+		var queryResult []struct {
+			Id    uint64 `db:"id"`
+			Name  string `db:"name"`
+			Count uint64 `db:"count"`
+		}
+		err := d.alloyReader.SelectContext(ctx, &queryResult, `
+			SELECT groups.id, groups.name, COUNT(accounts.address)
+			FROM users_acc_dashboards_groups groups
+			LEFT JOIN users_acc_dashboards_accounts accounts ON groups.dashboard_id = accounts.dashboard_id AND groups.id = accounts.group_id
+			WHERE groups.dashboard_id = $1
+			GROUP BY groups.id, groups.name
+			ORDER BY groups.id
+		`, dashboardId.Id)
+		if err != nil {
+			return fmt.Errorf("error retrieving account dashboard groups: %w", err)
+		}
+		for _, res := range queryResult {
+			data.AccountCount += res.Count
+			data.Groups = append(data.Groups, t.ADBOverviewGroup{Id: res.Id, Name: res.Name, Count: res.Count})
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		var publicIds []struct {
+			PublicId     string `db:"public_id"`
+			Name         string `db:"name"`
+			SharedGroups bool   `db:"shared_groups"`
+		}
+		err := d.alloyReader.SelectContext(ctx, &publicIds, `
+			SELECT public_id, name, shared_groups
+			FROM users_acc_dashboards_sharing
+			WHERE dashboard_id = $1
+		`, dashboardId.Id)
+		if err != nil {
+			return fmt.Errorf("error retrieving account dashboard public ids: %w", err)
+		}
+		for _, row := range publicIds {
+			publicId := t.ADBPublicId{PublicId: row.PublicId, Name: row.Name}
+			publicId.ShareSettings.ShareGroups = row.SharedGroups
+			data.PublicIds = append(data.PublicIds, publicId)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		settings, err := d.getAccountDashboardTransactionsSettings(ctx, dashboardId.Id)
+		if err != nil {
+			return err
+		}
+		data.TransactionsSettings = *settings
+		return nil
+	})
+
+	err := eg.Wait()
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dashboardId.AggregateGroups {
+		data.Groups = []t.ADBOverviewGroup{{Id: t.DefaultGroupId, Name: t.DefaultGroupName, Count: data.AccountCount}}
+	}
+
+	return &data, nil
+}
+
+func (d *DataAccessService) CreateAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, name string) (*t.ADBPostCreateGroupData, error) {
+	result := &t.ADBPostCreateGroupData{}
+
+	// Create a new group that has the smallest unique id possible
+	err := d.alloyWriter.GetContext(ctx, result, `
+		WITH NextAvailableId AS (
+		    SELECT COALESCE(MIN(uadg1.id) + 1, 0) AS next_id
+		    FROM users_acc_dashboards_groups uadg1
+		    LEFT JOIN users_acc_dashboards_groups uadg2 ON uadg1.id + 1 = uadg2.id AND uadg1.dashboard_id = uadg2.dashboard_id
+		    WHERE uadg1.dashboard_id = $1 AND uadg2.id IS NULL
+		)
+		INSERT INTO users_acc_dashboards_groups (id, dashboard_id, name)
+			SELECT next_id, $1, $2
+		FROM NextAvailableId
+		RETURNING id, name
+	`, dashboardId, name)
+
+	return result, err
+}
+
+func (d *DataAccessService) RemoveAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) error {
+	// Delete the group, its accounts are removed by the foreign key constraint
+	_, err := d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_acc_dashboards_groups WHERE dashboard_id = $1 AND id = $2
+	`, dashboardId, groupId)
+	return err
+}
+
+func (d *DataAccessService) GetAccountDashboardGroupCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	var count uint64
+	err := d.alloyReader.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM users_acc_dashboards_groups WHERE dashboard_id = $1
+	`, dashboardId)
+	return count, err
+}
+
+func (d *DataAccessService) GetAccountDashboardGroupExists(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) (bool, error) {
+	groupExists := false
+	err := d.alloyReader.GetContext(ctx, &groupExists, `
+		SELECT EXISTS(
+			SELECT
+				dashboard_id,
+				id
+			FROM users_acc_dashboards_groups
+			WHERE dashboard_id = $1 AND id = $2
+		)
+	`, dashboardId, groupId)
+	return groupExists, err
+}
+
+func (d *DataAccessService) GetAccountDashboardAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	var count uint64
+	err := d.alloyReader.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM users_acc_dashboards_accounts WHERE dashboard_id = $1
+	`, dashboardId)
+	return count, err
+}
+
+// return how many of the passed addresses are already in the dashboard
+func (d *DataAccessService) GetAccountDashboardExistingAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) (uint64, error) {
+	if len(addresses) == 0 {
+		return 0, nil
+	}
+	decodedAddresses, err := decodeAccountAddresses(addresses)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	err = d.alloyReader.GetContext(ctx, &count, `
+		SELECT COUNT(*)
+		FROM users_acc_dashboards_accounts
+		WHERE dashboard_id = $1 AND address = ANY($2)
+	`, dashboardId, pq.ByteaArray(decodedAddresses))
+	return count, err
+}
+
+// adds the accounts to the group, accounts that are already part of the dashboard are moved to the group
+func (d *DataAccessService) AddAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64, addresses []string) ([]t.ADBPostAccountsData, error) {
+	if len(addresses) == 0 {
+		return []t.ADBPostAccountsData{}, nil
+	}
+	decodedAddresses, err := decodeAccountAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var insertedAddresses [][]byte
+	err = d.alloyWriter.SelectContext(ctx, &insertedAddresses, `
+		INSERT INTO users_acc_dashboards_accounts (dashboard_id, group_id, address)
+			SELECT $1, $2, address FROM (SELECT DISTINCT UNNEST($3::BYTEA[]) AS address) addresses
+		ON CONFLICT (dashboard_id, address) DO UPDATE SET group_id = EXCLUDED.group_id
+		RETURNING address
+	`, dashboardId, groupId, pq.ByteaArray(decodedAddresses))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.ADBPostAccountsData, 0, len(insertedAddresses))
+	for _, address := range insertedAddresses {
+		result = append(result, t.ADBPostAccountsData{
+			Address: hexutil.Encode(address),
+			GroupId: groupId,
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) UpdateAccountDashboardAccount(ctx context.Context, dashboardId t.ADBIdPrimary, address string, groupId uint64) (*t.ADBManageAccountsTableRow, error) {
+	decodedAddresses, err := decodeAccountAddresses([]string{address})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_acc_dashboards_accounts SET group_id = $1 WHERE dashboard_id = $2 AND address = $3
+	`, groupId, dashboardId, decodedAddresses[0])
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("%w: account %s not found", ErrNotFound, address)
+	}
+
+	return &t.ADBManageAccountsTableRow{
+		Address: t.Address{Hash: t.Hash(hexutil.Encode(decodedAddresses[0]))},
+		GroupId: groupId,
+	}, nil
+}
+
+func (d *DataAccessService) RemoveAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	decodedAddresses, err := decodeAccountAddresses(addresses)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_acc_dashboards_accounts WHERE dashboard_id = $1 AND address = ANY($2)
+	`, dashboardId, pq.ByteaArray(decodedAddresses))
+	return err
+}
+
+type adbAccount struct {
+	Address []byte `db:"address"`
+	GroupId uint64 `db:"group_id"`
+}
+
+// returns the accounts of the dashboard, groups are replaced by the default group if the dashboard doesn't share them
+func (d *DataAccessService) getAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBId, groupId int64) ([]adbAccount, error) {
+	if dashboardId.AggregateGroups && groupId == t.DefaultGroupId {
+		groupId = t.AllGroups
+	}
+
+	var accounts []adbAccount
+	err := d.alloyReader.SelectContext(ctx, &accounts, `
+		SELECT address, group_id
+		FROM users_acc_dashboards_accounts
+		WHERE dashboard_id = $1 AND ($2 = -1 OR group_id = $2)
+	`, dashboardId.Id, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if dashboardId.AggregateGroups {
+		for i := range accounts {
+			accounts[i].GroupId = t.DefaultGroupId
+		}
+	}
+	return accounts, nil
+}
+
+func (d *DataAccessService) GetAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, colSort t.Sort[enums.ADBManageAccountsColumn], search string, limit uint64) ([]t.ADBManageAccountsTableRow, *t.Paging, error) {
+	var currentCursor t.ADBAccountsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.ADBAccountsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as ADBAccountsCursor: %w", err)
+		}
+	}
+
+	accounts, err := d.getAccountDashboardAccounts(ctx, dashboardId, groupId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search = strings.ToLower(strings.TrimPrefix(search, "0x"))
+	data := make([]t.ADBManageAccountsTableRow, 0, len(accounts))
+	for _, account := range accounts {
+		address := hexutil.Encode(account.Address)
+		if search != "" && !strings.HasPrefix(address[2:], search) {
+			continue
+		}
+		data = append(data, t.ADBManageAccountsTableRow{
+			Address: t.Address{Hash: t.Hash(address)},
+			GroupId: account.GroupId,
+		})
+	}
+
+	// Sort the data, the address is used as tie breaker
+	sortDesc := colSort.Desc != currentCursor.IsReverse()
+	slices.SortFunc(data, func(a, b t.ADBManageAccountsTableRow) int {
+		result := 0
+		if colSort.Column == enums.ADBManageAccountsColumns.Group {
+			result = cmp.Compare(a.GroupId, b.GroupId)
+		}
+		if result == 0 {
+			result = cmp.Compare(a.Address.Hash, b.Address.Hash)
+		}
+		if sortDesc {
+			return -result
+		}
+		return result
+	})
+
+	result, paging, err := getSortedDataPage(data, currentCursor, func(row t.ADBManageAccountsTableRow) bool {
+		return row.Address.Hash == currentCursor.Address.Hash
+	}, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string, len(result))
+	for _, row := range result {
+		ensMapping[string(row.Address.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].Address.Ens = ensMapping[string(result[i].Address.Hash)]
+	}
+
+	return result, paging, nil
+}
+
+func (d *DataAccessService) CreateAccountDashboardPublicId(ctx context.Context, dashboardId t.ADBIdPrimary, name string, shareGroups bool) (*t.ADBPublicId, error) {
+	dbReturn := struct {
+		PublicId     string `db:"public_id"`
+		Name         string `db:"name"`
+		SharedGroups bool   `db:"shared_groups"`
+	}{}
+
+	err := d.alloyWriter.GetContext(ctx, &dbReturn, `
+		INSERT INTO users_acc_dashboards_sharing (dashboard_id, name, shared_groups, tx_notes_shared)
+			VALUES ($1, $2, $3, false)
+		RETURNING public_id, name, shared_groups
+	`, dashboardId, name, shareGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &t.ADBPublicId{}
+	result.PublicId = dbReturn.PublicId
+	result.Name = dbReturn.Name
+	result.ShareSettings.ShareGroups = dbReturn.SharedGroups
+
+	return result, nil
+}
+
+func (d *DataAccessService) GetAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBPublicId, error) {
+	dbReturn := struct {
+		PublicId     string `db:"public_id"`
+		DashboardId  int    `db:"dashboard_id"`
+		Name         string `db:"name"`
+		SharedGroups bool   `db:"shared_groups"`
+	}{}
+
+	err := d.alloyReader.GetContext(ctx, &dbReturn, `
+		SELECT public_id, dashboard_id, name, shared_groups
+		FROM users_acc_dashboards_sharing
+		WHERE public_id = $1
+	`, publicDashboardId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: public dashboard id %v not found", ErrNotFound, publicDashboardId)
+		}
+		return nil, err
+	}
+
+	result := &t.ADBPublicId{}
+	result.DashboardId = dbReturn.DashboardId
+	result.PublicId = dbReturn.PublicId
+	result.Name = dbReturn.Name
+	result.ShareSettings.ShareGroups = dbReturn.SharedGroups
+
+	return result, nil
+}
+
+func (d *DataAccessService) UpdateAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic, name string, shareGroups bool) (*t.ADBPublicId, error) {
+	dbReturn := struct {
+		PublicId     string `db:"public_id"`
+		Name         string `db:"name"`
+		SharedGroups bool   `db:"shared_groups"`
+	}{}
+
+	err := d.alloyWriter.GetContext(ctx, &dbReturn, `
+		UPDATE users_acc_dashboards_sharing SET
+			name = $1,
+			shared_groups = $2
+		WHERE public_id = $3
+		RETURNING public_id, name, shared_groups
+	`, name, shareGroups, publicDashboardId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: public dashboard id %v not found", ErrNotFound, publicDashboardId)
+		}
+		return nil, err
+	}
+
+	result := &t.ADBPublicId{}
+	result.PublicId = dbReturn.PublicId
+	result.Name = dbReturn.Name
+	result.ShareSettings.ShareGroups = dbReturn.SharedGroups
+
+	return result, nil
+}
+
+func (d *DataAccessService) RemoveAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) error {
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_acc_dashboards_sharing WHERE public_id = $1
+	`, publicDashboardId)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: public dashboard id %v not found", ErrNotFound, publicDashboardId)
+	}
+
+	return nil
+}
+
+func (d *DataAccessService) GetAccountDashboardPublicIdCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	var count uint64
+	err := d.alloyReader.GetContext(ctx, &count, `
+		SELECT COUNT(*)
+		FROM users_acc_dashboards_sharing
+		WHERE dashboard_id = $1
+	`, dashboardId)
+	return count, err
+}
+
+// adbTransaction is a transactions feed row and the key used to sort, deduplicate and page it
+type adbTransaction struct {
+	t.ADBTransactionTableRow
+	key string
+}
+
+// getAccountTransactions reads the transactions and token transfers of an account from the bigtable address indexes,
+// starting at the given timestamp (inclusive) and going back in time
+func (d *DataAccessService) getAccountTransactions(address []byte, startTs *timestamppb.Timestamp, limit int64, settings *t.ADBTransactionsSettings) ([]adbTransaction, error) {
+	txs, _, err := d.bigtable.GetEth1TxsForAddress(d.bigtable.GetAddressTimeIndexPrefix("TX", address, startTs), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving transactions of address %#x: %w", address, err)
+	}
+	result := make([]adbTransaction, 0, len(txs))
+	for _, tx := range txs {
+		interaction := types.CONTRACT_NONE
+		if tx.IsContractCreation {
+			interaction = types.CONTRACT_CREATION
+		} else if len(tx.MethodId) > 0 {
+			interaction = types.CONTRACT_PRESENT
+		}
+		txFee := new(big.Int).SetBytes(tx.TxFee)
+		txFee.Add(txFee, new(big.Int).SetBytes(tx.BlobTxFee))
+		result = append(result, adbTransaction{
+			ADBTransactionTableRow: t.ADBTransactionTableRow{
+				Success:   tx.ErrorMsg == "",
+				TxHash:    t.Hash(hexutil.Encode(tx.Hash)),
+				Method:    d.bigtable.GetMethodLabel(tx.MethodId, interaction),
+				Block:     tx.BlockNumber,
+				Timestamp: tx.Time.AsTime().Unix(),
+				Type:      "transaction",
+				From:      t.Address{Hash: t.Hash(hexutil.Encode(tx.From))},
+				To:        t.Address{Hash: t.Hash(hexutil.Encode(tx.To))},
+				Value:     decimal.NewFromBigInt(new(big.Int).SetBytes(tx.Value), 0),
+				TxFee:     decimal.NewFromBigInt(txFee, 0),
+			},
+			key: fmt.Sprintf("tx:%x", tx.Hash),
+		})
+	}
+
+	if !settings.ShowTokenTransfers {
+		return result, nil
+	}
+	transfers, _, err := d.bigtable.GetEth1ERC20ForAddress(d.bigtable.GetAddressTimeIndexPrefix("ERC20", address, startTs), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving token transfers of address %#x: %w", address, err)
+	}
+	for _, transfer := range transfers {
+		value := new(big.Int).SetBytes(transfer.Value)
+		if settings.HideZeroValueTokenTransfers && value.Sign() == 0 {
+			continue
+		}
+		token := t.Address{Hash: t.Hash(hexutil.Encode(transfer.TokenAddress))}
+		result = append(result, adbTransaction{
+			ADBTransactionTableRow: t.ADBTransactionTableRow{
+				Success:   true,
+				TxHash:    t.Hash(hexutil.Encode(transfer.ParentHash)),
+				Method:    "Transfer",
+				Block:     transfer.BlockNumber,
+				Timestamp: transfer.Time.AsTime().Unix(),
+				Type:      "erc20",
+				From:      t.Address{Hash: t.Hash(hexutil.Encode(transfer.From))},
+				To:        t.Address{Hash: t.Hash(hexutil.Encode(transfer.To))},
+				Token:     &token,
+				Value:     decimal.NewFromBigInt(value, 0),
+				TxFee:     decimal.Zero,
+			},
+			key: fmt.Sprintf("erc20:%x:%x:%x:%x:%x", transfer.ParentHash, transfer.TokenAddress, transfer.From, transfer.To, transfer.Value),
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetAccountDashboardTransactions(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, limit uint64) ([]t.ADBTransactionTableRow, *t.Paging, error) {
+	var currentCursor t.ADBTransactionsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.ADBTransactionsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as ADBTransactionsCursor: %w", err)
+		}
+		if currentCursor.IsReverse() {
+			return nil, nil, fmt.Errorf("transactions can only be paged forward")
+		}
+	}
+
+	accounts, err := d.getAccountDashboardAccounts(ctx, dashboardId, groupId)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings, err := d.getAccountDashboardTransactionsSettings(ctx, dashboardId.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(accounts) == 0 {
+		return []t.ADBTransactionTableRow{}, &t.Paging{}, nil
+	}
+
+	var startTs *timestamppb.Timestamp
+	if currentCursor.IsValid() {
+		startTs = timestamppb.New(time.Unix(currentCursor.Timestamp, 0))
+	}
+
+	// every account contributes at most limit+1 rows after the cursor, which is enough to fill the page and detect more data
+	accountTransactions := make([][]adbTransaction, len(accounts))
+	eg := errgroup.Group{}
+	eg.SetLimit(10)
+	for i, account := range accounts {
+		eg.Go(func() error {
+			var err error
+			accountTransactions[i], err = d.getAccountTransactions(account.Address, startTs, int64(limit)+1, settings)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	accountGroups := make(map[t.Hash]uint64, len(accounts))
+	for _, account := range accounts {
+		accountGroups[t.Hash(hexutil.Encode(account.Address))] = account.GroupId
+	}
+
+	// merge the transactions of all accounts, transactions between accounts of the dashboard are listed once
+	seen := make(map[string]bool)
+	var data []adbTransaction
+	for _, transactions := range accountTransactions {
+		for _, tx := range transactions {
+			if seen[tx.key] {
+				continue
+			}
+			seen[tx.key] = true
+			if currentCursor.IsValid() && (tx.Timestamp > currentCursor.Timestamp || tx.Timestamp == currentCursor.Timestamp && tx.key <= currentCursor.Key) {
+				continue
+			}
+
+			fromGroup, isFromAccount := accountGroups[tx.From.Hash]
+			toGroup, isToAccount := accountGroups[tx.To.Hash]
+			switch {
+			case isFromAccount && isToAccount:
+				tx.Direction = "self"
+				tx.GroupId = fromGroup
+			case isFromAccount:
+				tx.Direction = "out"
+				tx.GroupId = fromGroup
+			case isToAccount:
+				tx.Direction = "in"
+				tx.GroupId = toGroup
+			default:
+				continue
+			}
+			data = append(data, tx)
+		}
+	}
+
+	slices.SortFunc(data, func(a, b adbTransaction) int {
+		if a.Timestamp != b.Timestamp {
+			return -cmp.Compare(a.Timestamp, b.Timestamp)
+		}
+		return cmp.Compare(a.key, b.key)
+	})
+
+	paging := &t.Paging{}
+	if uint64(len(data)) > limit {
+		data = data[:limit]
+		last := data[len(data)-1]
+		paging.NextCursor, err = utils.CursorToString(t.ADBTransactionsCursor{Timestamp: last.Timestamp, Key: last.key})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create next cursor: %w", err)
+		}
+	}
+
+	ensMapping := make(map[string]string)
+	for _, tx := range data {
+		ensMapping[string(tx.From.Hash)] = ""
+		ensMapping[string(tx.To.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.ADBTransactionTableRow, 0, len(data))
+	for _, tx := range data {
+		tx.From.Ens = ensMapping[string(tx.From.Hash)]
+		tx.To.Ens = ensMapping[string(tx.To.Hash)]
+		result = append(result, tx.ADBTransactionTableRow)
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) UpdateAccountDashboardTransactionsSettings(ctx context.Context, dashboardId t.ADBIdPrimary, settings t.ADBTransactionsSettings) (*t.ADBTransactionsSettings, error) {
+	settingsJson, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_acc_dashboards SET user_settings = jsonb_set(COALESCE(user_settings, '{}'::jsonb), '{transactions}', $1::jsonb)
+		WHERE id = $2
+	`, settingsJson, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId)
+	}
+
+	return &settings, nil
+}
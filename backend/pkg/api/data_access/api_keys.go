@@ -0,0 +1,250 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+type ApiKeyRepository interface {
+	GetApiKeyInfo(ctx context.Context, apiKey string) (*t.ApiKeyInfo, error)
+	GetApiKeys(ctx context.Context, userId uint64) ([]t.ApiKey, error)
+	CreateApiKey(ctx context.Context, userId uint64, name, scope string, allowedIps []string, expiresAt *time.Time) (*t.ApiKey, error)
+	RotateApiKey(ctx context.Context, userId, keyId uint64) (*t.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userId, keyId uint64) error
+	GetApiKeyUsage(ctx context.Context, userId, keyId uint64, since time.Time) (*t.ApiKeyUsage, error)
+}
+
+// keys without expiry are stored with this valid_until, see api_keys table definition
+var apiKeyNoExpiry = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+type apiKey struct {
+	Id         uint64         `db:"id"`
+	Name       string         `db:"name"`
+	ApiKey     string         `db:"api_key"`
+	Scope      string         `db:"scope"`
+	AllowedIps pq.StringArray `db:"allowed_ips"`
+	ValidUntil time.Time      `db:"valid_until"`
+	CreatedTs  time.Time      `db:"created_ts"`
+}
+
+func (key apiKey) toApiType() t.ApiKey {
+	result := t.ApiKey{
+		Id:         key.Id,
+		Name:       key.Name,
+		Key:        key.ApiKey,
+		Scope:      key.Scope,
+		AllowedIps: key.AllowedIps,
+		CreatedAt:  key.CreatedTs.Unix(),
+	}
+	if key.ValidUntil.Before(apiKeyNoExpiry) {
+		expiresAt := key.ValidUntil.Unix()
+		result.ExpiresAt = &expiresAt
+	}
+	return result
+}
+
+const apiKeyColumns = `id, name, api_key, scope, allowed_ips, valid_until, created_ts`
+
+// GetApiKeyInfo returns the restrictions of a key, revoked and expired keys are not found
+func (d *DataAccessService) GetApiKeyInfo(ctx context.Context, apiKey string) (*t.ApiKeyInfo, error) {
+	var row struct {
+		UserId     uint64         `db:"user_id"`
+		Scope      string         `db:"scope"`
+		AllowedIps pq.StringArray `db:"allowed_ips"`
+	}
+	err := d.userReader.GetContext(ctx, &row, `
+		SELECT user_id, scope, allowed_ips
+		FROM api_keys
+		WHERE api_key = $1 AND revoked = false AND valid_until > NOW()
+		LIMIT 1`, apiKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: user for api_key not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.ApiKeyInfo{
+		UserId:     row.UserId,
+		Scope:      row.Scope,
+		AllowedIps: row.AllowedIps,
+	}, nil
+}
+
+func (d *DataAccessService) GetApiKeys(ctx context.Context, userId uint64) ([]t.ApiKey, error) {
+	var keys []apiKey
+	err := d.userReader.SelectContext(ctx, &keys, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE user_id = $1 AND revoked = false
+		ORDER BY id`, userId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key.toApiType())
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) CreateApiKey(ctx context.Context, userId uint64, name, scope string, allowedIps []string, expiresAt *time.Time) (*t.ApiKey, error) {
+	newKey, err := utils.GenerateRandomAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	validUntil := apiKeyNoExpiry
+	if expiresAt != nil {
+		validUntil = expiresAt.UTC()
+	}
+
+	var key apiKey
+	err = d.userWriter.GetContext(ctx, &key, `
+		INSERT INTO api_keys (user_id, api_key, name, scope, allowed_ips, valid_until, changed_at, created_ts)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING `+apiKeyColumns,
+		userId, newKey, name, scope, pq.Array(allowedIps), validUntil)
+	if err != nil {
+		return nil, err
+	}
+	result := key.toApiType()
+	return &result, nil
+}
+
+// RotateApiKey replaces the key with a new one that has the same settings, the old key stops working immediately.
+// Usage statistics are carried over to the new key.
+func (d *DataAccessService) RotateApiKey(ctx context.Context, userId, keyId uint64) (*t.ApiKey, error) {
+	newKey, err := utils.GenerateRandomAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting db transactions to rotate api key: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	// read the settings before revoking, the replacement has to keep the original expiry
+	var oldKey apiKey
+	err = tx.GetContext(ctx, &oldKey, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE id = $1 AND user_id = $2 AND revoked = false
+		FOR UPDATE`, keyId, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: api key %d not found", ErrNotFound, keyId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE api_keys SET revoked = true, valid_until = LEAST(valid_until, NOW()), changed_at = NOW() WHERE id = $1`, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	var key apiKey
+	err = tx.GetContext(ctx, &key, `
+		INSERT INTO api_keys (user_id, api_key, name, scope, allowed_ips, valid_until, changed_at, created_ts)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING `+apiKeyColumns,
+		userId, newKey, oldKey.Name, oldKey.Scope, pq.Array(oldKey.AllowedIps), oldKey.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE api_statistics SET apikey = $1 WHERE apikey = $2`, newKey, oldKey.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	// keep the legacy key column in sync, it is still used by v1
+	_, err = tx.ExecContext(ctx, `UPDATE users SET api_key = $1 WHERE id = $2 AND api_key = $3`, newKey, userId, oldKey.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	result := key.toApiType()
+	return &result, nil
+}
+
+func (d *DataAccessService) RevokeApiKey(ctx context.Context, userId, keyId uint64) error {
+	// valid_until is updated as well so the ratelimiter drops the key
+	result, err := d.userWriter.ExecContext(ctx, `
+		UPDATE api_keys SET revoked = true, valid_until = LEAST(valid_until, NOW()), changed_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked = false`, keyId, userId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: api key %d not found", ErrNotFound, keyId)
+	}
+	return nil
+}
+
+func (d *DataAccessService) GetApiKeyUsage(ctx context.Context, userId, keyId uint64, since time.Time) (*t.ApiKeyUsage, error) {
+	var key string
+	err := d.userReader.GetContext(ctx, &key, `SELECT api_key FROM api_keys WHERE id = $1 AND user_id = $2 AND revoked = false`, keyId, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: api key %d not found", ErrNotFound, keyId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := t.ApiKeyUsage{
+		Days:      []t.ApiKeyUsageDay{},
+		Endpoints: []t.ApiKeyEndpointUsage{},
+	}
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var days []struct {
+			Day      time.Time `db:"day"`
+			Requests uint64    `db:"requests"`
+		}
+		err := d.userReader.SelectContext(ctx, &days, `
+			SELECT DATE_TRUNC('day', ts) AS day, SUM(count) AS requests
+			FROM api_statistics
+			WHERE apikey = $1 AND ts >= $2
+			GROUP BY day
+			ORDER BY day`, key, since.UTC())
+		if err != nil {
+			return fmt.Errorf("error retrieving daily api key usage: %w", err)
+		}
+		for _, day := range days {
+			result.Days = append(result.Days, t.ApiKeyUsageDay{Timestamp: day.Day.Unix(), Requests: day.Requests})
+			result.TotalRequests += day.Requests
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.userReader.SelectContext(ctx, &result.Endpoints, `
+			SELECT endpoint, SUM(count) AS requests
+			FROM api_statistics
+			WHERE apikey = $1 AND ts >= $2
+			GROUP BY endpoint
+			ORDER BY requests DESC, endpoint`, key, since.UTC())
+		if err != nil {
+			return fmt.Errorf("error retrieving api key usage by endpoint: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -1,11 +1,30 @@
 package dataaccess
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethMath "github.com/ethereum/go-ethereum/common/math"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
 )
 
+// size of a blob in bytes (4096 field elements of 32 bytes each)
+const blobSize = 131072
+
 type BlockRepository interface {
 	GetBlock(ctx context.Context, chainId, block uint64) (*t.BlockSummary, error)
 	GetBlockOverview(ctx context.Context, chainId, block uint64) (*t.BlockOverview, error)
@@ -26,121 +45,1231 @@ type BlockRepository interface {
 	GetSlotBlsChanges(ctx context.Context, chainId, block uint64) ([]t.BlockBlsChangeTableRow, error)
 	GetSlotVoluntaryExits(ctx context.Context, chainId, block uint64) ([]t.BlockVoluntaryExitTableRow, error)
 	GetSlotBlobs(ctx context.Context, chainId, block uint64) ([]t.BlockBlobTableRow, error)
+
+	GetSlots(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.SlotTableRow, *t.Paging, error)
+	GetBlocks(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTableRow, *t.Paging, error)
+
+	// startSlot, endSlot (inclusive) and committeeIndex filter by the attested slot and committee and are optional
+	GetNetworkAggregatedAttestations(ctx context.Context, chainId uint64, startSlot, endSlot, committeeIndex *uint64, cursor string, limit uint64) ([]t.NetworkAggregatedAttestationTableRow, *t.Paging, error)
+}
+
+// blockData is the consensus layer row of a canonical block as stored in the blocks table
+type blockData struct {
+	Epoch                      uint64         `db:"epoch"`
+	Slot                       uint64         `db:"slot"`
+	BlockRoot                  []byte         `db:"blockroot"`
+	ParentRoot                 []byte         `db:"parentroot"`
+	StateRoot                  []byte         `db:"stateroot"`
+	Signature                  []byte         `db:"signature"`
+	RandaoReveal               []byte         `db:"randaoreveal"`
+	GraffitiText               sql.NullString `db:"graffiti_text"`
+	Eth1DataDepositRoot        []byte         `db:"eth1data_depositroot"`
+	Eth1DataDepositCount       uint64         `db:"eth1data_depositcount"`
+	Eth1DataBlockHash          []byte         `db:"eth1data_blockhash"`
+	SyncAggregateBits          []byte         `db:"syncaggregate_bits"`
+	SyncAggregateSignature     []byte         `db:"syncaggregate_signature"`
+	SyncAggregateParticipation float64        `db:"syncaggregate_participation"`
+	ProposerSlashingsCount     uint64         `db:"proposerslashingscount"`
+	AttesterSlashingsCount     uint64         `db:"attesterslashingscount"`
+	AttestationsCount          uint64         `db:"attestationscount"`
+	DepositsCount              uint64         `db:"depositscount"`
+	WithdrawalCount            uint64         `db:"withdrawalcount"`
+	VoluntaryExitsCount        uint64         `db:"voluntaryexitscount"`
+	Proposer                   uint64         `db:"proposer"`
+	ExecParentHash             []byte         `db:"exec_parent_hash"`
+	ExecFeeRecipient           []byte         `db:"exec_fee_recipient"`
+	ExecBlockNumber            sql.NullInt64  `db:"exec_block_number"` // not set for slots without execution payload
+	ExecGasLimit               sql.NullInt64  `db:"exec_gas_limit"`
+	ExecGasUsed                sql.NullInt64  `db:"exec_gas_used"`
+	ExecTimestamp              sql.NullInt64  `db:"exec_timestamp"`
+	ExecBaseFeePerGas          sql.NullInt64  `db:"exec_base_fee_per_gas"`
+	ExecBlockHash              []byte         `db:"exec_block_hash"`
+	ExecTransactionsCount      uint64         `db:"exec_transactions_count"`
+	ExecBlobTransactionsCount  uint64         `db:"exec_blob_transactions_count"`
+	Votes                      uint64         `db:"votes"`
+	VotingValidators           uint64         `db:"voting_validators"`
+	BlsChangesCount            uint64         `db:"bls_changes_count"`
+	BlobsCount                 uint64         `db:"blobs_count"`
+}
+
+// blockDataQuery selects the blockData of the canonical blocks matching the where clause
+const blockDataQuery = `
+		SELECT
+			b.epoch,
+			b.slot,
+			b.blockroot,
+			b.parentroot,
+			b.stateroot,
+			b.signature,
+			b.randaoreveal,
+			b.graffiti_text,
+			b.eth1data_depositroot,
+			b.eth1data_depositcount,
+			b.eth1data_blockhash,
+			b.syncaggregate_bits,
+			b.syncaggregate_signature,
+			b.syncaggregate_participation,
+			b.proposerslashingscount,
+			b.attesterslashingscount,
+			b.attestationscount,
+			b.depositscount,
+			b.withdrawalcount,
+			b.voluntaryexitscount,
+			b.proposer,
+			b.exec_parent_hash,
+			b.exec_fee_recipient,
+			b.exec_block_number,
+			b.exec_gas_limit,
+			b.exec_gas_used,
+			b.exec_timestamp,
+			b.exec_base_fee_per_gas,
+			b.exec_block_hash,
+			b.exec_transactions_count,
+			b.exec_blob_transactions_count,
+			COALESCE(votes.votes, 0) AS votes,
+			COALESCE(votes.voting_validators, 0) AS voting_validators,
+			(SELECT COUNT(*) FROM blocks_bls_change WHERE block_slot = b.slot AND block_root = b.blockroot) AS bls_changes_count,
+			(SELECT COUNT(*) FROM blocks_blob_sidecars WHERE block_root = b.blockroot) AS blobs_count
+		FROM blocks b
+		LEFT JOIN LATERAL (
+			SELECT
+				COUNT(v) AS votes,
+				COUNT(DISTINCT v) AS voting_validators
+			FROM blocks_attestations ba, UNNEST(ba.validators) AS v
+			WHERE ba.block_slot = b.slot AND ba.block_root = b.blockroot
+		) votes ON TRUE
+		WHERE %s AND b.status = '1'
+		LIMIT 1`
+
+// getBlockData returns the canonical consensus layer block carrying the given execution block.
+// Returns nil (without error) for existing blocks that predate the merge, as they have no consensus layer data.
+func (d *DataAccessService) getBlockData(ctx context.Context, block uint64) (*blockData, error) {
+	var data blockData
+	err := d.readerDb.GetContext(ctx, &data, fmt.Sprintf(blockDataQuery, "b.exec_block_number = $1"), block)
+	if err == nil {
+		return &data, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// no consensus layer block found, either the block predates the merge or it doesn't exist (yet)
+	latestBlock, err := d.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	if block > latestBlock {
+		return nil, fmt.Errorf("%w: block %d", ErrNotFound, block)
+	}
+	return nil, nil
+}
+
+// getSlotData returns the canonical consensus layer block proposed at the given slot
+func (d *DataAccessService) getSlotData(ctx context.Context, slot uint64) (*blockData, error) {
+	var data blockData
+	err := d.readerDb.GetContext(ctx, &data, fmt.Sprintf(blockDataQuery, "b.slot = $1"), slot)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no block at slot %d", ErrNotFound, slot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// getExecutionBlock returns the execution layer block from bigtable
+func (d *DataAccessService) getExecutionBlock(block uint64) (*types.Eth1Block, error) {
+	eth1Block, err := d.bigtable.GetBlockFromBlocksTable(block)
+	if err != nil {
+		if errors.Is(err, db.ErrBlockNotFound) {
+			return nil, fmt.Errorf("%w: block %d", ErrNotFound, block)
+		}
+		return nil, err
+	}
+	return eth1Block, nil
+}
+
+// getEffectiveGasPrice returns the gas price paid by the transaction and the part of it that goes to the proposer
+func getEffectiveGasPrice(tx *types.Eth1Transaction, baseFee *big.Int) (*big.Int, *big.Int) {
+	gasPrice := new(big.Int).SetBytes(tx.GasPrice)
+	if baseFee.Sign() == 0 {
+		// pre EIP-1559, the whole fee goes to the miner
+		return gasPrice, gasPrice
+	}
+	effectiveGasPrice := gethMath.BigMin(new(big.Int).Add(new(big.Int).SetBytes(tx.MaxPriorityFeePerGas), baseFee), new(big.Int).SetBytes(tx.MaxFeePerGas))
+	if tx.Type == 0 || tx.Type == 1 {
+		// legacy and access list transactions pay the gas price as is
+		effectiveGasPrice = gasPrice
+	}
+	proposerGasPrice := new(big.Int).Sub(effectiveGasPrice, baseFee)
+	if proposerGasPrice.Sign() < 0 {
+		proposerGasPrice = big.NewInt(0)
+	}
+	return effectiveGasPrice, proposerGasPrice
 }
 
 func (d *DataAccessService) GetBlock(ctx context.Context, chainId, block uint64) (*t.BlockSummary, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlock(ctx, chainId, block)
+	data, err := d.getBlockData(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		// pre-merge block, only transactions are available
+		eth1Block, err := d.getExecutionBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		return &t.BlockSummary{Transactions: uint64(len(eth1Block.Transactions))}, nil
+	}
+	return data.toSummary(), nil
+}
+
+func (d *DataAccessService) GetSlot(ctx context.Context, chainId, slot uint64) (*t.BlockSummary, error) {
+	data, err := d.getSlotData(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return data.toSummary(), nil
+}
+
+func (data *blockData) toSummary() *t.BlockSummary {
+	return &t.BlockSummary{
+		Transactions:   data.ExecTransactionsCount,
+		Votes:          data.Votes,
+		Attestations:   data.AttestationsCount,
+		Withdrawals:    data.WithdrawalCount,
+		BlsChanges:     data.BlsChangesCount,
+		VoluntaryExits: data.VoluntaryExitsCount,
+		Blobs:          data.BlobsCount,
+	}
 }
 
 func (d *DataAccessService) GetBlockOverview(ctx context.Context, chainId, block uint64) (*t.BlockOverview, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockOverview(ctx, chainId, block)
+	var data *blockData
+	var eth1Block *types.Eth1Block
+	var syncCommittee []uint64
+
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		data, err = d.getBlockData(ctx, block)
+		if err != nil || data == nil {
+			return err
+		}
+		syncCommittee, err = d.getSyncCommitteeOfBlock(ctx, data)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		eth1Block, err = d.getExecutionBlockIfIndexed(block)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	if data == nil && eth1Block == nil {
+		return nil, fmt.Errorf("%w: block %d", ErrNotFound, block)
+	}
+	return d.toBlockOverview(block, data, eth1Block, syncCommittee)
 }
 
-func (d *DataAccessService) GetBlockTransactions(ctx context.Context, chainId, block uint64) ([]t.BlockTransactionTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockTransactions(ctx, chainId, block)
+func (d *DataAccessService) GetSlotOverview(ctx context.Context, chainId, slot uint64) (*t.BlockOverview, error) {
+	data, err := d.getSlotData(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	var eth1Block *types.Eth1Block
+	var syncCommittee []uint64
+
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		syncCommittee, err = d.getSyncCommitteeOfBlock(ctx, data)
+		return err
+	})
+	if data.ExecBlockNumber.Valid {
+		wg.Go(func() error {
+			var err error
+			eth1Block, err = d.getExecutionBlockIfIndexed(uint64(data.ExecBlockNumber.Int64))
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	return d.toBlockOverview(uint64(data.ExecBlockNumber.Int64), data, eth1Block, syncCommittee)
 }
 
-func (d *DataAccessService) GetBlockVotes(ctx context.Context, chainId, block uint64) ([]t.BlockVoteTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockVotes(ctx, chainId, block)
+// getSyncCommitteeOfBlock returns the sync committee members of the period of the block, nil for blocks without sync aggregate
+func (d *DataAccessService) getSyncCommitteeOfBlock(ctx context.Context, data *blockData) ([]uint64, error) {
+	if data.SyncAggregateBits == nil {
+		return nil, nil
+	}
+	var syncCommittee []uint64
+	period := utils.SyncPeriodOfEpoch(data.Epoch)
+	err := d.readerDb.SelectContext(ctx, &syncCommittee, `SELECT validatorindex FROM sync_committees WHERE period = $1 ORDER BY committeeindex`, period)
+	return syncCommittee, err
 }
 
-func (d *DataAccessService) GetBlockAttestations(ctx context.Context, chainId, block uint64) ([]t.BlockAttestationTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockAttestations(ctx, chainId, block)
+// getExecutionBlockIfIndexed returns the execution layer block from bigtable, nil if it is not indexed (yet)
+func (d *DataAccessService) getExecutionBlockIfIndexed(block uint64) (*types.Eth1Block, error) {
+	eth1Block, err := d.getExecutionBlock(block)
+	if errors.Is(err, ErrNotFound) {
+		// execution data might not be indexed yet, the consensus layer data is sufficient
+		return nil, nil
+	}
+	return eth1Block, err
 }
 
-func (d *DataAccessService) GetBlockWithdrawals(ctx context.Context, chainId, block uint64) ([]t.BlockWithdrawalTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockWithdrawals(ctx, chainId, block)
+// toBlockOverview combines the consensus and execution layer data of a block, either of them may be nil
+func (d *DataAccessService) toBlockOverview(block uint64, data *blockData, eth1Block *types.Eth1Block, syncCommittee []uint64) (*t.BlockOverview, error) {
+	result := t.BlockOverview{Block: block}
+
+	if eth1Block != nil {
+		result.Time = eth1Block.Time.AsTime().Unix()
+
+		baseFee := new(big.Int).SetBytes(eth1Block.BaseFee)
+		txFees := big.NewInt(0)
+		priorityFees := big.NewInt(0)
+		var lowestGasPrice *big.Int
+		internalTxCount := uint64(0)
+		blobTxCount := uint64(0)
+		for _, tx := range eth1Block.Transactions {
+			effectiveGasPrice, proposerGasPrice := getEffectiveGasPrice(tx, baseFee)
+			gasUsed := new(big.Int).SetUint64(tx.GasUsed)
+			txFees.Add(txFees, new(big.Int).Mul(effectiveGasPrice, gasUsed))
+			priorityFees.Add(priorityFees, new(big.Int).Mul(proposerGasPrice, gasUsed))
+			if lowestGasPrice == nil || effectiveGasPrice.Cmp(lowestGasPrice) < 0 {
+				lowestGasPrice = effectiveGasPrice
+			}
+			for _, itx := range tx.Itx {
+				if itx.Path == "[]" || bytes.Equal(itx.Value, []byte{0x0}) { // skip top level call & empty calls
+					continue
+				}
+				internalTxCount++
+			}
+			if tx.Type == 3 {
+				blobTxCount++
+			}
+		}
+		burnedFees := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(eth1Block.GasUsed))
+
+		result.Transactions = &struct {
+			General  uint64 `json:"general"`
+			Internal uint64 `json:"internal"`
+			Blob     uint64 `json:"blob,omitempty"`
+		}{
+			General:  uint64(len(eth1Block.Transactions)),
+			Internal: internalTxCount,
+			Blob:     blobTxCount,
+		}
+		priorityFeesDecimal := decimal.NewFromBigInt(priorityFees, 0)
+		result.PriorityFees = &priorityFeesDecimal
+
+		if data == nil {
+			// pre-merge block
+			miner := t.Address{Hash: t.Hash(hexutil.Encode(eth1Block.Coinbase))}
+			result.Miner = &miner
+			rewards := decimal.NewFromBigInt(new(big.Int).Add(utils.Eth1BlockReward(block, eth1Block.Difficulty), priorityFees), 0)
+			result.Rewards = &rewards
+			txFeesDecimal := decimal.NewFromBigInt(txFees, 0)
+			result.TxFees = &txFeesDecimal
+			gasUsage := decimal.NewFromInt(int64(eth1Block.GasUsed))
+			result.GasUsage = &gasUsage
+			gasLimit := struct {
+				Value   uint64  `json:"value"`
+				Percent float64 `json:"percent"`
+			}{Value: eth1Block.GasLimit}
+			if eth1Block.GasLimit > 0 {
+				gasLimit.Percent = float64(eth1Block.GasUsed) / float64(eth1Block.GasLimit) * 100
+			}
+			result.GasLimit = &gasLimit
+			if lowestGasPrice != nil {
+				lowestGasPriceDecimal := decimal.NewFromBigInt(lowestGasPrice, 0)
+				result.LowestGasPrice = &lowestGasPriceDecimal
+			}
+			difficulty := decimal.NewFromBigInt(new(big.Int).SetBytes(eth1Block.Difficulty), 0)
+			result.Difficulty = &difficulty
+			if baseFee.Sign() > 0 {
+				baseFeeDecimal := decimal.NewFromBigInt(baseFee, 0)
+				result.BaseFee = &baseFeeDecimal
+				burnedFeesDecimal := decimal.NewFromBigInt(burnedFees, 0)
+				result.BurnedFees = &burnedFeesDecimal
+			}
+			result.Extra = hexutil.Encode(eth1Block.Extra)
+			result.Hash = t.Hash(hexutil.Encode(eth1Block.Hash))
+			result.ParentHash = t.Hash(hexutil.Encode(eth1Block.ParentHash))
+		}
+	}
+
+	if data != nil {
+		if data.ExecTimestamp.Valid {
+			result.Time = data.ExecTimestamp.Int64
+		} else {
+			result.Time = utils.SlotToTime(data.Slot).Unix()
+		}
+		result.Epoch = data.Epoch
+		result.Slot = data.Slot
+		result.Proposer = data.Proposer
+		result.Status = &struct {
+			Proposal  string `json:"proposal" tstype:"'proposed' | 'orphaned' | 'missed' | 'scheduled'" faker:"oneof: proposed, orphaned, missed, scheduled"`
+			Finalized string `json:"finalized" tstype:"'finalized' | 'justified' | 'not_finalized'" faker:"oneof: finalized, justified, not_finalized"`
+		}{
+			Proposal:  "proposed",
+			Finalized: "not_finalized",
+		}
+		if data.Epoch <= cache.LatestFinalizedEpoch.Get() {
+			result.Status.Finalized = "finalized"
+		}
+		result.BlockRoot = t.Hash(hexutil.Encode(data.BlockRoot))
+		result.ParentRoot = t.Hash(hexutil.Encode(data.ParentRoot))
+
+		if data.ExecBlockNumber.Valid {
+			feeRecipient := t.Address{Hash: t.Hash(hexutil.Encode(data.ExecFeeRecipient))}
+			ensMapping := map[string]string{string(feeRecipient.Hash): ""}
+			if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+				return nil, err
+			}
+			feeRecipient.Ens = ensMapping[string(feeRecipient.Hash)]
+			result.ProposerRewardRecipient = &feeRecipient
+
+			baseFeePerGas := decimal.NewFromInt(data.ExecBaseFeePerGas.Int64)
+			result.ExecutionPayload = &t.BlockExecutionPayload{
+				BlockHash:             t.Hash(hexutil.Encode(data.ExecBlockHash)),
+				ParentHash:            t.Hash(hexutil.Encode(data.ExecParentHash)),
+				PriorityFeesRecipient: feeRecipient,
+				GasUsed:               uint64(data.ExecGasUsed.Int64),
+				GasLimit:              uint64(data.ExecGasLimit.Int64),
+				BaseFeePerGas:         baseFeePerGas,
+				BaseFees:              baseFeePerGas.Mul(decimal.NewFromInt(data.ExecGasUsed.Int64)),
+			}
+		}
+		if result.Transactions == nil && data.ExecBlockNumber.Valid {
+			result.Transactions = &struct {
+				General  uint64 `json:"general"`
+				Internal uint64 `json:"internal"`
+				Blob     uint64 `json:"blob,omitempty"`
+			}{
+				General: data.ExecTransactionsCount,
+				Blob:    data.ExecBlobTransactionsCount,
+			}
+		}
+
+		syncBits := make([]bool, 0, len(data.SyncAggregateBits)*8)
+		for i := 0; i < len(data.SyncAggregateBits)*8; i++ {
+			syncBits = append(syncBits, utils.BitAtVector(data.SyncAggregateBits, i))
+		}
+		result.ConsensusLayer = &t.BlockConsensusLayer{
+			StateRoot:         t.Hash(hexutil.Encode(data.StateRoot)),
+			Signature:         t.Hash(hexutil.Encode(data.Signature)),
+			RandaoReveal:      t.Hash(hexutil.Encode(data.RandaoReveal)),
+			Attestations:      data.AttestationsCount,
+			Votes:             data.Votes,
+			VotingValidators:  data.VotingValidators,
+			VoluntaryExits:    data.VoluntaryExitsCount,
+			AttesterSlashings: data.AttesterSlashingsCount,
+			ProposerSlashings: data.ProposerSlashingsCount,
+			Deposits:          data.DepositsCount,
+			SyncCommittee: t.BlockSyncCommittee{
+				Participation: data.SyncAggregateParticipation,
+				Bits:          syncBits,
+				SyncCommittee: syncCommittee,
+				Signature:     t.Hash(hexutil.Encode(data.SyncAggregateSignature)),
+			},
+			Eth1Data: t.BlockEth1Data{
+				BlockHash:    t.Hash(hexutil.Encode(data.Eth1DataBlockHash)),
+				DepositCount: data.Eth1DataDepositCount,
+				DepositRoot:  t.Hash(hexutil.Encode(data.Eth1DataDepositRoot)),
+			},
+			Graffiti: data.GraffitiText.String,
+		}
+	}
+
+	return &result, nil
 }
 
-func (d *DataAccessService) GetBlockBlsChanges(ctx context.Context, chainId, block uint64) ([]t.BlockBlsChangeTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockBlsChanges(ctx, chainId, block)
+func (d *DataAccessService) GetBlockTransactions(ctx context.Context, chainId, block uint64) ([]t.BlockTransactionTableRow, error) {
+	eth1Block, err := d.getExecutionBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	contractInteractions, err := d.bigtable.GetAddressContractInteractionsAtBlock(eth1Block)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee := new(big.Int).SetBytes(eth1Block.BaseFee)
+	ensMapping := make(map[string]string)
+	result := make([]t.BlockTransactionTableRow, 0, len(eth1Block.Transactions))
+	for i, tx := range eth1Block.Transactions {
+		effectiveGasPrice, _ := getEffectiveGasPrice(tx, baseFee)
+		from := hexutil.Encode(tx.From)
+		to := hexutil.Encode(tx.To)
+		if len(tx.To) == 0 {
+			to = hexutil.Encode(tx.ContractAddress)
+		}
+		ensMapping[from] = ""
+		ensMapping[to] = ""
+
+		txType := "out"
+		if contractInteractions[i] != types.CONTRACT_NONE {
+			txType = "contract"
+		} else if from == to {
+			txType = "self"
+		}
+
+		result = append(result, t.BlockTransactionTableRow{
+			Success:  tx.Status == 1,
+			TxHash:   t.Hash(hexutil.Encode(tx.Hash)),
+			Method:   d.bigtable.GetMethodLabel(tx.Data, contractInteractions[i]),
+			Block:    block,
+			Age:      uint64(eth1Block.Time.AsTime().Unix()),
+			From:     t.Address{Hash: t.Hash(from)},
+			Type:     txType,
+			To:       t.Address{Hash: t.Hash(to)},
+			Value:    decimal.NewFromBigInt(new(big.Int).SetBytes(tx.Value), 0),
+			GasPrice: decimal.NewFromBigInt(effectiveGasPrice, 0),
+			TxFee:    decimal.NewFromBigInt(new(big.Int).Mul(effectiveGasPrice, new(big.Int).SetUint64(tx.GasUsed)), 0),
+		})
+	}
+
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].From.Ens = ensMapping[string(result[i].From.Hash)]
+		result[i].To.Ens = ensMapping[string(result[i].To.Hash)]
+	}
+	return result, nil
 }
 
-func (d *DataAccessService) GetBlockVoluntaryExits(ctx context.Context, chainId, block uint64) ([]t.BlockVoluntaryExitTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockVoluntaryExits(ctx, chainId, block)
+type blockAttestation struct {
+	BlockSlot       uint64        `db:"block_slot"`
+	AggregationBits []byte        `db:"aggregationbits"`
+	Validators      pq.Int64Array `db:"validators"`
+	Signature       []byte        `db:"signature"`
+	Slot            uint64        `db:"slot"`
+	CommitteeIndex  uint64        `db:"committeeindex"`
+	BeaconBlockRoot []byte        `db:"beaconblockroot"`
+	SourceEpoch     uint64        `db:"source_epoch"`
+	SourceRoot      []byte        `db:"source_root"`
+	TargetEpoch     uint64        `db:"target_epoch"`
+	TargetRoot      []byte        `db:"target_root"`
 }
 
-func (d *DataAccessService) GetBlockBlobs(ctx context.Context, chainId, block uint64) ([]t.BlockBlobTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockBlobs(ctx, chainId, block)
+func (d *DataAccessService) getBlockAttestations(ctx context.Context, data *blockData) ([]blockAttestation, error) {
+	var attestations []blockAttestation
+	err := d.readerDb.SelectContext(ctx, &attestations, `
+		SELECT
+			block_slot,
+			aggregationbits,
+			validators,
+			signature,
+			slot,
+			committeeindex,
+			beaconblockroot,
+			source_epoch,
+			source_root,
+			target_epoch,
+			target_root
+		FROM blocks_attestations
+		WHERE block_slot = $1 AND block_root = $2
+		ORDER BY block_index`, data.Slot, data.BlockRoot)
+	return attestations, err
 }
 
-func (d *DataAccessService) GetSlot(ctx context.Context, chainId, slot uint64) (*t.BlockSummary, error) {
-	block, err := d.GetBlockHeightAt(slot)
+func (d *DataAccessService) GetBlockVotes(ctx context.Context, chainId, block uint64) ([]t.BlockVoteTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return d.getBlockVotes(ctx, data)
+}
+
+func (d *DataAccessService) GetSlotVotes(ctx context.Context, chainId, slot uint64) ([]t.BlockVoteTableRow, error) {
+	data, err := d.getSlotData(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlock(ctx, chainId, block)
+	return d.getBlockVotes(ctx, data)
 }
 
-func (d *DataAccessService) GetSlotOverview(ctx context.Context, chainId, slot uint64) (*t.BlockOverview, error) {
-	block, err := d.GetBlockHeightAt(slot)
+func (d *DataAccessService) getBlockVotes(ctx context.Context, data *blockData) ([]t.BlockVoteTableRow, error) {
+	attestations, err := d.getBlockAttestations(ctx, data)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockOverview(ctx, chainId, block)
+	result := make([]t.BlockVoteTableRow, 0, len(attestations))
+	for _, attestation := range attestations {
+		validators := make([]uint64, 0, len(attestation.Validators))
+		for _, validator := range attestation.Validators {
+			validators = append(validators, uint64(validator))
+		}
+		result = append(result, t.BlockVoteTableRow{
+			AllocatedSlot:   attestation.Slot,
+			Committee:       attestation.CommitteeIndex,
+			IncludedInBlock: attestation.BlockSlot,
+			Validators:      validators,
+		})
+	}
+	return result, nil
 }
 
-func (d *DataAccessService) GetSlotTransactions(ctx context.Context, chainId, slot uint64) ([]t.BlockTransactionTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+func (a blockAttestation) toTableRow() t.BlockAttestationTableRow {
+	aggregationBits := bitfield.Bitlist(a.AggregationBits)
+	bits := make([]bool, 0, aggregationBits.Len())
+	for i := uint64(0); i < aggregationBits.Len(); i++ {
+		bits = append(bits, aggregationBits.BitAt(i))
+	}
+	validators := make([]uint64, 0, len(a.Validators))
+	for _, validator := range a.Validators {
+		validators = append(validators, uint64(validator))
+	}
+	return t.BlockAttestationTableRow{
+		Slot:            a.Slot,
+		CommitteeIndex:  a.CommitteeIndex,
+		AggregationBits: bits,
+		Validators:      validators,
+		BeaconBlockRoot: t.Hash(hexutil.Encode(a.BeaconBlockRoot)),
+		Source: t.EpochInfo{
+			Epoch:     a.SourceEpoch,
+			BlockRoot: t.Hash(hexutil.Encode(a.SourceRoot)),
+		},
+		Target: t.EpochInfo{
+			Epoch:     a.TargetEpoch,
+			BlockRoot: t.Hash(hexutil.Encode(a.TargetRoot)),
+		},
+		Signature: t.Hash(hexutil.Encode(a.Signature)),
+	}
+}
+
+func (d *DataAccessService) GetBlockAttestations(ctx context.Context, chainId, block uint64) ([]t.BlockAttestationTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return d.getBlockAttestationRows(ctx, data)
+}
+
+func (d *DataAccessService) GetSlotAttestations(ctx context.Context, chainId, slot uint64) ([]t.BlockAttestationTableRow, error) {
+	data, err := d.getSlotData(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockTransactions(ctx, chainId, block)
+	return d.getBlockAttestationRows(ctx, data)
 }
 
-func (d *DataAccessService) GetSlotVotes(ctx context.Context, chainId, slot uint64) ([]t.BlockVoteTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+func (d *DataAccessService) getBlockAttestationRows(ctx context.Context, data *blockData) ([]t.BlockAttestationTableRow, error) {
+	attestations, err := d.getBlockAttestations(ctx, data)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockVotes(ctx, chainId, block)
+	result := make([]t.BlockAttestationTableRow, 0, len(attestations))
+	for _, attestation := range attestations {
+		result = append(result, attestation.toTableRow())
+	}
+	return result, nil
 }
 
-func (d *DataAccessService) GetSlotAttestations(ctx context.Context, chainId, slot uint64) ([]t.BlockAttestationTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+// GetNetworkAggregatedAttestations returns the attestations included in canonical blocks, newest inclusions first
+func (d *DataAccessService) GetNetworkAggregatedAttestations(ctx context.Context, chainId uint64, startSlot, endSlot, committeeIndex *uint64, cursor string, limit uint64) ([]t.NetworkAggregatedAttestationTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	result := make([]t.NetworkAggregatedAttestationTableRow, 0)
+	var paging t.Paging
+
+	var currentCursor t.BlockOperationsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.BlockOperationsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as BlockOperationsCursor: %w", err)
+		}
+	}
+
+	sortSearchDirection := "<"
+	sortSearchOrder := " DESC"
+	if currentCursor.IsReverse() {
+		sortSearchDirection = ">"
+		sortSearchOrder = " ASC"
+	}
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE b.status = '1'"
+	if startSlot != nil {
+		// attestations can only be included after the attested slot, the primary key range keeps the query cheap
+		queryParams = append(queryParams, *startSlot)
+		whereQuery += fmt.Sprintf(" AND ba.slot >= $%[1]d AND ba.block_slot >= $%[1]d", len(queryParams))
+	}
+	if endSlot != nil {
+		queryParams = append(queryParams, *endSlot)
+		whereQuery += fmt.Sprintf(" AND ba.slot <= $%d", len(queryParams))
+		// attestations may be included until the end of the next epoch
+		queryParams = append(queryParams, *endSlot+2*utils.Config.Chain.ClConfig.SlotsPerEpoch)
+		whereQuery += fmt.Sprintf(" AND ba.block_slot <= $%d", len(queryParams))
+	}
+	if committeeIndex != nil {
+		queryParams = append(queryParams, *committeeIndex)
+		whereQuery += fmt.Sprintf(" AND ba.committeeindex = $%d", len(queryParams))
+	}
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.BlockSlot, currentCursor.BlockIndex)
+		whereQuery += fmt.Sprintf(" AND (ba.block_slot, ba.block_index) %s ($%d, $%d)", sortSearchDirection, len(queryParams)-1, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := `
+		SELECT
+			ba.block_slot,
+			ba.block_index,
+			ba.aggregationbits,
+			ba.validators,
+			ba.signature,
+			ba.slot,
+			ba.committeeindex,
+			ba.beaconblockroot,
+			ba.source_epoch,
+			ba.source_root,
+			ba.target_epoch,
+			ba.target_root
+		FROM blocks_attestations ba
+		INNER JOIN blocks b ON b.slot = ba.block_slot AND b.blockroot = ba.block_root` + whereQuery +
+		" ORDER BY ba.block_slot" + sortSearchOrder + ", ba.block_index" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		blockAttestation
+		BlockIndex uint64 `db:"block_index"`
+	}
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	cursorData := make([]t.BlockOperationsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		result = append(result, t.NetworkAggregatedAttestationTableRow{
+			IncludedInSlot: row.BlockSlot,
+			Attestation:    row.toTableRow(),
+		})
+		cursorData = append(cursorData, t.BlockOperationsCursor{BlockSlot: row.BlockSlot, BlockIndex: row.BlockIndex})
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
 	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetBlockWithdrawals(ctx context.Context, chainId, block uint64) ([]t.BlockWithdrawalTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
 		return nil, err
 	}
-	return d.GetBlockAttestations(ctx, chainId, block)
+	return d.getBlockWithdrawals(ctx, data)
 }
 
 func (d *DataAccessService) GetSlotWithdrawals(ctx context.Context, chainId, slot uint64) ([]t.BlockWithdrawalTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+	data, err := d.getSlotData(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockWithdrawals(ctx, chainId, block)
+	return d.getBlockWithdrawals(ctx, data)
+}
+
+func (d *DataAccessService) getBlockWithdrawals(ctx context.Context, data *blockData) ([]t.BlockWithdrawalTableRow, error) {
+	var withdrawals []struct {
+		Index   uint64 `db:"validatorindex"`
+		Address []byte `db:"address"`
+		Amount  int64  `db:"amount"`
+	}
+	err := d.readerDb.SelectContext(ctx, &withdrawals, `
+		SELECT validatorindex, address, amount
+		FROM blocks_withdrawals
+		WHERE block_slot = $1 AND block_root = $2
+		ORDER BY withdrawalindex`, data.Slot, data.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]t.BlockWithdrawalTableRow, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		address := hexutil.Encode(withdrawal.Address)
+		ensMapping[address] = ""
+		result = append(result, t.BlockWithdrawalTableRow{
+			Index:     withdrawal.Index,
+			Epoch:     data.Epoch,
+			Slot:      data.Slot,
+			Age:       uint64(utils.SlotToTime(data.Slot).Unix()),
+			Recipient: t.Address{Hash: t.Hash(address)},
+			Amount:    utils.GWeiToWei(big.NewInt(withdrawal.Amount)),
+		})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].Recipient.Ens = ensMapping[string(result[i].Recipient.Hash)]
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetBlockBlsChanges(ctx context.Context, chainId, block uint64) ([]t.BlockBlsChangeTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return d.getBlockBlsChanges(ctx, data)
 }
 
 func (d *DataAccessService) GetSlotBlsChanges(ctx context.Context, chainId, slot uint64) ([]t.BlockBlsChangeTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+	data, err := d.getSlotData(ctx, slot)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockBlsChanges(ctx, chainId, block)
+	return d.getBlockBlsChanges(ctx, data)
+}
+
+func (d *DataAccessService) getBlockBlsChanges(ctx context.Context, data *blockData) ([]t.BlockBlsChangeTableRow, error) {
+	var blsChanges []struct {
+		Index     uint64 `db:"validatorindex"`
+		Signature []byte `db:"signature"`
+		Pubkey    []byte `db:"pubkey"`
+		Address   []byte `db:"address"`
+	}
+	err := d.readerDb.SelectContext(ctx, &blsChanges, `
+		SELECT validatorindex, signature, pubkey, address
+		FROM blocks_bls_change
+		WHERE block_slot = $1 AND block_root = $2
+		ORDER BY validatorindex`, data.Slot, data.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]t.BlockBlsChangeTableRow, 0, len(blsChanges))
+	for _, blsChange := range blsChanges {
+		address := hexutil.Encode(blsChange.Address)
+		ensMapping[address] = ""
+		result = append(result, t.BlockBlsChangeTableRow{
+			Index:                blsChange.Index,
+			Signature:            t.Hash(hexutil.Encode(blsChange.Signature)),
+			BlsPubkey:            t.Hash(hexutil.Encode(blsChange.Pubkey)),
+			NewWithdrawalAddress: t.Address{Hash: t.Hash(address)},
+		})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].NewWithdrawalAddress.Ens = ensMapping[string(result[i].NewWithdrawalAddress.Hash)]
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetBlockVoluntaryExits(ctx context.Context, chainId, block uint64) ([]t.BlockVoluntaryExitTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return d.getBlockVoluntaryExits(ctx, data)
 }
 
 func (d *DataAccessService) GetSlotVoluntaryExits(ctx context.Context, chainId, slot uint64) ([]t.BlockVoluntaryExitTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+	data, err := d.getSlotData(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return d.getBlockVoluntaryExits(ctx, data)
+}
+
+func (d *DataAccessService) getBlockVoluntaryExits(ctx context.Context, data *blockData) ([]t.BlockVoluntaryExitTableRow, error) {
+	var exits []struct {
+		Validator uint64 `db:"validatorindex"`
+		Signature []byte `db:"signature"`
+	}
+	err := d.readerDb.SelectContext(ctx, &exits, `
+		SELECT validatorindex, signature
+		FROM blocks_voluntaryexits
+		WHERE block_slot = $1 AND block_root = $2
+		ORDER BY block_index`, data.Slot, data.BlockRoot)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockVoluntaryExits(ctx, chainId, block)
+
+	result := make([]t.BlockVoluntaryExitTableRow, 0, len(exits))
+	for _, exit := range exits {
+		result = append(result, t.BlockVoluntaryExitTableRow{
+			Validator: exit.Validator,
+			Signature: t.Hash(hexutil.Encode(exit.Signature)),
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetBlockBlobs(ctx context.Context, chainId, block uint64) ([]t.BlockBlobTableRow, error) {
+	data, err := d.getBlockData(ctx, block)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return d.getBlockBlobs(ctx, data)
 }
 
 func (d *DataAccessService) GetSlotBlobs(ctx context.Context, chainId, slot uint64) ([]t.BlockBlobTableRow, error) {
-	block, err := d.GetBlockHeightAt(slot)
+	data, err := d.getSlotData(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return d.getBlockBlobs(ctx, data)
+}
+
+func (d *DataAccessService) getBlockBlobs(ctx context.Context, data *blockData) ([]t.BlockBlobTableRow, error) {
+	var blobs []struct {
+		Commitment    []byte `db:"kzg_commitment"`
+		Proof         []byte `db:"kzg_proof"`
+		VersionedHash []byte `db:"blob_versioned_hash"`
+	}
+	err := d.readerDb.SelectContext(ctx, &blobs, `
+		SELECT kzg_commitment, kzg_proof, blob_versioned_hash
+		FROM blocks_blob_sidecars
+		WHERE block_root = $1
+		ORDER BY index`, data.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) == 0 {
+		return []t.BlockBlobTableRow{}, nil
+	}
+
+	// map blobs to the transactions that carried them
+	blobTxHashes := make(map[string]t.Hash)
+	block := uint64(data.ExecBlockNumber.Int64)
+	eth1Block, err := d.getExecutionBlockIfIndexed(block)
 	if err != nil {
 		return nil, err
 	}
-	return d.GetBlockBlobs(ctx, chainId, block)
+	if eth1Block != nil {
+		for _, tx := range eth1Block.Transactions {
+			for _, versionedHash := range tx.BlobVersionedHashes {
+				blobTxHashes[hexutil.Encode(versionedHash)] = t.Hash(hexutil.Encode(tx.Hash))
+			}
+		}
+	}
+
+	result := make([]t.BlockBlobTableRow, 0, len(blobs))
+	for _, blob := range blobs {
+		versionedHash := hexutil.Encode(blob.VersionedHash)
+		result = append(result, t.BlockBlobTableRow{
+			VersionedHash:   t.Hash(versionedHash),
+			Commitment:      t.Hash(hexutil.Encode(blob.Commitment)),
+			Proof:           t.Hash(hexutil.Encode(blob.Proof)),
+			Size:            blobSize,
+			TransactionHash: blobTxHashes[versionedHash],
+			Block:           block,
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetSlotTransactions(ctx context.Context, chainId, slot uint64) ([]t.BlockTransactionTableRow, error) {
+	data, err := d.getSlotData(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if !data.ExecBlockNumber.Valid {
+		// slots without execution payload don't contain transactions
+		return []t.BlockTransactionTableRow{}, nil
+	}
+	return d.GetBlockTransactions(ctx, chainId, uint64(data.ExecBlockNumber.Int64))
+}
+
+func (d *DataAccessService) GetSlots(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.SlotTableRow, *t.Paging, error) {
+	result := make([]t.SlotTableRow, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.SlotsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.SlotsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as SlotsCursor: %w", err)
+		}
+	}
+
+	// Newest slots first
+	sortSearchDirection := "<"
+	sortSearchOrder := " DESC"
+	if currentCursor.IsReverse() {
+		sortSearchDirection = ">"
+		sortSearchOrder = " ASC"
+	}
+
+	// Don't list scheduled slots of the future
+	latestSlot, err := d.GetLatestSlot()
+	if err != nil {
+		return nil, nil, err
+	}
+	queryParams := []interface{}{latestSlot}
+	whereQuery := fmt.Sprintf(" WHERE slot <= $%d", len(queryParams))
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.Slot, currentCursor.BlockRoot)
+		whereQuery += fmt.Sprintf(" AND (slot%[1]s$%[2]d OR (slot=$%[2]d AND blockroot%[1]s$%[3]d))",
+			sortSearchDirection, len(queryParams)-1, len(queryParams))
+	}
+	orderQuery := fmt.Sprintf(" ORDER BY slot %[1]s, blockroot %[1]s", sortSearchOrder)
+	queryParams = append(queryParams, limit+1)
+	limitQuery := fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		Slot                       uint64         `db:"slot"`
+		Epoch                      uint64         `db:"epoch"`
+		BlockRoot                  []byte         `db:"blockroot"`
+		Status                     string         `db:"status"`
+		Proposer                   uint64         `db:"proposer"`
+		GraffitiText               sql.NullString `db:"graffiti_text"`
+		ExecBlockNumber            sql.NullInt64  `db:"exec_block_number"`
+		AttestationsCount          uint64         `db:"attestationscount"`
+		DepositsCount              uint64         `db:"depositscount"`
+		WithdrawalCount            uint64         `db:"withdrawalcount"`
+		VoluntaryExitsCount        uint64         `db:"voluntaryexitscount"`
+		ProposerSlashingsCount     uint64         `db:"proposerslashingscount"`
+		AttesterSlashingsCount     uint64         `db:"attesterslashingscount"`
+		SyncAggregateParticipation float64        `db:"syncaggregate_participation"`
+	}
+	query := `
+		SELECT
+			slot,
+			epoch,
+			blockroot,
+			status,
+			proposer,
+			graffiti_text,
+			exec_block_number,
+			attestationscount,
+			depositscount,
+			withdrawalcount,
+			voluntaryexitscount,
+			proposerslashingscount,
+			attesterslashingscount,
+			syncaggregate_participation
+		FROM blocks` + whereQuery + orderQuery + limitQuery
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	cursorData := make([]t.SlotsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		slot := t.SlotTableRow{
+			Slot:              row.Slot,
+			Epoch:             row.Epoch,
+			Time:              utils.SlotToTime(row.Slot).Unix(),
+			Proposer:          row.Proposer,
+			Graffiti:          row.GraffitiText.String,
+			Attestations:      row.AttestationsCount,
+			Deposits:          row.DepositsCount,
+			Withdrawals:       row.WithdrawalCount,
+			VoluntaryExits:    row.VoluntaryExitsCount,
+			ProposerSlashings: row.ProposerSlashingsCount,
+			AttesterSlashings: row.AttesterSlashingsCount,
+			SyncParticipation: row.SyncAggregateParticipation,
+		}
+		switch row.Status {
+		case "0":
+			slot.Status = "scheduled"
+		case "1":
+			slot.Status = "proposed"
+		case "2":
+			slot.Status = "missed"
+		case "3":
+			slot.Status = "orphaned"
+		}
+		if row.Status == "1" || row.Status == "3" {
+			slot.BlockRoot = t.Hash(hexutil.Encode(row.BlockRoot))
+			if row.ExecBlockNumber.Valid {
+				block := uint64(row.ExecBlockNumber.Int64)
+				slot.Block = &block
+			}
+		}
+		result = append(result, slot)
+		cursorData = append(cursorData, t.SlotsCursor{
+			Slot:      row.Slot,
+			BlockRoot: row.BlockRoot,
+		})
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetBlocks(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTableRow, *t.Paging, error) {
+	result := make([]t.BlockTableRow, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.NetworkBlocksCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NetworkBlocksCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NetworkBlocksCursor: %w", err)
+		}
+	}
+
+	// Newest blocks first
+	sortSearchDirection := "<"
+	sortSearchOrder := " DESC"
+	if currentCursor.IsReverse() {
+		sortSearchDirection = ">"
+		sortSearchOrder = " ASC"
+	}
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE status = '1' AND exec_block_number IS NOT NULL"
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.Block)
+		whereQuery += fmt.Sprintf(" AND exec_block_number %s $%d", sortSearchDirection, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	orderQuery := " ORDER BY exec_block_number" + sortSearchOrder
+	limitQuery := fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		Block         uint64        `db:"exec_block_number"`
+		Slot          uint64        `db:"slot"`
+		Epoch         uint64        `db:"epoch"`
+		Proposer      uint64        `db:"proposer"`
+		BlockHash     []byte        `db:"exec_block_hash"`
+		FeeRecipient  []byte        `db:"exec_fee_recipient"`
+		Transactions  uint64        `db:"exec_transactions_count"`
+		GasUsed       sql.NullInt64 `db:"exec_gas_used"`
+		GasLimit      sql.NullInt64 `db:"exec_gas_limit"`
+		BaseFeePerGas sql.NullInt64 `db:"exec_base_fee_per_gas"`
+		ExecTimestamp sql.NullInt64 `db:"exec_timestamp"`
+	}
+	query := `
+		SELECT
+			exec_block_number,
+			slot,
+			epoch,
+			proposer,
+			exec_block_hash,
+			exec_fee_recipient,
+			exec_transactions_count,
+			exec_gas_used,
+			exec_gas_limit,
+			exec_base_fee_per_gas,
+			exec_timestamp
+		FROM blocks` + whereQuery + orderQuery + limitQuery
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	ensMapping := make(map[string]string)
+	cursorData := make([]t.NetworkBlocksCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		feeRecipient := hexutil.Encode(row.FeeRecipient)
+		ensMapping[feeRecipient] = ""
+		blockTime := utils.SlotToTime(row.Slot).Unix()
+		if row.ExecTimestamp.Valid {
+			blockTime = row.ExecTimestamp.Int64
+		}
+		result = append(result, t.BlockTableRow{
+			Block:         row.Block,
+			Slot:          row.Slot,
+			Epoch:         row.Epoch,
+			Time:          blockTime,
+			Proposer:      row.Proposer,
+			Hash:          t.Hash(hexutil.Encode(row.BlockHash)),
+			FeeRecipient:  t.Address{Hash: t.Hash(feeRecipient)},
+			Transactions:  row.Transactions,
+			GasUsed:       uint64(row.GasUsed.Int64),
+			GasLimit:      uint64(row.GasLimit.Int64),
+			BaseFeePerGas: decimal.NewFromInt(row.BaseFeePerGas.Int64),
+		})
+		cursorData = append(cursorData, t.NetworkBlocksCursor{Block: row.Block})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].FeeRecipient.Ens = ensMapping[string(result[i].FeeRecipient.Hash)]
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
 }
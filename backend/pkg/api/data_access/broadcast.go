@@ -0,0 +1,89 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/nodejobs"
+)
+
+// BroadcastRepository stores signed data as node jobs, the node jobs processor forwards them to the configured nodes
+// and keeps track of their status.
+type BroadcastRepository interface {
+	// validation failures are returned as types.CreateNodeJobUserError
+	CreateNetworkBroadcast(ctx context.Context, chainId uint64, broadcastType string, data []byte) (*t.NetworkBroadcast, error)
+	GetNetworkBroadcast(ctx context.Context, chainId uint64, id string) (*t.NetworkBroadcast, error)
+}
+
+var broadcastNodeJobTypes = map[string]types.NodeJobType{
+	"transaction":    types.ExecutionTransactionNodeJobType,
+	"voluntary_exit": types.VoluntaryExitsNodeJobType,
+	"bls_changes":    types.BLSToExecutionChangesNodeJobType,
+	"attestations":   types.AttestationsNodeJobType,
+}
+
+var broadcastStatuses = map[types.NodeJobStatus]string{
+	types.PendingNodeJobStatus:         "pending",
+	types.SubmittedToNodeNodeJobStatus: "submitted",
+	types.CompletedNodeJobStatus:       "completed",
+	types.FailedNodeJobStatus:          "failed",
+}
+
+func (d *DataAccessService) CreateNetworkBroadcast(ctx context.Context, chainId uint64, broadcastType string, data []byte) (*t.NetworkBroadcast, error) {
+	// TODO: implement handling of chainid
+	jobType, ok := broadcastNodeJobTypes[broadcastType]
+	if !ok {
+		return nil, fmt.Errorf("unknown broadcast type %s", broadcastType)
+	}
+	job, err := types.NewNodeJob(data)
+	if err != nil {
+		return nil, err
+	}
+	if job.Type != jobType {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("data is not valid for broadcast type %s", broadcastType)}
+	}
+	job, err = nodejobs.CreateNodeJob(job.RawData)
+	if err != nil {
+		return nil, err
+	}
+	// read the job back for the creation time set by the db
+	return d.GetNetworkBroadcast(ctx, chainId, job.ID)
+}
+
+func (d *DataAccessService) GetNetworkBroadcast(ctx context.Context, chainId uint64, id string) (*t.NetworkBroadcast, error) {
+	// TODO: implement handling of chainid
+	job, err := nodejobs.GetNodeJob(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: broadcast %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toNetworkBroadcast(job), nil
+}
+
+func toNetworkBroadcast(job *types.NodeJob) *t.NetworkBroadcast {
+	result := &t.NetworkBroadcast{
+		Id:        job.ID,
+		Status:    broadcastStatuses[job.Status],
+		CreatedTs: job.CreatedTime.Unix(),
+	}
+	for name, jobType := range broadcastNodeJobTypes {
+		if jobType == job.Type {
+			result.Type = name
+		}
+	}
+	if job.SubmittedToNodeTime.Valid {
+		ts := job.SubmittedToNodeTime.Time.Unix()
+		result.SubmittedTs = &ts
+	}
+	if job.CompletedTime.Valid {
+		ts := job.CompletedTime.Time.Unix()
+		result.CompletedTs = &ts
+	}
+	return result
+}
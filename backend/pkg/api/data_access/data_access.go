@@ -12,6 +12,7 @@ import (
 	"github.com/gobitfly/beaconchain/pkg/commons/cache"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/rpc"
 	"github.com/gobitfly/beaconchain/pkg/commons/types"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -19,13 +20,26 @@ import (
 
 type DataAccessor interface {
 	ValidatorDashboardRepository
+	AccountDashboardRepository
 	SearchRepository
 	NetworkRepository
 	UserRepository
+	ApiKeyRepository
 	AppRepository
+	OAuthRepository
 	NotificationsRepository
 	AdminRepository
 	BlockRepository
+	OperationsRepository
+	ExecutionRepository
+	GasRepository
+	BroadcastRepository
+	EnsRepository
+	MultisigRepository
+	ValidatorRepository
+	EpochRepository
+	SyncCommitteeRepository
+	EthStoreRepository
 	ArchiverRepository
 	ProtocolRepository
 	RatelimitRepository
@@ -38,6 +52,8 @@ type DataAccessor interface {
 	GetLatestSlot() (uint64, error)
 	GetLatestBlock() (uint64, error)
 	GetBlockHeightAt(slot uint64) (uint64, error)
+	GetBlockHeightByHash(hash []byte) (uint64, error)
+	GetSlotByBlockRoot(blockRoot []byte) (uint64, error)
 	GetLatestExchangeRates() ([]t.EthConversionRate, error)
 
 	GetProductSummary(ctx context.Context) (*t.ProductSummary, error)
@@ -58,6 +74,7 @@ type DataAccessService struct {
 	userWriter              *sqlx.DB
 	bigtable                *db.Bigtable
 	persistentRedisDbClient *redis.Client
+	erigonClient            *rpc.ErigonClient // optional, only used for historic state reads and ens text records
 
 	services *services.Services
 }
@@ -235,6 +252,20 @@ func createDataAccessService(cfg *types.Config) *DataAccessService {
 		dataAccessService.persistentRedisDbClient = rdc
 	}()
 
+	// Initialize the execution client, the api can run without it
+	if cfg.Eth1ErigonEndpoint != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := rpc.NewErigonClient(cfg.Eth1ErigonEndpoint)
+			if err != nil {
+				log.Error(err, "error connecting to execution client, historic state endpoints will be unavailable", 0)
+				return
+			}
+			dataAccessService.erigonClient = client
+		}()
+	}
+
 	wg.Wait()
 
 	if cfg.TieredCacheProvider != "redis" {
@@ -272,6 +303,12 @@ func (d *DataAccessService) Close() {
 	if d.bigtable != nil {
 		d.bigtable.Close()
 	}
+	if d.erigonClient != nil {
+		d.erigonClient.Close()
+	}
 }
 
 var ErrNotFound = errors.New("not found")
+
+// ErrUnavailable is returned if a dependency required to serve the data is not configured or overloaded
+var ErrUnavailable = errors.New("unavailable")
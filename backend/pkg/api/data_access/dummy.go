@@ -102,6 +102,14 @@ func (d *DummyService) GetBlockHeightAt(slot uint64) (uint64, error) {
 	return getDummyData[uint64]()
 }
 
+func (d *DummyService) GetBlockHeightByHash(hash []byte) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) GetSlotByBlockRoot(blockRoot []byte) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
 func (d *DummyService) GetLatestExchangeRates() ([]t.EthConversionRate, error) {
 	return getDummyData[[]t.EthConversionRate]()
 }
@@ -162,8 +170,28 @@ func (d *DummyService) GetUserCredentialInfo(ctx context.Context, userId uint64)
 	return getDummyStruct[t.UserCredentialInfo]()
 }
 
-func (d *DummyService) GetUserIdByApiKey(ctx context.Context, apiKey string) (uint64, error) {
-	return getDummyData[uint64]()
+func (d *DummyService) GetApiKeyInfo(ctx context.Context, apiKey string) (*t.ApiKeyInfo, error) {
+	return getDummyStruct[t.ApiKeyInfo]()
+}
+
+func (d *DummyService) GetApiKeys(ctx context.Context, userId uint64) ([]t.ApiKey, error) {
+	return getDummyData[[]t.ApiKey]()
+}
+
+func (d *DummyService) CreateApiKey(ctx context.Context, userId uint64, name, scope string, allowedIps []string, expiresAt *time.Time) (*t.ApiKey, error) {
+	return getDummyStruct[t.ApiKey]()
+}
+
+func (d *DummyService) RotateApiKey(ctx context.Context, userId, keyId uint64) (*t.ApiKey, error) {
+	return getDummyStruct[t.ApiKey]()
+}
+
+func (d *DummyService) RevokeApiKey(ctx context.Context, userId, keyId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetApiKeyUsage(ctx context.Context, userId, keyId uint64, since time.Time) (*t.ApiKeyUsage, error) {
+	return getDummyStruct[t.ApiKeyUsage]()
 }
 
 func (d *DummyService) GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error) {
@@ -429,6 +457,38 @@ func (d *DummyService) GetSearchValidatorsByGraffiti(ctx context.Context, chainI
 	return getDummyStruct[t.SearchValidatorsByGraffiti]()
 }
 
+func (d *DummyService) GetSearchSlot(ctx context.Context, chainId, slot uint64) (*t.SearchSlot, error) {
+	return getDummyStruct[t.SearchSlot]()
+}
+
+func (d *DummyService) GetSearchSlotByBlockRoot(ctx context.Context, chainId uint64, blockRoot []byte) (*t.SearchSlot, error) {
+	return getDummyStruct[t.SearchSlot]()
+}
+
+func (d *DummyService) GetSearchEpoch(ctx context.Context, chainId, epoch uint64) (*t.SearchEpoch, error) {
+	return getDummyStruct[t.SearchEpoch]()
+}
+
+func (d *DummyService) GetSearchBlock(ctx context.Context, chainId, number uint64) (*t.SearchBlock, error) {
+	return getDummyStruct[t.SearchBlock]()
+}
+
+func (d *DummyService) GetSearchBlockByHash(ctx context.Context, chainId uint64, hash []byte) (*t.SearchBlock, error) {
+	return getDummyStruct[t.SearchBlock]()
+}
+
+func (d *DummyService) GetSearchTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.SearchTransaction, error) {
+	return getDummyStruct[t.SearchTransaction]()
+}
+
+func (d *DummyService) GetSearchAddresses(ctx context.Context, chainId uint64, addressPrefix string, limit uint64) ([]t.SearchAddress, error) {
+	return getDummyData[[]t.SearchAddress]()
+}
+
+func (d *DummyService) GetSearchEnsNames(ctx context.Context, chainId uint64, ensNamePrefix string, limit uint64) ([]t.SearchEnsName, error) {
+	return getDummyData[[]t.SearchEnsName]()
+}
+
 func (d *DummyService) GetUserValidatorDashboardCount(ctx context.Context, userId uint64, active bool) (uint64, error) {
 	return getDummyData[uint64]()
 }
@@ -445,6 +505,98 @@ func (d *DummyService) GetValidatorDashboardPublicIdCount(ctx context.Context, d
 	return getDummyData[uint64]()
 }
 
+func (d *DummyService) GetAccountDashboardUser(ctx context.Context, dashboardId t.ADBIdPrimary) (*t.DashboardUser, error) {
+	return getDummyStruct[t.DashboardUser]()
+}
+
+func (d *DummyService) GetAccountDashboardIdByPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBIdPrimary, error) {
+	return getDummyStruct[t.ADBIdPrimary]()
+}
+
+func (d *DummyService) GetUserAccountDashboardCount(ctx context.Context, userId uint64) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) CreateAccountDashboard(ctx context.Context, userId uint64, name string) (*t.ADBPostReturnData, error) {
+	return getDummyStruct[t.ADBPostReturnData]()
+}
+
+func (d *DummyService) RemoveAccountDashboard(ctx context.Context, dashboardId t.ADBIdPrimary) error {
+	return nil
+}
+
+func (d *DummyService) GetAccountDashboardOverview(ctx context.Context, dashboardId t.ADBId) (*t.ADBOverviewData, error) {
+	return getDummyStruct[t.ADBOverviewData]()
+}
+
+func (d *DummyService) CreateAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, name string) (*t.ADBPostCreateGroupData, error) {
+	return getDummyStruct[t.ADBPostCreateGroupData]()
+}
+
+func (d *DummyService) RemoveAccountDashboardGroup(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetAccountDashboardGroupCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) GetAccountDashboardGroupExists(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64) (bool, error) {
+	return true, nil
+}
+
+func (d *DummyService) GetAccountDashboardAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) GetAccountDashboardExistingAccountCount(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) AddAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, groupId uint64, addresses []string) ([]t.ADBPostAccountsData, error) {
+	return getDummyData[[]t.ADBPostAccountsData]()
+}
+
+func (d *DummyService) UpdateAccountDashboardAccount(ctx context.Context, dashboardId t.ADBIdPrimary, address string, groupId uint64) (*t.ADBManageAccountsTableRow, error) {
+	return getDummyStruct[t.ADBManageAccountsTableRow]()
+}
+
+func (d *DummyService) RemoveAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBIdPrimary, addresses []string) error {
+	return nil
+}
+
+func (d *DummyService) GetAccountDashboardAccounts(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, colSort t.Sort[enums.ADBManageAccountsColumn], search string, limit uint64) ([]t.ADBManageAccountsTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.ADBManageAccountsTableRow]()
+}
+
+func (d *DummyService) CreateAccountDashboardPublicId(ctx context.Context, dashboardId t.ADBIdPrimary, name string, shareGroups bool) (*t.ADBPublicId, error) {
+	return getDummyStruct[t.ADBPublicId]()
+}
+
+func (d *DummyService) GetAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) (*t.ADBPublicId, error) {
+	return getDummyStruct[t.ADBPublicId]()
+}
+
+func (d *DummyService) UpdateAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic, name string, shareGroups bool) (*t.ADBPublicId, error) {
+	return getDummyStruct[t.ADBPublicId]()
+}
+
+func (d *DummyService) RemoveAccountDashboardPublicId(ctx context.Context, publicDashboardId t.ADBIdPublic) error {
+	return nil
+}
+
+func (d *DummyService) GetAccountDashboardPublicIdCount(ctx context.Context, dashboardId t.ADBIdPrimary) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) GetAccountDashboardTransactions(ctx context.Context, dashboardId t.ADBId, groupId int64, cursor string, limit uint64) ([]t.ADBTransactionTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.ADBTransactionTableRow]()
+}
+
+func (d *DummyService) UpdateAccountDashboardTransactionsSettings(ctx context.Context, dashboardId t.ADBIdPrimary, settings t.ADBTransactionsSettings) (*t.ADBTransactionsSettings, error) {
+	return getDummyStruct[t.ADBTransactionsSettings]()
+}
+
 func (d *DummyService) GetNotificationOverview(ctx context.Context, userId uint64) (*t.NotificationOverviewData, error) {
 	return getDummyStruct[t.NotificationOverviewData]()
 }
@@ -452,11 +604,11 @@ func (d *DummyService) GetDashboardNotifications(ctx context.Context, userId uin
 	return getDummyWithPaging[t.NotificationDashboardsTableRow]()
 }
 
-func (d *DummyService) GetValidatorDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationValidatorDashboardDetail, error) {
+func (d *DummyService) GetValidatorDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationValidatorDashboardDetail, error) {
 	return getDummyStruct[t.NotificationValidatorDashboardDetail]()
 }
 
-func (d *DummyService) GetAccountDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationAccountDashboardDetail, error) {
+func (d *DummyService) GetAccountDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationAccountDashboardDetail, error) {
 	return getDummyStruct[t.NotificationAccountDashboardDetail]()
 }
 
@@ -482,10 +634,10 @@ func (d *DummyService) UpdateNotificationSettingsGeneral(ctx context.Context, us
 func (d *DummyService) UpdateNotificationSettingsNetworks(ctx context.Context, userId uint64, chainId uint64, settings t.NotificationSettingsNetwork) error {
 	return nil
 }
-func (d *DummyService) UpdateNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string, name string, IsNotificationsEnabled bool) error {
+func (d *DummyService) UpdateNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string, name string, IsNotificationsEnabled bool) error {
 	return nil
 }
-func (d *DummyService) DeleteNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string) error {
+func (d *DummyService) DeleteNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string) error {
 	return nil
 }
 func (d *DummyService) GetNotificationSettingsDashboards(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationSettingsDashboardColumn], search string, limit uint64) ([]t.NotificationSettingsDashboardsTableRow, *t.Paging, error) {
@@ -508,6 +660,22 @@ func (d *DummyService) UpdateNotificationSettingsValidatorDashboard(ctx context.
 func (d *DummyService) UpdateNotificationSettingsAccountDashboard(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, settings t.NotificationSettingsAccountDashboard) error {
 	return nil
 }
+func (d *DummyService) GetWebhookDeliveries(ctx context.Context, userId uint64, cursor string, limit uint64) ([]t.NotificationWebhookDelivery, *t.Paging, error) {
+	return getDummyWithPaging[t.NotificationWebhookDelivery]()
+}
+
+func (d *DummyService) GetNotificationPushTokens(ctx context.Context, userId uint64) ([]string, error) {
+	return getDummyData[[]string]()
+}
+
+func (d *DummyService) GetNotificationWebhookSecret(ctx context.Context, userId uint64, webhookUrl string) (string, error) {
+	return getDummyData[string]()
+}
+
+func (d *DummyService) IncrementNotificationTestCount(ctx context.Context, userId uint64, window time.Duration) (uint64, time.Duration, error) {
+	return 1, window, nil
+}
+
 func (d *DummyService) CreateAdConfiguration(ctx context.Context, key, jquerySelector string, insertMode enums.AdInsertMode, refreshInterval uint64, forAllUsers bool, bannerId uint64, htmlContent string, enabled bool) error {
 	return nil
 }
@@ -540,6 +708,50 @@ func (d *DummyService) GetAppDataFromRedirectUri(callback string) (*t.OAuthAppDa
 	return getDummyStruct[t.OAuthAppData]()
 }
 
+func (d *DummyService) CreateOAuthApp(ctx context.Context, userId uint64, name, redirectUri, clientId, clientSecretHash string, scopes []string) (*t.OAuthApp, error) {
+	return getDummyStruct[t.OAuthApp]()
+}
+
+func (d *DummyService) GetOAuthApps(ctx context.Context, userId uint64) ([]t.OAuthApp, error) {
+	return getDummyData[[]t.OAuthApp]()
+}
+
+func (d *DummyService) RemoveOAuthApp(ctx context.Context, userId, appId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetOAuthAppCredentials(ctx context.Context, clientId string) (*t.OAuthAppCredentials, error) {
+	return getDummyStruct[t.OAuthAppCredentials]()
+}
+
+func (d *DummyService) AddOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode, expiresAt time.Time) error {
+	return nil
+}
+
+func (d *DummyService) ConsumeOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error) {
+	return getDummyStruct[t.OAuthAuthorizationCode]()
+}
+
+func (d *DummyService) AddOAuthToken(ctx context.Context, appId, userId uint64, accessTokenHash, refreshTokenHash string, scopes []string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	return nil
+}
+
+func (d *DummyService) RotateOAuthToken(ctx context.Context, appId uint64, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) (*t.OAuthToken, error) {
+	return getDummyStruct[t.OAuthToken]()
+}
+
+func (d *DummyService) GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthToken, error) {
+	return getDummyStruct[t.OAuthToken]()
+}
+
+func (d *DummyService) GetOAuthGrants(ctx context.Context, userId uint64) ([]t.OAuthGrant, error) {
+	return getDummyData[[]t.OAuthGrant]()
+}
+
+func (d *DummyService) RevokeOAuthGrant(ctx context.Context, userId, appId uint64) error {
+	return nil
+}
+
 func (d *DummyService) AddUserDevice(userID uint64, hashedRefreshToken string, deviceID, deviceName string, appID uint64) error {
 	return nil
 }
@@ -636,6 +848,18 @@ func (d *DummyService) GetRocketPoolOverview(ctx context.Context) (*t.RocketPool
 	return getDummyStruct[t.RocketPoolData]()
 }
 
+func (d *DummyService) GetRocketPoolNetwork(ctx context.Context) (*t.RocketPoolNetwork, error) {
+	return getDummyStruct[t.RocketPoolNetwork]()
+}
+
+func (d *DummyService) GetRocketPoolNodes(ctx context.Context, cursor string, colSort t.Sort[enums.RocketPoolNodesColumn], filter t.RocketPoolNodesFilter, limit uint64) ([]t.RocketPoolNode, *t.Paging, error) {
+	return getDummyWithPaging[t.RocketPoolNode]()
+}
+
+func (d *DummyService) GetRocketPoolMinipools(ctx context.Context, cursor string, filter t.RocketPoolMinipoolsFilter, limit uint64) ([]t.RocketPoolMinipool, *t.Paging, error) {
+	return getDummyWithPaging[t.RocketPoolMinipool]()
+}
+
 func (d *DummyService) GetApiWeights(ctx context.Context) ([]t.ApiWeightItem, error) {
 	r := []t.ApiWeightItem{}
 	err := commonFakeData(&r)
@@ -654,3 +878,167 @@ func (d *DummyService) GetLatestBundleForNativeVersion(ctx context.Context, nati
 func (d *DummyService) IncrementBundleDeliveryCount(ctx context.Context, bundleVerison uint64) error {
 	return nil
 }
+
+func (d *DummyService) GetNetworkValidators(ctx context.Context, chainId uint64, validators []t.VDBValidator, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], search string, limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkValidator]()
+}
+
+func (d *DummyService) GetNetworkValidator(ctx context.Context, chainId uint64, validator t.VDBValidator) (*t.NetworkValidator, error) {
+	return getDummyStruct[t.NetworkValidator]()
+}
+
+func (d *DummyService) GetNetworkValidatorDuties(ctx context.Context, chainId uint64, validator t.VDBValidator) ([]t.NetworkValidatorDuty, error) {
+	return getDummyData[[]t.NetworkValidatorDuty]()
+}
+
+func (d *DummyService) GetNetworkAddressValidators(ctx context.Context, chainId uint64, address string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkValidator]()
+}
+
+func (d *DummyService) GetNetworkWithdrawalCredentialValidators(ctx context.Context, chainId uint64, credential string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkValidator]()
+}
+
+func (d *DummyService) GetNetworkValidatorsRewardHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorRewardHistoryRow, error) {
+	return getDummyData[[]t.NetworkValidatorRewardHistoryRow]()
+}
+
+func (d *DummyService) GetNetworkValidatorsBalanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorBalanceHistoryRow, error) {
+	return getDummyData[[]t.NetworkValidatorBalanceHistoryRow]()
+}
+
+func (d *DummyService) GetNetworkValidatorsPerformanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorPerformanceHistoryRow, error) {
+	return getDummyData[[]t.NetworkValidatorPerformanceHistoryRow]()
+}
+
+func (d *DummyService) GetEpochs(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.EpochTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.EpochTableRow]()
+}
+
+func (d *DummyService) GetEpoch(ctx context.Context, chainId uint64, epoch uint64) (*t.EpochTableRow, error) {
+	return getDummyStruct[t.EpochTableRow]()
+}
+
+func (d *DummyService) GetSlots(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.SlotTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.SlotTableRow]()
+}
+
+func (d *DummyService) GetBlocks(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.BlockTableRow]()
+}
+
+func (d *DummyService) GetNetworkAggregatedAttestations(ctx context.Context, chainId uint64, startSlot, endSlot, committeeIndex *uint64, cursor string, limit uint64) ([]t.NetworkAggregatedAttestationTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkAggregatedAttestationTableRow]()
+}
+
+func (d *DummyService) GetNetworkValidatorsAttestations(ctx context.Context, chainId uint64, validators []t.VDBValidator, startEpoch, endEpoch uint64) ([]t.NetworkValidatorAttestation, error) {
+	return getDummyData[[]t.NetworkValidatorAttestation]()
+}
+
+func (d *DummyService) GetNetworkDeposits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkDepositTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkDepositTableRow]()
+}
+
+func (d *DummyService) GetNetworkWithdrawals(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkWithdrawalTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkWithdrawalTableRow]()
+}
+
+func (d *DummyService) GetNetworkVoluntaryExits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkVoluntaryExitTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkVoluntaryExitTableRow]()
+}
+
+func (d *DummyService) GetNetworkBlsChanges(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkBlsChangeTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkBlsChangeTableRow]()
+}
+
+func (d *DummyService) GetNetworkSlashings(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkSlashingTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkSlashingTableRow]()
+}
+
+func (d *DummyService) GetNetworkTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkTransactionTableRow]()
+}
+
+func (d *DummyService) GetNetworkTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.NetworkTransactionDetail, error) {
+	return getDummyStruct[t.NetworkTransactionDetail]()
+}
+
+func (d *DummyService) GetNetworkAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkTransactionTableRow]()
+}
+
+func (d *DummyService) GetNetworkAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkEventLog, *t.Paging, error) {
+	return getDummyWithPaging[t.NetworkEventLog]()
+}
+
+func (d *DummyService) GetNetworkAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, token []byte, startDay, endDay uint64) ([]t.NetworkAddressBalanceHistoryRow, error) {
+	return getDummyData[[]t.NetworkAddressBalanceHistoryRow]()
+}
+
+func (d *DummyService) GetNetworkTokenSupplyHistory(ctx context.Context, chainId uint64, token []byte, startDay, endDay uint64) ([]t.NetworkTokenSupplyHistoryRow, error) {
+	return getDummyData[[]t.NetworkTokenSupplyHistoryRow]()
+}
+
+func (d *DummyService) GetNetworkGasNow(ctx context.Context, chainId uint64, includeHistory bool) (*t.NetworkGasNow, error) {
+	return getDummyStruct[t.NetworkGasNow]()
+}
+
+func (d *DummyService) GetNetworkGasLimitHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error) {
+	return getDummyStruct[t.ChartData[string, decimal.Decimal]]()
+}
+
+func (d *DummyService) GetNetworkGasUsedHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error) {
+	return getDummyStruct[t.ChartData[string, decimal.Decimal]]()
+}
+
+func (d *DummyService) CreateNetworkBroadcast(ctx context.Context, chainId uint64, broadcastType string, data []byte) (*t.NetworkBroadcast, error) {
+	return getDummyStruct[t.NetworkBroadcast]()
+}
+
+func (d *DummyService) GetNetworkBroadcast(ctx context.Context, chainId uint64, id string) (*t.NetworkBroadcast, error) {
+	return getDummyStruct[t.NetworkBroadcast]()
+}
+
+func (d *DummyService) GetNetworkEnsName(ctx context.Context, chainId uint64, name string) (*t.NetworkEnsName, error) {
+	return getDummyStruct[t.NetworkEnsName]()
+}
+
+func (d *DummyService) GetNetworkAddressesEns(ctx context.Context, chainId uint64, addresses [][]byte) ([]t.NetworkAddressEns, error) {
+	return getDummyData[[]t.NetworkAddressEns]()
+}
+
+func (d *DummyService) GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error) {
+	return getDummyStruct[t.MultisigSafe]()
+}
+
+func (d *DummyService) GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigTransaction, *t.Paging, error) {
+	return getDummyWithPaging[t.MultisigTransaction]()
+}
+
+func (d *DummyService) GetMultisigTransactionConfirmations(ctx context.Context, address []byte, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error) {
+	return getDummyData[[]t.MultisigTransactionConfirmation]()
+}
+
+func (d *DummyService) GetSyncCommittee(ctx context.Context, chainId uint64, period uint64) (*t.SyncCommittee, error) {
+	return getDummyStruct[t.SyncCommittee]()
+}
+
+func (d *DummyService) GetLatestEthStoreDay(ctx context.Context) (uint64, error) {
+	return getDummyData[uint64]()
+}
+
+func (d *DummyService) GetEthStoreDay(ctx context.Context, chainId uint64, day uint64) (*t.EthStoreDay, error) {
+	return getDummyStruct[t.EthStoreDay]()
+}
+
+func (d *DummyService) GetEthStoreDays(ctx context.Context, chainId uint64, startDay, endDay uint64) ([]t.EthStoreDay, error) {
+	return getDummyData[[]t.EthStoreDay]()
+}
+
+func (d *DummyService) GetEthStoreComparison(ctx context.Context, chainId uint64, validators []t.VDBValidator, startDay, endDay uint64) (*t.EthStoreComparison, error) {
+	return getDummyStruct[t.EthStoreComparison]()
+}
+
+func (d *DummyService) GetValidatorDashboardEthStoreComparison(ctx context.Context, dashboardId t.VDBId, groupId int64, startDay, endDay uint64) (*t.EthStoreComparison, error) {
+	return getDummyStruct[t.EthStoreComparison]()
+}
@@ -0,0 +1,157 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	go_ens "github.com/wealdtech/go-ens/v3"
+	"github.com/wealdtech/go-ens/v3/contracts/resolver"
+	"golang.org/x/sync/errgroup"
+)
+
+// EnsRepository resolves ens names from the ens table maintained by the eth1 indexer
+type EnsRepository interface {
+	GetNetworkEnsName(ctx context.Context, chainId uint64, name string) (*t.NetworkEnsName, error)
+	// returns one entry per given address, in the same order
+	GetNetworkAddressesEns(ctx context.Context, chainId uint64, addresses [][]byte) ([]t.NetworkAddressEns, error)
+}
+
+// text records that are read from the resolver of a name
+var ensTextRecordKeys = []string{"avatar", "description", "url", "email", "com.twitter", "com.github"}
+
+const ensTextRecordsCacheDuration = time.Hour
+
+func (d *DataAccessService) GetNetworkEnsName(ctx context.Context, chainId uint64, name string) (*t.NetworkEnsName, error) {
+	// TODO: implement handling of chainid
+	var row struct {
+		Name      string    `db:"ens_name"`
+		Address   []byte    `db:"address"`
+		IsPrimary bool      `db:"is_primary_name"`
+		ValidTo   time.Time `db:"valid_to"`
+		Owner     []byte    `db:"owner"`
+		Resolver  []byte    `db:"resolver"`
+	}
+	err := d.readerDb.GetContext(ctx, &row, `
+		SELECT ens_name, address, is_primary_name, valid_to, owner, resolver
+		FROM ens
+		WHERE ens_name = $1 AND valid_to >= now()`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: ens name %s", ErrNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &t.NetworkEnsName{
+		Name:      row.Name,
+		Address:   t.Hash(hexutil.Encode(row.Address)),
+		IsPrimary: row.IsPrimary,
+		Expires:   row.ValidTo.Unix(),
+		Records:   []t.NetworkEnsTextRecord{},
+	}
+	if len(row.Owner) > 0 {
+		owner := hexutil.Encode(row.Owner)
+		ensMapping := map[string]string{owner: ""}
+		if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+			return nil, err
+		}
+		result.Owner = &t.Address{Hash: t.Hash(owner), Ens: ensMapping[owner]}
+	}
+	if len(row.Resolver) > 0 {
+		resolver := t.Hash(hexutil.Encode(row.Resolver))
+		result.Resolver = &resolver
+		result.Records, err = d.getEnsTextRecords(ctx, row.Name, common.BytesToAddress(row.Resolver))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// getEnsTextRecords reads the text records from the resolver, the records are cached for ensTextRecordsCacheDuration
+func (d *DataAccessService) getEnsTextRecords(ctx context.Context, name string, resolverAddress common.Address) ([]t.NetworkEnsTextRecord, error) {
+	result := []t.NetworkEnsTextRecord{}
+	if d.erigonClient == nil {
+		return result, nil
+	}
+	cacheKey := fmt.Sprintf("%d:api:ens_text_records:%s:%x", utils.Config.Chain.ClConfig.DepositChainID, name, resolverAddress)
+	if cache.TieredCache != nil {
+		if _, err := cache.TieredCache.GetWithLocalTimeout(cacheKey, ensTextRecordsCacheDuration, &result); err == nil {
+			return result, nil
+		}
+	}
+
+	node, err := go_ens.NameHash(name)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing ens name %s: %w", name, err)
+	}
+	contract, err := resolver.NewContract(resolverAddress, d.erigonClient.GetNativeClient())
+	if err != nil {
+		return nil, fmt.Errorf("error binding ens resolver %s: %w", resolverAddress, err)
+	}
+	values := make([]string, len(ensTextRecordKeys))
+	wg, ctx := errgroup.WithContext(ctx)
+	for i, key := range ensTextRecordKeys {
+		wg.Go(func() error {
+			value, err := contract.Text(&bind.CallOpts{Context: ctx}, node, key)
+			if err != nil {
+				return fmt.Errorf("error reading ens text record %s of %s: %w", key, name, err)
+			}
+			values[i] = value
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	result = []t.NetworkEnsTextRecord{}
+	for i, key := range ensTextRecordKeys {
+		if values[i] != "" {
+			result = append(result, t.NetworkEnsTextRecord{Key: key, Value: values[i]})
+		}
+	}
+	if cache.TieredCache != nil {
+		if err := cache.TieredCache.Set(cacheKey, result, ensTextRecordsCacheDuration); err != nil {
+			log.Error(err, "error caching ens text records", 0, log.Fields{"name": name})
+		}
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetNetworkAddressesEns(ctx context.Context, chainId uint64, addresses [][]byte) ([]t.NetworkAddressEns, error) {
+	// TODO: implement handling of chainid
+	var rows []struct {
+		Address []byte `db:"address"`
+		Name    string `db:"ens_name"`
+	}
+	err := d.readerDb.SelectContext(ctx, &rows, `
+		SELECT address, ens_name
+		FROM ens
+		WHERE address = ANY($1) AND is_primary_name AND valid_to >= now()`, pq.ByteaArray(addresses))
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(rows))
+	for _, row := range rows {
+		names[hexutil.Encode(row.Address)] = row.Name
+	}
+
+	result := make([]t.NetworkAddressEns, 0, len(addresses))
+	for _, address := range addresses {
+		hash := hexutil.Encode(address)
+		result = append(result, t.NetworkAddressEns{Address: t.Hash(hash), Name: names[hash]})
+	}
+	return result, nil
+}
@@ -0,0 +1,181 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/pkg/errors"
+)
+
+type EpochRepository interface {
+	GetEpochs(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.EpochTableRow, *t.Paging, error)
+	GetEpoch(ctx context.Context, chainId uint64, epoch uint64) (*t.EpochTableRow, error)
+}
+
+type epochData struct {
+	Epoch                   uint64        `db:"epoch"`
+	Finalized               sql.NullBool  `db:"finalized"`
+	BlocksCount             uint64        `db:"blockscount"`
+	ProposerSlashingsCount  uint64        `db:"proposerslashingscount"`
+	AttesterSlashingsCount  uint64        `db:"attesterslashingscount"`
+	AttestationsCount       uint64        `db:"attestationscount"`
+	DepositsCount           uint64        `db:"depositscount"`
+	WithdrawalCount         uint64        `db:"withdrawalcount"`
+	VoluntaryExitsCount     uint64        `db:"voluntaryexitscount"`
+	ValidatorsCount         uint64        `db:"validatorscount"`
+	AverageValidatorBalance int64         `db:"averagevalidatorbalance"`
+	TotalValidatorBalance   int64         `db:"totalvalidatorbalance"`
+	EligibleEther           sql.NullInt64 `db:"eligibleether"`
+	GlobalParticipationRate float64       `db:"globalparticipationrate"`
+	VotedEther              sql.NullInt64 `db:"votedether"`
+	ProposedBlocks          uint64        `db:"proposed_blocks"`
+	MissedBlocks            uint64        `db:"missed_blocks"`
+	OrphanedBlocks          uint64        `db:"orphaned_blocks"`
+	ScheduledBlocks         uint64        `db:"scheduled_blocks"`
+}
+
+const epochsQuery = `
+	SELECT
+		e.epoch,
+		e.finalized,
+		e.blockscount,
+		e.proposerslashingscount,
+		e.attesterslashingscount,
+		e.attestationscount,
+		e.depositscount,
+		e.withdrawalcount,
+		e.voluntaryexitscount,
+		e.validatorscount,
+		e.averagevalidatorbalance,
+		e.totalvalidatorbalance,
+		e.eligibleether,
+		COALESCE(e.globalparticipationrate, 0) AS globalparticipationrate,
+		e.votedether,
+		COALESCE(b.proposed_blocks, 0) AS proposed_blocks,
+		COALESCE(b.missed_blocks, 0) AS missed_blocks,
+		COALESCE(b.orphaned_blocks, 0) AS orphaned_blocks,
+		COALESCE(b.scheduled_blocks, 0) AS scheduled_blocks
+	FROM epochs e
+	LEFT JOIN LATERAL (
+		SELECT
+			COUNT(*) FILTER (WHERE status = '1') AS proposed_blocks,
+			COUNT(*) FILTER (WHERE status = '2') AS missed_blocks,
+			COUNT(*) FILTER (WHERE status = '3') AS orphaned_blocks,
+			COUNT(*) FILTER (WHERE status = '0') AS scheduled_blocks
+		FROM blocks
+		WHERE blocks.epoch = e.epoch
+	) b ON TRUE`
+
+func (row epochData) toTableRow() t.EpochTableRow {
+	return t.EpochTableRow{
+		Epoch:             row.Epoch,
+		Time:              utils.EpochToTime(row.Epoch).Unix(),
+		Finalized:         row.Finalized.Bool,
+		ProposedBlocks:    row.ProposedBlocks,
+		MissedBlocks:      row.MissedBlocks,
+		OrphanedBlocks:    row.OrphanedBlocks,
+		ScheduledBlocks:   row.ScheduledBlocks,
+		Attestations:      row.AttestationsCount,
+		Deposits:          row.DepositsCount,
+		Withdrawals:       row.WithdrawalCount,
+		VoluntaryExits:    row.VoluntaryExitsCount,
+		ProposerSlashings: row.ProposerSlashingsCount,
+		AttesterSlashings: row.AttesterSlashingsCount,
+		Validators:        row.ValidatorsCount,
+		AverageBalance:    utils.GWeiToWei(big.NewInt(row.AverageValidatorBalance)),
+		TotalBalance:      utils.GWeiToWei(big.NewInt(row.TotalValidatorBalance)),
+		EligibleEther:     utils.GWeiToWei(big.NewInt(row.EligibleEther.Int64)),
+		VotedEther:        utils.GWeiToWei(big.NewInt(row.VotedEther.Int64)),
+		Participation:     row.GlobalParticipationRate,
+	}
+}
+
+func (d *DataAccessService) GetEpochs(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.EpochTableRow, *t.Paging, error) {
+	result := make([]t.EpochTableRow, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.EpochsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.EpochsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as EpochsCursor: %w", err)
+		}
+	}
+
+	// Newest epochs first
+	sortSearchDirection := "<"
+	sortSearchOrder := " DESC"
+	if currentCursor.IsReverse() {
+		sortSearchDirection = ">"
+		sortSearchOrder = " ASC"
+	}
+
+	queryParams := []interface{}{}
+	whereQuery := ""
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.Epoch)
+		whereQuery = fmt.Sprintf(" WHERE e.epoch %s $%d", sortSearchDirection, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := epochsQuery + whereQuery + " ORDER BY e.epoch" + sortSearchOrder + fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []epochData
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	cursorData := make([]t.EpochsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		result = append(result, row.toTableRow())
+		cursorData = append(cursorData, t.EpochsCursor{Epoch: row.Epoch})
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetEpoch(ctx context.Context, chainId uint64, epoch uint64) (*t.EpochTableRow, error) {
+	var row epochData
+	err := d.readerDb.GetContext(ctx, &row, epochsQuery+" WHERE e.epoch = $1", epoch)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: epoch %d", ErrNotFound, epoch)
+		}
+		return nil, err
+	}
+	result := row.toTableRow()
+	return &result, nil
+}
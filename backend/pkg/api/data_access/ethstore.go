@@ -0,0 +1,223 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+type EthStoreRepository interface {
+	GetLatestEthStoreDay(ctx context.Context) (uint64, error)
+	GetEthStoreDay(ctx context.Context, chainId uint64, day uint64) (*t.EthStoreDay, error)
+	// startDay and endDay are inclusive, days are sorted from new to old
+	GetEthStoreDays(ctx context.Context, chainId uint64, startDay, endDay uint64) ([]t.EthStoreDay, error)
+	GetEthStoreComparison(ctx context.Context, chainId uint64, validators []t.VDBValidator, startDay, endDay uint64) (*t.EthStoreComparison, error)
+	GetValidatorDashboardEthStoreComparison(ctx context.Context, dashboardId t.VDBId, groupId int64, startDay, endDay uint64) (*t.EthStoreComparison, error)
+}
+
+// the exporter stores the network wide ETH.STORE of a day as validator -1
+const ethStoreNetworkValidator = -1
+
+type ethStoreData struct {
+	Day              uint64          `db:"day"`
+	Validators       uint64          `db:"validators"`
+	EffectiveBalance decimal.Decimal `db:"effective_balances_sum_wei"`
+	StartBalance     decimal.Decimal `db:"start_balances_sum_wei"`
+	EndBalance       decimal.Decimal `db:"end_balances_sum_wei"`
+	Deposits         decimal.Decimal `db:"deposits_sum_wei"`
+	TxFees           decimal.Decimal `db:"tx_fees_sum_wei"`
+	ConsensusRewards decimal.Decimal `db:"consensus_rewards_sum_wei"`
+	TotalRewards     decimal.Decimal `db:"total_rewards_wei"`
+	Apr              float64         `db:"apr"`
+}
+
+const ethStoreQuery = `
+	SELECT
+		day,
+		effective_balances_sum_wei,
+		start_balances_sum_wei,
+		end_balances_sum_wei,
+		deposits_sum_wei,
+		tx_fees_sum_wei,
+		consensus_rewards_sum_wei,
+		total_rewards_wei,
+		apr
+	FROM eth_store_stats`
+
+func (row ethStoreData) toEthStoreDay() t.EthStoreDay {
+	return t.EthStoreDay{
+		Day:              row.Day,
+		Time:             utils.DayToTime(int64(row.Day)).Unix(),
+		Apr:              row.Apr,
+		ConsensusApr:     ethStoreApr(row.ConsensusRewards, row.EffectiveBalance),
+		ExecutionApr:     ethStoreApr(row.TxFees, row.EffectiveBalance),
+		EffectiveBalance: row.EffectiveBalance,
+		StartBalance:     row.StartBalance,
+		EndBalance:       row.EndBalance,
+		Deposits:         row.Deposits,
+		ConsensusRewards: row.ConsensusRewards,
+		ExecutionRewards: row.TxFees,
+		TotalRewards:     row.TotalRewards,
+	}
+}
+
+// ethStoreApr annualizes the rewards of one or more days, the effective balance is summed up over the same days
+func ethStoreApr(rewards, effectiveBalance decimal.Decimal) float64 {
+	if effectiveBalance.IsZero() {
+		return 0
+	}
+	return rewards.Mul(decimal.NewFromInt(365)).Div(effectiveBalance).InexactFloat64()
+}
+
+func (d *DataAccessService) GetLatestEthStoreDay(ctx context.Context) (uint64, error) {
+	var day sql.NullInt64
+	err := d.readerDb.GetContext(ctx, &day, `SELECT MAX(day) FROM eth_store_stats WHERE validator = $1`, ethStoreNetworkValidator)
+	if err != nil {
+		return 0, fmt.Errorf("error retrieving latest eth.store day: %w", err)
+	}
+	if !day.Valid {
+		return 0, fmt.Errorf("%w: eth.store has not been exported yet", ErrNotFound)
+	}
+	return uint64(day.Int64), nil
+}
+
+func (d *DataAccessService) GetEthStoreDay(ctx context.Context, chainId uint64, day uint64) (*t.EthStoreDay, error) {
+	// TODO: implement handling of chainid
+	var row ethStoreData
+	err := d.readerDb.GetContext(ctx, &row, ethStoreQuery+" WHERE day = $1 AND validator = $2", day, ethStoreNetworkValidator)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: eth.store of day %d", ErrNotFound, day)
+		}
+		return nil, fmt.Errorf("error retrieving eth.store of day %d: %w", day, err)
+	}
+	result := row.toEthStoreDay()
+	return &result, nil
+}
+
+func (d *DataAccessService) GetEthStoreDays(ctx context.Context, chainId uint64, startDay, endDay uint64) ([]t.EthStoreDay, error) {
+	// TODO: implement handling of chainid
+	var rows []ethStoreData
+	err := d.readerDb.SelectContext(ctx, &rows, ethStoreQuery+" WHERE day BETWEEN $1 AND $2 AND validator = $3 ORDER BY day DESC", startDay, endDay, ethStoreNetworkValidator)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving eth.store of days %d to %d: %w", startDay, endDay, err)
+	}
+	result := make([]t.EthStoreDay, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row.toEthStoreDay())
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetEthStoreComparison(ctx context.Context, chainId uint64, validators []t.VDBValidator, startDay, endDay uint64) (*t.EthStoreComparison, error) {
+	// TODO: implement handling of chainid
+	return d.getEthStoreComparison(ctx, validators, startDay, endDay)
+}
+
+func (d *DataAccessService) GetValidatorDashboardEthStoreComparison(ctx context.Context, dashboardId t.VDBId, groupId int64, startDay, endDay uint64) (*t.EthStoreComparison, error) {
+	var groupIds []uint64
+	if groupId != t.AllGroups {
+		groupIds = append(groupIds, uint64(groupId))
+	}
+	validators, err := d.getDashboardValidators(ctx, dashboardId, groupIds)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving validators of dashboard: %w", err)
+	}
+	return d.getEthStoreComparison(ctx, validators, startDay, endDay)
+}
+
+func (d *DataAccessService) getEthStoreComparison(ctx context.Context, validators []t.VDBValidator, startDay, endDay uint64) (*t.EthStoreComparison, error) {
+	result := &t.EthStoreComparison{
+		StartDay: startDay,
+		EndDay:   endDay,
+		Days:     []t.EthStoreComparisonDay{},
+	}
+
+	var validatorRows, networkRows []ethStoreData
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		if len(validators) == 0 {
+			return nil
+		}
+		err := d.readerDb.SelectContext(ctx, &validatorRows, `
+			SELECT
+				day,
+				COUNT(*) AS validators,
+				SUM(effective_balances_sum_wei) AS effective_balances_sum_wei,
+				SUM(tx_fees_sum_wei) AS tx_fees_sum_wei,
+				SUM(consensus_rewards_sum_wei) AS consensus_rewards_sum_wei,
+				SUM(total_rewards_wei) AS total_rewards_wei
+			FROM eth_store_stats
+			WHERE day BETWEEN $1 AND $2 AND validator = ANY($3)
+			GROUP BY day
+			ORDER BY day`, startDay, endDay, validators)
+		if err != nil {
+			return fmt.Errorf("error retrieving eth.store of validators: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		if len(validators) == 0 {
+			return nil
+		}
+		err := d.readerDb.GetContext(ctx, &result.Validators, `
+			SELECT COUNT(DISTINCT validator)
+			FROM eth_store_stats
+			WHERE day BETWEEN $1 AND $2 AND validator = ANY($3)`, startDay, endDay, validators)
+		if err != nil {
+			return fmt.Errorf("error retrieving eth.store validator count: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.readerDb.SelectContext(ctx, &networkRows, ethStoreQuery+" WHERE day BETWEEN $1 AND $2 AND validator = $3 ORDER BY day", startDay, endDay, ethStoreNetworkValidator)
+		if err != nil {
+			return fmt.Errorf("error retrieving eth.store of days %d to %d: %w", startDay, endDay, err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	networkAprs := make(map[uint64]float64, len(networkRows))
+	for _, row := range networkRows {
+		networkAprs[row.Day] = row.Apr
+	}
+	validatorDays := make(map[uint64]bool, len(validatorRows))
+	var effectiveBalance, networkEffectiveBalance, networkRewards decimal.Decimal
+	for _, row := range validatorRows {
+		validatorDays[row.Day] = true
+		result.Days = append(result.Days, t.EthStoreComparisonDay{
+			Day:          row.Day,
+			Validators:   row.Validators,
+			Apr:          ethStoreApr(row.TotalRewards, row.EffectiveBalance),
+			NetworkApr:   networkAprs[row.Day],
+			TotalRewards: row.TotalRewards,
+		})
+		effectiveBalance = effectiveBalance.Add(row.EffectiveBalance)
+		result.ConsensusRewards = result.ConsensusRewards.Add(row.ConsensusRewards)
+		result.ExecutionRewards = result.ExecutionRewards.Add(row.TxFees)
+		result.TotalRewards = result.TotalRewards.Add(row.TotalRewards)
+	}
+	// only days the validators have data for are compared to the network
+	for _, row := range networkRows {
+		if len(validatorDays) > 0 && !validatorDays[row.Day] {
+			continue
+		}
+		networkEffectiveBalance = networkEffectiveBalance.Add(row.EffectiveBalance)
+		networkRewards = networkRewards.Add(row.TotalRewards)
+	}
+	result.Apr = ethStoreApr(result.TotalRewards, effectiveBalance)
+	result.ConsensusApr = ethStoreApr(result.ConsensusRewards, effectiveBalance)
+	result.ExecutionApr = ethStoreApr(result.ExecutionRewards, effectiveBalance)
+	result.NetworkApr = ethStoreApr(networkRewards, networkEffectiveBalance)
+	result.Difference = result.Apr - result.NetworkApr
+	return result, nil
+}
@@ -0,0 +1,614 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/erc20"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// ExecutionRepository serves the execution layer data which the eth1indexer stores in bigtable.
+// The historic balance and supply series are read from the configured execution client and require an archive node.
+type ExecutionRepository interface {
+	GetNetworkTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error)
+	GetNetworkTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.NetworkTransactionDetail, error)
+	GetNetworkAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error)
+	// only logs of transactions sent directly to the address are covered as bigtable has no index on the emitting address
+	GetNetworkAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkEventLog, *t.Paging, error)
+	// token is optional, the ether balance is returned if it is nil
+	GetNetworkAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, token []byte, startDay, endDay uint64) ([]t.NetworkAddressBalanceHistoryRow, error)
+	GetNetworkTokenSupplyHistory(ctx context.Context, chainId uint64, token []byte, startDay, endDay uint64) ([]t.NetworkTokenSupplyHistoryRow, error)
+}
+
+const (
+	// upper bound of blocks read for a single page of the network transactions feed, protects against long runs of empty blocks
+	maxTransactionFeedBlocks = 100
+	// upper bound of address transactions read for a single page of the event logs feed
+	maxEventLogScanTransactions = 1000
+)
+
+// getExecutionBlocks reads the given execution layer blocks from bigtable concurrently
+func (d *DataAccessService) getExecutionBlocks(blockNumbers []uint64) (map[uint64]*types.Eth1Block, error) {
+	blocks := make(map[uint64]*types.Eth1Block, len(blockNumbers))
+	for _, number := range blockNumbers {
+		blocks[number] = nil
+	}
+	var mu sync.Mutex
+	wg := errgroup.Group{}
+	wg.SetLimit(10)
+	for number := range blocks {
+		wg.Go(func() error {
+			block, err := d.getExecutionBlock(number)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			blocks[number] = block
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// findBlockTransaction returns the index of the transaction within the block and the block wide index of its first log
+func findBlockTransaction(block *types.Eth1Block, hash []byte) (int, uint64, bool) {
+	logIndex := uint64(0)
+	for i, tx := range block.Transactions {
+		if bytes.Equal(tx.Hash, hash) {
+			return i, logIndex, true
+		}
+		logIndex += uint64(len(tx.Logs))
+	}
+	return 0, 0, false
+}
+
+// transactionRecipient returns the recipient of a transaction, which is the created contract for contract creations
+func transactionRecipient(tx *types.Eth1Transaction) []byte {
+	if len(tx.To) == 0 {
+		return tx.ContractAddress
+	}
+	return tx.To
+}
+
+func transactionFee(tx *types.Eth1Transaction, effectiveGasPrice *big.Int) *big.Int {
+	txFee := new(big.Int).Mul(effectiveGasPrice, new(big.Int).SetUint64(tx.GasUsed))
+	blobFee := new(big.Int).Mul(new(big.Int).SetBytes(tx.BlobGasPrice), new(big.Int).SetUint64(tx.BlobGasUsed))
+	return txFee.Add(txFee, blobFee)
+}
+
+func (d *DataAccessService) toEventLog(block *types.Eth1Block, tx *types.Eth1Transaction, log *types.Eth1Log, logIndex uint64) t.NetworkEventLog {
+	topics := make([]t.Hash, 0, len(log.Topics))
+	for _, topic := range log.Topics {
+		topics = append(topics, t.Hash(hexutil.Encode(topic)))
+	}
+	eventLog := t.NetworkEventLog{
+		Block:     block.Number,
+		Timestamp: block.Time.AsTime().Unix(),
+		TxHash:    t.Hash(hexutil.Encode(tx.Hash)),
+		LogIndex:  logIndex,
+		Address:   t.Address{Hash: t.Hash(hexutil.Encode(log.Address))},
+		Topics:    topics,
+		Data:      t.Hash(hexutil.Encode(log.Data)),
+		Removed:   log.Removed,
+	}
+	if len(log.Topics) > 0 {
+		eventLog.Event = d.bigtable.GetEventLabel(log.Topics[0])
+	}
+	return eventLog
+}
+
+// decodeTokenTransfer decodes erc20 and erc721 transfer logs, both share the same event signature but erc721 indexes the token id
+func decodeTokenTransfer(log *types.Eth1Log, logIndex uint64) (t.NetworkTokenTransfer, bool) {
+	if len(log.Topics) < 3 || !bytes.Equal(log.Topics[0], erc20.TransferTopic) {
+		return t.NetworkTokenTransfer{}, false
+	}
+	transfer := t.NetworkTokenTransfer{
+		Token:    t.Address{Hash: t.Hash(hexutil.Encode(log.Address))},
+		From:     t.Address{Hash: t.Hash(hexutil.Encode(common.BytesToAddress(log.Topics[1]).Bytes()))},
+		To:       t.Address{Hash: t.Hash(hexutil.Encode(common.BytesToAddress(log.Topics[2]).Bytes()))},
+		LogIndex: logIndex,
+	}
+	switch len(log.Topics) {
+	case 3:
+		transfer.Type = "erc20"
+		transfer.Value = decimal.NewFromBigInt(new(big.Int).SetBytes(log.Data), 0)
+	case 4:
+		transfer.Type = "erc721"
+		transfer.Value = decimal.NewFromBigInt(new(big.Int).SetBytes(log.Topics[3]), 0)
+	default:
+		return t.NetworkTokenTransfer{}, false
+	}
+	return transfer, true
+}
+
+// setTransactionEnsNames fills in the ens names of all senders and recipients of the given rows
+func setTransactionEnsNames(rows []t.NetworkTransactionTableRow) error {
+	ensMapping := make(map[string]string)
+	for _, row := range rows {
+		ensMapping[string(row.From.Hash)] = ""
+		ensMapping[string(row.To.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return err
+	}
+	for i := range rows {
+		rows[i].From.Ens = ensMapping[string(rows[i].From.Hash)]
+		rows[i].To.Ens = ensMapping[string(rows[i].To.Hash)]
+	}
+	return nil
+}
+
+func (d *DataAccessService) GetNetworkTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.NetworkTransactionsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NetworkTransactionsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NetworkTransactionsCursor: %w", err)
+		}
+		if currentCursor.IsReverse() {
+			return nil, nil, fmt.Errorf("transactions can only be paged forward")
+		}
+	}
+
+	var block uint64
+	if currentCursor.IsValid() {
+		block = currentCursor.Block
+	} else {
+		lastBlock, err := d.bigtable.GetLastBlockInBlocksTable()
+		if err != nil {
+			return nil, nil, err
+		}
+		block = uint64(lastBlock)
+	}
+
+	// read limit+1 rows to detect whether there is more data, blocks are read newest first
+	result := make([]t.NetworkTransactionTableRow, 0, limit+1)
+	cursorData := make([]t.NetworkTransactionsCursor, 0, limit+1)
+	reachedGenesis := false
+	for i := 0; i < maxTransactionFeedBlocks && uint64(len(result)) <= limit; i++ {
+		eth1Block, err := d.getExecutionBlock(block)
+		if err != nil {
+			return nil, nil, err
+		}
+		contractInteractions, err := d.bigtable.GetAddressContractInteractionsAtBlock(eth1Block)
+		if err != nil {
+			return nil, nil, err
+		}
+		baseFee := new(big.Int).SetBytes(eth1Block.BaseFee)
+		for txIndex := len(eth1Block.Transactions) - 1; txIndex >= 0 && uint64(len(result)) <= limit; txIndex-- {
+			if currentCursor.IsValid() && block == currentCursor.Block && uint64(txIndex) >= currentCursor.TxIndex {
+				continue
+			}
+			tx := eth1Block.Transactions[txIndex]
+			effectiveGasPrice, _ := getEffectiveGasPrice(tx, baseFee)
+			result = append(result, t.NetworkTransactionTableRow{
+				Success:            tx.Status == 1,
+				TxHash:             t.Hash(hexutil.Encode(tx.Hash)),
+				Method:             d.bigtable.GetMethodLabel(tx.Data, contractInteractions[txIndex]),
+				Block:              block,
+				Timestamp:          eth1Block.Time.AsTime().Unix(),
+				From:               t.Address{Hash: t.Hash(hexutil.Encode(tx.From))},
+				To:                 t.Address{Hash: t.Hash(hexutil.Encode(transactionRecipient(tx)))},
+				IsContractCreation: len(tx.To) == 0,
+				Value:              decimal.NewFromBigInt(new(big.Int).SetBytes(tx.Value), 0),
+				TxFee:              decimal.NewFromBigInt(transactionFee(tx, effectiveGasPrice), 0),
+			})
+			cursorData = append(cursorData, t.NetworkTransactionsCursor{Block: block, TxIndex: uint64(txIndex)})
+		}
+		if block == 0 {
+			reachedGenesis = true
+			break
+		}
+		block--
+	}
+
+	paging := &t.Paging{}
+	if uint64(len(result)) > limit {
+		result, cursorData = result[:limit], cursorData[:limit]
+		paging.NextCursor, err = utils.CursorToString(cursorData[len(cursorData)-1])
+	} else if !reachedGenesis {
+		// the block limit was hit before the page was filled, continue below the last block that has been read
+		paging.NextCursor, err = utils.CursorToString(t.NetworkTransactionsCursor{Block: block + 1})
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create next cursor: %w", err)
+	}
+	if err := setTransactionEnsNames(result); err != nil {
+		return nil, nil, err
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) GetNetworkTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.NetworkTransactionDetail, error) {
+	// TODO: implement handling of chainid
+	indexedTx, err := d.bigtable.GetIndexedEth1Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	if indexedTx == nil {
+		return nil, fmt.Errorf("%w: transaction %#x", ErrNotFound, hash)
+	}
+	eth1Block, err := d.getExecutionBlock(indexedTx.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	txIndex, logIndex, found := findBlockTransaction(eth1Block, hash)
+	if !found {
+		return nil, fmt.Errorf("%w: transaction %#x in block %d", ErrNotFound, hash, indexedTx.BlockNumber)
+	}
+	tx := eth1Block.Transactions[txIndex]
+
+	interaction := types.CONTRACT_NONE
+	if indexedTx.IsContractCreation {
+		interaction = types.CONTRACT_CREATION
+	} else if indexedTx.InvokesContract {
+		interaction = types.CONTRACT_PRESENT
+	}
+	effectiveGasPrice, _ := getEffectiveGasPrice(tx, new(big.Int).SetBytes(eth1Block.BaseFee))
+	result := &t.NetworkTransactionDetail{
+		Success:              tx.Status == 1,
+		Error:                tx.ErrorMsg,
+		TxHash:               t.Hash(hexutil.Encode(tx.Hash)),
+		Type:                 tx.Type,
+		Block:                eth1Block.Number,
+		Timestamp:            eth1Block.Time.AsTime().Unix(),
+		Nonce:                tx.Nonce,
+		From:                 t.Address{Hash: t.Hash(hexutil.Encode(tx.From))},
+		To:                   t.Address{Hash: t.Hash(hexutil.Encode(transactionRecipient(tx)))},
+		IsContractCreation:   len(tx.To) == 0,
+		Method:               d.bigtable.GetMethodLabel(tx.Data, interaction),
+		Input:                t.Hash(hexutil.Encode(tx.Data)),
+		Value:                decimal.NewFromBigInt(new(big.Int).SetBytes(tx.Value), 0),
+		GasLimit:             tx.Gas,
+		GasUsed:              tx.GasUsed,
+		GasPrice:             decimal.NewFromBigInt(effectiveGasPrice, 0),
+		MaxFeePerGas:         decimal.NewFromBigInt(new(big.Int).SetBytes(tx.MaxFeePerGas), 0),
+		MaxPriorityFeePerGas: decimal.NewFromBigInt(new(big.Int).SetBytes(tx.MaxPriorityFeePerGas), 0),
+		BlobGasUsed:          tx.BlobGasUsed,
+		BlobGasPrice:         decimal.NewFromBigInt(new(big.Int).SetBytes(tx.BlobGasPrice), 0),
+		TxFee:                decimal.NewFromBigInt(transactionFee(tx, effectiveGasPrice), 0),
+		InternalTransactions: make([]t.NetworkInternalTransaction, 0, len(tx.Itx)),
+		TokenTransfers:       []t.NetworkTokenTransfer{},
+		Logs:                 make([]t.NetworkEventLog, 0, len(tx.Logs)),
+	}
+
+	for _, itx := range tx.Itx {
+		if itx.Path == "[]" || bytes.Equal(itx.Value, []byte{0x0}) { // skip top level and empty calls, same as the indexer
+			continue
+		}
+		result.InternalTransactions = append(result.InternalTransactions, t.NetworkInternalTransaction{
+			Type:  itx.Type,
+			From:  t.Address{Hash: t.Hash(hexutil.Encode(itx.From))},
+			To:    t.Address{Hash: t.Hash(hexutil.Encode(itx.To))},
+			Value: decimal.NewFromBigInt(new(big.Int).SetBytes(itx.Value), 0),
+			Path:  itx.Path,
+			Error: itx.ErrorMsg,
+		})
+	}
+	for i, log := range tx.Logs {
+		result.Logs = append(result.Logs, d.toEventLog(eth1Block, tx, log, logIndex+uint64(i)))
+		if transfer, ok := decodeTokenTransfer(log, logIndex+uint64(i)); ok {
+			result.TokenTransfers = append(result.TokenTransfers, transfer)
+		}
+	}
+
+	ensMapping := map[string]string{string(result.From.Hash): "", string(result.To.Hash): ""}
+	for _, itx := range result.InternalTransactions {
+		ensMapping[string(itx.From.Hash)] = ""
+		ensMapping[string(itx.To.Hash)] = ""
+	}
+	for _, transfer := range result.TokenTransfers {
+		ensMapping[string(transfer.From.Hash)] = ""
+		ensMapping[string(transfer.To.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, err
+	}
+	result.From.Ens = ensMapping[string(result.From.Hash)]
+	result.To.Ens = ensMapping[string(result.To.Hash)]
+	for i := range result.InternalTransactions {
+		result.InternalTransactions[i].From.Ens = ensMapping[string(result.InternalTransactions[i].From.Hash)]
+		result.InternalTransactions[i].To.Ens = ensMapping[string(result.InternalTransactions[i].To.Hash)]
+	}
+	for i := range result.TokenTransfers {
+		result.TokenTransfers[i].From.Ens = ensMapping[string(result.TokenTransfers[i].From.Hash)]
+		result.TokenTransfers[i].To.Ens = ensMapping[string(result.TokenTransfers[i].To.Hash)]
+	}
+	return result, nil
+}
+
+// addressIndexPrefix returns the bigtable row prefix to continue reading the time sorted index (e.g. "TX") of an address from
+func (d *DataAccessService) addressIndexPrefix(index string, address []byte, cursor string) (string, error) {
+	if cursor == "" {
+		return d.bigtable.GetAddressTimeIndexPrefix(index, address, nil), nil
+	}
+	currentCursor, err := utils.StringToCursor[t.AddressIndexCursor](cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse passed cursor as AddressIndexCursor: %w", err)
+	}
+	if currentCursor.IsReverse() {
+		return "", fmt.Errorf("address feeds can only be paged forward")
+	}
+	return currentCursor.Key, nil
+}
+
+func (d *DataAccessService) GetNetworkAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkTransactionTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	prefix, err := d.addressIndexPrefix("TX", address, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// read limit+1 rows to detect whether there is more data
+	txs, indexKeys, err := d.bigtable.GetEth1TxsForAddress(prefix, int64(limit+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving transactions of address %#x: %w", address, err)
+	}
+	paging := &t.Paging{}
+	if uint64(len(indexKeys)) > limit {
+		paging.NextCursor, err = utils.CursorToString(t.AddressIndexCursor{Key: indexKeys[limit-1]})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create next cursor: %w", err)
+		}
+		txs = txs[:min(uint64(len(txs)), limit)]
+	}
+
+	result := make([]t.NetworkTransactionTableRow, 0, len(txs))
+	for _, tx := range txs {
+		interaction := types.CONTRACT_NONE
+		if tx.IsContractCreation {
+			interaction = types.CONTRACT_CREATION
+		} else if tx.InvokesContract {
+			interaction = types.CONTRACT_PRESENT
+		}
+		txFee := new(big.Int).SetBytes(tx.TxFee)
+		txFee.Add(txFee, new(big.Int).SetBytes(tx.BlobTxFee))
+		result = append(result, t.NetworkTransactionTableRow{
+			Success:            tx.ErrorMsg == "",
+			TxHash:             t.Hash(hexutil.Encode(tx.Hash)),
+			Method:             d.bigtable.GetMethodLabel(tx.MethodId, interaction),
+			Block:              tx.BlockNumber,
+			Timestamp:          tx.Time.AsTime().Unix(),
+			From:               t.Address{Hash: t.Hash(hexutil.Encode(tx.From))},
+			To:                 t.Address{Hash: t.Hash(hexutil.Encode(tx.To))},
+			IsContractCreation: tx.IsContractCreation,
+			Value:              decimal.NewFromBigInt(new(big.Int).SetBytes(tx.Value), 0),
+			TxFee:              decimal.NewFromBigInt(txFee, 0),
+		})
+	}
+	if err := setTransactionEnsNames(result); err != nil {
+		return nil, nil, err
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) GetNetworkAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.NetworkEventLog, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	prefix, err := d.addressIndexPrefix("TX", address, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// transactions are consumed as a whole so the page only exceeds the limit if a single transaction emits more logs than that
+	result := make([]t.NetworkEventLog, 0, limit)
+	hasMore := false
+	for scanned := 0; !hasMore && uint64(len(result)) < limit; {
+		if scanned >= maxEventLogScanTransactions {
+			// continue the scan on the next page
+			hasMore = true
+			break
+		}
+		txs, indexKeys, err := d.bigtable.GetEth1TxsForAddress(prefix, int64(limit))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error retrieving transactions of address %#x: %w", address, err)
+		}
+		if len(indexKeys) == 0 {
+			break
+		}
+		scanned += len(indexKeys)
+
+		blockNumbers := make([]uint64, 0, len(txs))
+		for _, tx := range txs {
+			blockNumbers = append(blockNumbers, tx.BlockNumber)
+		}
+		blocks, err := d.getExecutionBlocks(blockNumbers)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the index keys can only be matched to the transactions if no transaction data is missing
+		aligned := len(txs) == len(indexKeys)
+		for i, indexedTx := range txs {
+			block := blocks[indexedTx.BlockNumber]
+			txIndex, logIndex, found := findBlockTransaction(block, indexedTx.Hash)
+			if !found {
+				return nil, nil, fmt.Errorf("transaction %#x not found in block %d", indexedTx.Hash, indexedTx.BlockNumber)
+			}
+			tx := block.Transactions[txIndex]
+			logs := make([]t.NetworkEventLog, 0)
+			for j, log := range tx.Logs {
+				if bytes.Equal(log.Address, address) {
+					logs = append(logs, d.toEventLog(block, tx, log, logIndex+uint64(j)))
+				}
+			}
+			if aligned && len(result) > 0 && uint64(len(result)+len(logs)) > limit {
+				hasMore = true
+				break
+			}
+			result = append(result, logs...)
+			if aligned {
+				prefix = indexKeys[i]
+			}
+		}
+		if !aligned {
+			prefix = indexKeys[len(indexKeys)-1]
+		}
+		if len(indexKeys) < int(limit) {
+			break
+		}
+		if uint64(len(result)) >= limit {
+			hasMore = true
+		}
+	}
+
+	paging := &t.Paging{}
+	if hasMore {
+		paging.NextCursor, err = utils.CursorToString(t.AddressIndexCursor{Key: prefix})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create next cursor: %w", err)
+		}
+	}
+	return result, paging, nil
+}
+
+type dailyBlock struct {
+	Day   uint64 `db:"day"`
+	Slot  uint64 `db:"slot"`
+	Block uint64 `db:"exec_block_number"`
+}
+
+// getLastBlocksOfDays returns the last canonical execution block of each day in the inclusive range, days before the merge are omitted
+func (d *DataAccessService) getLastBlocksOfDays(ctx context.Context, startDay, endDay uint64) ([]dailyBlock, error) {
+	days := make([]int64, 0, endDay-startDay+1)
+	slots := make([]int64, 0, endDay-startDay+1)
+	for day := startDay; day <= endDay; day++ {
+		_, lastEpoch := utils.GetFirstAndLastEpochForDay(day)
+		days = append(days, int64(day))
+		slots = append(slots, int64((lastEpoch+1)*utils.Config.Chain.ClConfig.SlotsPerEpoch-1))
+	}
+	var result []dailyBlock
+	err := d.readerDb.SelectContext(ctx, &result, `
+		SELECT
+			d.day,
+			b.slot,
+			b.exec_block_number
+		FROM unnest($1::int[], $2::int[]) AS d(day, slot)
+		CROSS JOIN LATERAL (
+			SELECT slot, exec_block_number
+			FROM blocks
+			WHERE slot <= d.slot AND status = '1' AND exec_block_number > 0
+			ORDER BY slot DESC
+			LIMIT 1
+		) b
+		ORDER BY d.day`, pq.Array(days), pq.Array(slots))
+	return result, err
+}
+
+// limits the archive node calls of all requests, every day of a series needs one call
+var historicStateSemaphore = semaphore.NewWeighted(20)
+
+// getDailyState reads a value of the execution layer state at the end of every day in the inclusive range.
+// cacheKey identifies the value, the state of finalized blocks doesn't change so it is cached per block.
+func (d *DataAccessService) getDailyState(ctx context.Context, cacheKey string, startDay, endDay uint64, read func(ctx context.Context, block uint64) (*big.Int, error)) ([]dailyBlock, []*big.Int, error) {
+	if d.erigonClient == nil {
+		return nil, nil, fmt.Errorf("%w: no execution client configured, historic state is unavailable", ErrUnavailable)
+	}
+	blocks, err := d.getLastBlocksOfDays(ctx, startDay, endDay)
+	if err != nil {
+		return nil, nil, err
+	}
+	finalizedSlot := (cache.LatestFinalizedEpoch.Get() + 1) * utils.Config.Chain.ClConfig.SlotsPerEpoch
+	values := make([]*big.Int, len(blocks))
+	wg, ctx := errgroup.WithContext(ctx)
+	wg.SetLimit(10)
+	for i, block := range blocks {
+		wg.Go(func() error {
+			isFinalized := block.Slot < finalizedSlot && cache.TieredCache != nil
+			key := fmt.Sprintf("%d:api:historic_state:%s:%d", utils.Config.Chain.ClConfig.DepositChainID, cacheKey, block.Block)
+			if isFinalized {
+				if cached, err := cache.TieredCache.GetStringWithLocalTimeout(key, time.Hour); err == nil {
+					if value, ok := new(big.Int).SetString(cached, 10); ok {
+						values[i] = value
+						return nil
+					}
+				}
+			}
+
+			if err := historicStateSemaphore.Acquire(ctx, 1); err != nil {
+				return err
+			}
+			value, err := read(ctx, block.Block)
+			historicStateSemaphore.Release(1)
+			if err != nil {
+				return fmt.Errorf("error reading state at block %d: %w", block.Block, err)
+			}
+			values[i] = value
+
+			if isFinalized {
+				if err := cache.TieredCache.SetString(key, value.String(), utils.Day); err != nil {
+					log.Error(err, "error caching historic state", 0, log.Fields{"key": key})
+				}
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return blocks, values, nil
+}
+
+func (d *DataAccessService) GetNetworkAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, token []byte, startDay, endDay uint64) ([]t.NetworkAddressBalanceHistoryRow, error) {
+	// TODO: implement handling of chainid
+	blocks, balances, err := d.getDailyState(ctx, fmt.Sprintf("balance:%x:%x", address, token), startDay, endDay, func(ctx context.Context, block uint64) (*big.Int, error) {
+		if token == nil {
+			return d.erigonClient.GetNativeBalanceAt(ctx, address, block)
+		}
+		return d.erigonClient.GetERC20TokenBalanceAt(ctx, address, token, block)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.NetworkAddressBalanceHistoryRow, 0, len(blocks))
+	for i, block := range blocks {
+		result = append(result, t.NetworkAddressBalanceHistoryRow{
+			Day:       block.Day,
+			Timestamp: utils.SlotToTime(block.Slot).Unix(),
+			Block:     block.Block,
+			Balance:   decimal.NewFromBigInt(balances[i], 0),
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetNetworkTokenSupplyHistory(ctx context.Context, chainId uint64, token []byte, startDay, endDay uint64) ([]t.NetworkTokenSupplyHistoryRow, error) {
+	// TODO: implement handling of chainid
+	blocks, supplies, err := d.getDailyState(ctx, fmt.Sprintf("supply:%x", token), startDay, endDay, func(ctx context.Context, block uint64) (*big.Int, error) {
+		return d.erigonClient.GetERC20TotalSupplyAt(ctx, token, block)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.NetworkTokenSupplyHistoryRow, 0, len(blocks))
+	for i, block := range blocks {
+		result = append(result, t.NetworkTokenSupplyHistoryRow{
+			Day:         block.Day,
+			Timestamp:   utils.SlotToTime(block.Slot).Unix(),
+			Block:       block.Block,
+			TotalSupply: decimal.NewFromBigInt(supplies[i], 0),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,224 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type GasRepository interface {
+	// the history of stored predictions is only included if requested
+	GetNetworkGasNow(ctx context.Context, chainId uint64, includeHistory bool) (*t.NetworkGasNow, error)
+	// hourly series are computed from the indexed execution blocks, daily series are read from the precomputed chart series
+	GetNetworkGasLimitHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error)
+	GetNetworkGasUsedHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error)
+}
+
+func (d *DataAccessService) GetNetworkGasNow(ctx context.Context, chainId uint64, includeHistory bool) (*t.NetworkGasNow, error) {
+	// TODO: implement handling of chainid
+	gasNow, err := d.services.GetCurrentGasNow()
+	if err != nil {
+		return nil, err
+	}
+	toFee := func(priorityFee *big.Int) t.GasNowFee {
+		return t.GasNowFee{
+			PriorityFee: decimal.NewFromBigInt(priorityFee, 0),
+			GasPrice:    decimal.NewFromBigInt(new(big.Int).Add(gasNow.NextBaseFee, priorityFee), 0),
+		}
+	}
+	result := &t.NetworkGasNow{
+		Timestamp:   gasNow.Timestamp.Unix(),
+		Block:       gasNow.Block,
+		BaseFee:     decimal.NewFromBigInt(gasNow.BaseFee, 0),
+		NextBaseFee: decimal.NewFromBigInt(gasNow.NextBaseFee, 0),
+		Slow:        toFee(gasNow.PriorityFees[0]),
+		Standard:    toFee(gasNow.PriorityFees[1]),
+		Fast:        toFee(gasNow.PriorityFees[2]),
+		Rapid:       toFee(gasNow.PriorityFees[3]),
+	}
+	if !includeHistory {
+		return result, nil
+	}
+
+	now := time.Now()
+	history, err := d.bigtable.GetGasNowHistory(now, now.Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	result.History = make([]t.GasNowHistoryPoint, 0, len(history))
+	for _, point := range history {
+		result.History = append(result.History, t.GasNowHistoryPoint{
+			Timestamp: point.Ts.Unix(),
+			Slow:      decimal.NewFromBigInt(point.Slow, 0),
+			Standard:  decimal.NewFromBigInt(point.Standard, 0),
+			Fast:      decimal.NewFromBigInt(point.Fast, 0),
+			Rapid:     decimal.NewFromBigInt(point.Rapid, 0),
+		})
+	}
+	return result, nil
+}
+
+type gasChartPoint struct {
+	Timestamp       time.Time
+	AverageGasLimit decimal.Decimal
+	TotalGasUsed    decimal.Decimal
+	AverageGasUsed  decimal.Decimal
+}
+
+// getGasChartPoints returns one point per hour or day whose start lies within the given inclusive timestamp range
+func (d *DataAccessService) getGasChartPoints(ctx context.Context, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) ([]gasChartPoint, error) {
+	switch aggregation {
+	case enums.IntervalHourly:
+		return d.getHourlyGasChartPoints(ctx, afterTs, beforeTs)
+	case enums.IntervalDaily:
+		return d.getDailyGasChartPoints(ctx, afterTs, beforeTs)
+	default:
+		return nil, fmt.Errorf("unsupported gas chart aggregation %s", aggregation.ToString())
+	}
+}
+
+func (d *DataAccessService) getHourlyGasChartPoints(ctx context.Context, afterTs, beforeTs uint64) ([]gasChartPoint, error) {
+	start := time.Unix(int64(afterTs), 0).Truncate(time.Hour)
+	if start.Unix() < int64(afterTs) {
+		start = start.Add(time.Hour)
+	}
+	end := time.Unix(int64(beforeTs), 0).Truncate(time.Hour).Add(time.Hour) // exclusive
+	if !start.Before(end) {
+		return []gasChartPoint{}, nil
+	}
+
+	// blocks are looked up via the consensus layer, hours before the merge have no data
+	var blockRange struct {
+		Low  sql.NullInt64 `db:"low"`
+		High sql.NullInt64 `db:"high"`
+	}
+	err := d.readerDb.GetContext(ctx, &blockRange, `
+		SELECT
+			(SELECT exec_block_number FROM blocks WHERE slot >= $1 AND status = '1' AND exec_block_number > 0 ORDER BY slot LIMIT 1) AS low,
+			(SELECT exec_block_number FROM blocks WHERE slot < $2 AND status = '1' AND exec_block_number > 0 ORDER BY slot DESC LIMIT 1) AS high`,
+		utils.TimeToSlot(uint64(start.Unix())), utils.TimeToSlot(uint64(end.Unix())))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if !blockRange.Low.Valid || !blockRange.High.Valid || blockRange.High.Int64 < blockRange.Low.Int64 {
+		return []gasChartPoint{}, nil
+	}
+
+	blocks, err := d.bigtable.GetBlocksDescending(uint64(blockRange.High.Int64), uint64(blockRange.High.Int64-blockRange.Low.Int64+1))
+	if err != nil {
+		return nil, err
+	}
+	type hourTotals struct {
+		gasLimit, gasUsed uint64
+		blocks            int64
+	}
+	totals := make(map[int64]*hourTotals)
+	for _, block := range blocks {
+		blockTime := block.Time.AsTime()
+		if blockTime.Before(start) || !blockTime.Before(end) {
+			continue
+		}
+		hour := blockTime.Truncate(time.Hour).Unix()
+		if totals[hour] == nil {
+			totals[hour] = &hourTotals{}
+		}
+		totals[hour].gasLimit += block.GasLimit
+		totals[hour].gasUsed += block.GasUsed
+		totals[hour].blocks++
+	}
+
+	result := make([]gasChartPoint, 0, len(totals))
+	for hour, total := range totals {
+		blockCount := decimal.NewFromInt(total.blocks)
+		gasUsed := decimal.NewFromInt(int64(total.gasUsed))
+		result = append(result, gasChartPoint{
+			Timestamp:       time.Unix(hour, 0),
+			AverageGasLimit: decimal.NewFromInt(int64(total.gasLimit)).Div(blockCount),
+			TotalGasUsed:    gasUsed,
+			AverageGasUsed:  gasUsed.Div(blockCount),
+		})
+	}
+	slices.SortFunc(result, func(a, b gasChartPoint) int { return a.Timestamp.Compare(b.Timestamp) })
+	return result, nil
+}
+
+func (d *DataAccessService) getDailyGasChartPoints(ctx context.Context, afterTs, beforeTs uint64) ([]gasChartPoint, error) {
+	var rows []struct {
+		Time      time.Time       `db:"time"`
+		Indicator string          `db:"indicator"`
+		Value     decimal.Decimal `db:"value"`
+	}
+	err := d.readerDb.SelectContext(ctx, &rows, `
+		SELECT time, indicator, value
+		FROM chart_series
+		WHERE indicator = ANY($1) AND time >= to_timestamp($2) AT TIME ZONE 'UTC' AND time <= to_timestamp($3) AT TIME ZONE 'UTC'
+		ORDER BY time`, pq.Array([]string{"AVG_GASLIMIT", "TOTAL_GASUSED", "AVG_GASUSED"}), afterTs, beforeTs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]gasChartPoint, 0, len(rows))
+	for _, row := range rows {
+		if len(result) == 0 || !result[len(result)-1].Timestamp.Equal(row.Time) {
+			result = append(result, gasChartPoint{Timestamp: row.Time})
+		}
+		point := &result[len(result)-1]
+		switch row.Indicator {
+		case "AVG_GASLIMIT":
+			point.AverageGasLimit = row.Value
+		case "TOTAL_GASUSED":
+			point.TotalGasUsed = row.Value
+		case "AVG_GASUSED":
+			point.AverageGasUsed = row.Value
+		}
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetNetworkGasLimitHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error) {
+	// TODO: implement handling of chainid
+	points, err := d.getGasChartPoints(ctx, aggregation, afterTs, beforeTs)
+	if err != nil {
+		return nil, err
+	}
+	result := &t.ChartData[string, decimal.Decimal]{
+		Categories: make([]uint64, 0, len(points)),
+		Series:     []t.ChartSeries[string, decimal.Decimal]{{Id: "average", Data: make([]decimal.Decimal, 0, len(points))}},
+	}
+	for _, point := range points {
+		result.Categories = append(result.Categories, uint64(point.Timestamp.Unix()))
+		result.Series[0].Data = append(result.Series[0].Data, point.AverageGasLimit)
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetNetworkGasUsedHistory(ctx context.Context, chainId uint64, aggregation enums.ChartAggregation, afterTs, beforeTs uint64) (*t.ChartData[string, decimal.Decimal], error) {
+	// TODO: implement handling of chainid
+	points, err := d.getGasChartPoints(ctx, aggregation, afterTs, beforeTs)
+	if err != nil {
+		return nil, err
+	}
+	result := &t.ChartData[string, decimal.Decimal]{
+		Categories: make([]uint64, 0, len(points)),
+		Series: []t.ChartSeries[string, decimal.Decimal]{
+			{Id: "total", Data: make([]decimal.Decimal, 0, len(points))},
+			{Id: "average", Data: make([]decimal.Decimal, 0, len(points))},
+		},
+	}
+	for _, point := range points {
+		result.Categories = append(result.Categories, uint64(point.Timestamp.Unix()))
+		result.Series[0].Data = append(result.Series[0].Data, point.TotalGasUsed)
+		result.Series[1].Data = append(result.Series[1].Data, point.AverageGasUsed)
+	}
+	return result, nil
+}
@@ -1,9 +1,13 @@
 package dataaccess
 
 import (
+	"database/sql"
+	"fmt"
+
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/cache"
 	"github.com/gobitfly/beaconchain/pkg/commons/price"
+	"github.com/pkg/errors"
 )
 
 func (d *DataAccessService) GetLatestSlot() (uint64, error) {
@@ -17,13 +21,48 @@ func (d *DataAccessService) GetLatestFinalizedEpoch() (uint64, error) {
 }
 
 func (d *DataAccessService) GetLatestBlock() (uint64, error) {
-	// @DATA-ACCESS implement
-	return d.dummy.GetLatestBlock()
+	var latestBlock uint64
+	err := d.readerDb.Get(&latestBlock, `SELECT COALESCE(MAX(exec_block_number), 0) FROM blocks WHERE status = '1'`)
+	return latestBlock, err
 }
 
 func (d *DataAccessService) GetBlockHeightAt(slot uint64) (uint64, error) {
-	// @DATA-ACCESS implement; return error if no block at slot
-	return d.dummy.GetBlockHeightAt(slot)
+	var block sql.NullInt64
+	err := d.readerDb.Get(&block, `SELECT exec_block_number FROM blocks WHERE slot = $1 AND status = '1'`, slot)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	if !block.Valid {
+		return 0, fmt.Errorf("%w: no block at slot %d", ErrNotFound, slot)
+	}
+	return uint64(block.Int64), nil
+}
+
+// GetBlockHeightByHash resolves an execution block hash or a beacon block root to the execution block number
+func (d *DataAccessService) GetBlockHeightByHash(hash []byte) (uint64, error) {
+	var block sql.NullInt64
+	err := d.readerDb.Get(&block, `
+		SELECT exec_block_number FROM blocks WHERE exec_block_hash = $1 AND status = '1'
+		UNION
+		SELECT exec_block_number FROM blocks WHERE blockroot = $1 AND status = '1'
+		LIMIT 1`, hash)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	if !block.Valid {
+		return 0, fmt.Errorf("%w: no block with hash %#x", ErrNotFound, hash)
+	}
+	return uint64(block.Int64), nil
+}
+
+// GetSlotByBlockRoot resolves a beacon block root to its slot
+func (d *DataAccessService) GetSlotByBlockRoot(blockRoot []byte) (uint64, error) {
+	var slot uint64
+	err := d.readerDb.Get(&slot, `SELECT slot FROM blocks WHERE blockroot = $1 AND status = '1' LIMIT 1`, blockRoot)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: no slot with block root %#x", ErrNotFound, blockRoot)
+	}
+	return slot, err
 }
 
 func (d *DataAccessService) GetLatestExchangeRates() ([]t.EthConversionRate, error) {
@@ -0,0 +1,275 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+// MultisigRepository serves Safe (formerly Gnosis Safe) multisig wallets from the events the eth1indexer stores in bigtable
+type MultisigRepository interface {
+	GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error)
+	GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigTransaction, *t.Paging, error)
+	// returns the confirmations of a safe transaction, identified by its safe tx hash
+	GetMultisigTransactionConfirmations(ctx context.Context, address []byte, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error)
+}
+
+const (
+	// upper bound of setup, owner and threshold change events replayed to get the current configuration of a safe
+	maxMultisigConfigEvents = 1000
+	// upper bound of executions and approvals read for a single safe tx hash
+	maxMultisigTxEvents = 100
+)
+
+var multisigOperations = map[uint8]string{0: "call", 1: "delegate_call"}
+
+type multisigConfig struct {
+	setup     *types.Eth1SafeEventIndexed
+	owners    [][]byte
+	threshold uint64
+}
+
+// getMultisigConfig replays the config events of a safe, addresses without an indexed setup are not considered safes
+func (d *DataAccessService) getMultisigConfig(address []byte) (*multisigConfig, error) {
+	events, _, err := d.bigtable.GetSafeEvents(d.bigtable.GetAddressTimeIndexPrefix(db.SAFE_CONFIG_INDEX, address, nil), maxMultisigConfigEvents)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config events of safe %#x: %w", address, err)
+	}
+	// events are sorted from new to old, replay them starting at the setup
+	slices.Reverse(events)
+	setupIdx := slices.IndexFunc(events, func(e *types.Eth1SafeEventIndexed) bool { return e.Type == types.SafeEventSetup })
+	if setupIdx == -1 {
+		return nil, fmt.Errorf("%w: safe %#x", ErrNotFound, address)
+	}
+	setup := events[setupIdx]
+	owners := slices.Clone(setup.Owners)
+	threshold := setup.Threshold
+	// swapOwner emits RemovedOwner directly followed by AddedOwner, the new owner takes the position of the old one
+	var removed *types.Eth1SafeEventIndexed
+	removedIdx := 0
+	for _, event := range events[setupIdx+1:] {
+		previous := removed
+		removed = nil
+		switch event.Type {
+		case types.SafeEventAddedOwner:
+			if previous != nil && bytes.Equal(previous.TxHash, event.TxHash) && previous.LogIndex+1 == event.LogIndex {
+				owners = slices.Insert(owners, removedIdx, event.Owner)
+			} else {
+				// added owners are prepended to the owner list of the safe
+				owners = slices.Insert(owners, 0, event.Owner)
+			}
+		case types.SafeEventRemovedOwner:
+			idx := slices.IndexFunc(owners, func(o []byte) bool { return bytes.Equal(o, event.Owner) })
+			if idx != -1 {
+				owners = slices.Delete(owners, idx, idx+1)
+				removed, removedIdx = event, idx
+			}
+		case types.SafeEventChangedThreshold:
+			threshold = event.Threshold
+		}
+	}
+	return &multisigConfig{setup: setup, owners: owners, threshold: threshold}, nil
+}
+
+func (d *DataAccessService) GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error) {
+	config, err := d.getMultisigConfig(address)
+	if err != nil {
+		return nil, err
+	}
+	setup, owners, threshold := config.setup, config.owners, config.threshold
+
+	result := &t.MultisigSafe{
+		Address:           t.Address{Hash: t.Hash(hexutil.Encode(address))},
+		Owners:            make([]t.Address, 0, len(owners)),
+		Threshold:         threshold,
+		Creator:           t.Address{Hash: t.Hash(hexutil.Encode(setup.TxFrom))},
+		FallbackHandler:   t.Address{Hash: t.Hash(hexutil.Encode(setup.FallbackHandler))},
+		CreationBlock:     setup.BlockNumber,
+		CreationTimestamp: setup.Time.Unix(),
+		CreationTxHash:    t.Hash(hexutil.Encode(setup.TxHash)),
+	}
+	for _, owner := range owners {
+		result.Owners = append(result.Owners, t.Address{Hash: t.Hash(hexutil.Encode(owner))})
+	}
+
+	addresses := []*t.Address{&result.Address, &result.Creator, &result.FallbackHandler}
+	for i := range result.Owners {
+		addresses = append(addresses, &result.Owners[i])
+	}
+	if err := setAddressEnsNames(addresses); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigTransaction, *t.Paging, error) {
+	config, err := d.getMultisigConfig(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix, err := d.addressIndexPrefix(db.SAFE_INDEX, address, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// read limit+1 rows to detect whether there is more data
+	executions, indexKeys, err := d.bigtable.GetSafeEvents(prefix, int64(limit+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving transactions of safe %#x: %w", address, err)
+	}
+	paging := &t.Paging{}
+	if uint64(len(indexKeys)) > limit {
+		paging.NextCursor, err = utils.CursorToString(t.AddressIndexCursor{Key: indexKeys[limit-1]})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create next cursor: %w", err)
+		}
+		executions = executions[:min(uint64(len(executions)), limit)]
+	}
+
+	result := make([]t.MultisigTransaction, len(executions))
+	wg := errgroup.Group{}
+	wg.SetLimit(10)
+	for i, execution := range executions {
+		wg.Go(func() error {
+			events, _, err := d.bigtable.GetSafeEvents(d.bigtable.GetSafeTxIndexPrefix(address, execution.SafeTxHash), maxMultisigTxEvents)
+			if err != nil {
+				return fmt.Errorf("error retrieving events of safe transaction %#x: %w", execution.SafeTxHash, err)
+			}
+			result[i] = d.toMultisigTransaction(execution, toMultisigConfirmations(address, config.owners, execution, events))
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	addresses := make([]*t.Address, 0, len(result))
+	for i := range result {
+		addresses = append(addresses, &result[i].Executor)
+		if result[i].To != nil {
+			addresses = append(addresses, result[i].To)
+		}
+		for j := range result[i].Confirmations {
+			addresses = append(addresses, &result[i].Confirmations[j].Owner)
+		}
+	}
+	if err := setAddressEnsNames(addresses); err != nil {
+		return nil, nil, err
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) GetMultisigTransactionConfirmations(ctx context.Context, address []byte, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error) {
+	config, err := d.getMultisigConfig(address)
+	if err != nil {
+		return nil, err
+	}
+	events, _, err := d.bigtable.GetSafeEvents(d.bigtable.GetSafeTxIndexPrefix(address, safeTxHash), maxMultisigTxEvents)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving events of safe transaction %#x: %w", safeTxHash, err)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: safe transaction %#x of safe %#x", ErrNotFound, safeTxHash, address)
+	}
+	var execution *types.Eth1SafeEventIndexed
+	for _, event := range events {
+		if (event.Type == types.SafeEventExecutionSuccess || event.Type == types.SafeEventExecutionFailure) && bytes.Equal(event.Safe, address) {
+			execution = event
+			break
+		}
+	}
+
+	result := toMultisigConfirmations(address, config.owners, execution, events)
+	addresses := make([]*t.Address, 0, len(result))
+	for i := range result {
+		addresses = append(addresses, &result[i].Owner)
+	}
+	if err := setAddressEnsNames(addresses); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) toMultisigTransaction(execution *types.Eth1SafeEventIndexed, confirmations []t.MultisigTransactionConfirmation) t.MultisigTransaction {
+	tx := t.MultisigTransaction{
+		SafeTxHash:    t.Hash(hexutil.Encode(execution.SafeTxHash)),
+		TxHash:        t.Hash(hexutil.Encode(execution.TxHash)),
+		Block:         execution.BlockNumber,
+		Timestamp:     execution.Time.Unix(),
+		Success:       execution.Type == types.SafeEventExecutionSuccess,
+		Executor:      t.Address{Hash: t.Hash(hexutil.Encode(execution.TxFrom))},
+		Payment:       decimal.NewFromBigInt(new(big.Int).SetBytes(execution.Payment), 0),
+		Confirmations: confirmations,
+	}
+	if execution.To != nil {
+		tx.To = &t.Address{Hash: t.Hash(hexutil.Encode(execution.To))}
+		value := decimal.NewFromBigInt(new(big.Int).SetBytes(execution.Value), 0)
+		tx.Value = &value
+		tx.Operation = multisigOperations[execution.Operation]
+		interaction := types.CONTRACT_NONE
+		if len(execution.MethodId) > 0 {
+			interaction = types.CONTRACT_PRESENT
+		}
+		tx.Method = d.bigtable.GetMethodLabel(execution.MethodId, interaction)
+	}
+	return tx
+}
+
+// toMultisigConfirmations merges the on-chain approvals of a safe transaction with the signers of its execution (if executed), one confirmation per owner.
+// Only events of the safe itself and confirmations of its current owners are taken into account.
+func toMultisigConfirmations(safe []byte, owners [][]byte, execution *types.Eth1SafeEventIndexed, events []*types.Eth1SafeEventIndexed) []t.MultisigTransactionConfirmation {
+	result := []t.MultisigTransactionConfirmation{}
+	seen := make(map[string]bool)
+	add := func(owner []byte, confirmationType types.SafeSignatureType, event *types.Eth1SafeEventIndexed) {
+		key := string(owner)
+		if seen[key] || !bytes.Equal(event.Safe, safe) || !slices.ContainsFunc(owners, func(o []byte) bool { return bytes.Equal(o, owner) }) {
+			return
+		}
+		seen[key] = true
+		result = append(result, t.MultisigTransactionConfirmation{
+			Owner:     t.Address{Hash: t.Hash(hexutil.Encode(owner))},
+			Type:      string(confirmationType),
+			Block:     event.BlockNumber,
+			Timestamp: event.Time.Unix(),
+			TxHash:    t.Hash(hexutil.Encode(event.TxHash)),
+		})
+	}
+
+	// events are sorted from new to old, list the approvals in chronological order
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == types.SafeEventApproveHash {
+			add(events[i].Owner, types.SafeSignatureApprovedHash, events[i])
+		}
+	}
+	if execution != nil {
+		// approved hash signers without an approval event are the executor approving via msg.sender
+		for _, signer := range execution.Signers {
+			add(signer.Owner, signer.Type, execution)
+		}
+	}
+	return result
+}
+
+func setAddressEnsNames(addresses []*t.Address) error {
+	ensMapping := make(map[string]string, len(addresses))
+	for _, address := range addresses {
+		ensMapping[string(address.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		address.Ens = ensMapping[string(address.Hash)]
+	}
+	return nil
+}
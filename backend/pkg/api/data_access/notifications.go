@@ -2,18 +2,34 @@ package dataaccess
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	utilMath "github.com/protolambda/zrnt/eth2/util/math"
+	"github.com/shopspring/decimal"
+	"golang.org/x/exp/maps"
+	"golang.org/x/sync/errgroup"
 )
 
 type NotificationsRepository interface {
 	GetNotificationOverview(ctx context.Context, userId uint64) (*t.NotificationOverviewData, error)
 
 	GetDashboardNotifications(ctx context.Context, userId uint64, chainId uint64, cursor string, colSort t.Sort[enums.NotificationDashboardsColumn], search string, limit uint64) ([]t.NotificationDashboardsTableRow, *t.Paging, error)
-	// depending on how notifications are implemented, we may need to use something other than `notificationId` for identifying the notification
-	GetValidatorDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationValidatorDashboardDetail, error)
-	GetAccountDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationAccountDashboardDetail, error)
+	// `notificationId` is the `notification_id` of a row returned by GetDashboardNotifications
+	GetValidatorDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationValidatorDashboardDetail, error)
+	GetAccountDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationAccountDashboardDetail, error)
 
 	GetMachineNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationMachinesColumn], search string, limit uint64) ([]t.NotificationMachinesTableRow, *t.Paging, error)
 	GetClientNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationClientsColumn], search string, limit uint64) ([]t.NotificationClientsTableRow, *t.Paging, error)
@@ -23,61 +39,1718 @@ type NotificationsRepository interface {
 	GetNotificationSettings(ctx context.Context, userId uint64) (*t.NotificationSettings, error)
 	UpdateNotificationSettingsGeneral(ctx context.Context, userId uint64, settings t.NotificationSettingsGeneral) error
 	UpdateNotificationSettingsNetworks(ctx context.Context, userId uint64, chainId uint64, settings t.NotificationSettingsNetwork) error
-	UpdateNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string, name string, IsNotificationsEnabled bool) error
-	DeleteNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string) error
+	UpdateNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string, name string, IsNotificationsEnabled bool) error
+	DeleteNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string) error
 	GetNotificationSettingsDashboards(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationSettingsDashboardColumn], search string, limit uint64) ([]t.NotificationSettingsDashboardsTableRow, *t.Paging, error)
 	UpdateNotificationSettingsValidatorDashboard(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, settings t.NotificationSettingsValidatorDashboard) error
 	UpdateNotificationSettingsAccountDashboard(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, settings t.NotificationSettingsAccountDashboard) error
+
+	GetWebhookDeliveries(ctx context.Context, userId uint64, cursor string, limit uint64) ([]t.NotificationWebhookDelivery, *t.Paging, error)
+
+	GetNotificationPushTokens(ctx context.Context, userId uint64) ([]string, error)
+	GetNotificationWebhookSecret(ctx context.Context, userId uint64, webhookUrl string) (string, error)
+	// increments the number of test notifications sent by the user in the current window and returns the new count and the time until the window resets
+	IncrementNotificationTestCount(ctx context.Context, userId uint64, window time.Duration) (uint64, time.Duration, error)
+}
+
+// event names as stored in the notification history mapped to the event types of the api
+var (
+	dashboardNotificationEventTypes = map[types.EventName]string{
+		types.ValidatorIsOfflineEventName:          "validator_offline",
+		types.ValidatorGroupIsOfflineEventName:     "group_offline",
+		types.ValidatorMissedAttestationEventName:  "attestation_missed",
+		types.ValidatorExecutedProposalEventName:   "proposal_success",
+		types.ValidatorMissedProposalEventName:     "proposal_missed",
+		types.ValidatorUpcomingProposalEventName:   "proposal_upcoming",
+		types.SyncCommitteeSoon:                    "sync",
+		types.ValidatorReceivedWithdrawalEventName: "withdrawal",
+		types.ValidatorGotSlashedEventName:         "got_slashed",
+		types.ValidatorDidSlashEventName:           "has_slashed",
+		types.IncomingTransactionEventName:         "incoming_tx",
+		types.OutgoingTransactionEventName:         "outgoing_tx",
+		types.ERC20TokenTransferEventName:          "transfer_erc20",
+		types.ERC721TokenTransferEventName:         "transfer_erc721",
+		types.ERC1155TokenTransferEventName:        "transfer_erc1155",
+	}
+	machineNotificationEventTypes = map[types.EventName]string{
+		types.MonitoringMachineOfflineEventName:        "offline",
+		types.MonitoringMachineDiskAlmostFullEventName: "storage",
+		types.MonitoringMachineCpuLoadEventName:        "cpu",
+		types.MonitoringMachineMemoryUsageEventName:    "memory",
+	}
+	rocketPoolNotificationEventTypes = map[types.EventName]string{
+		types.RocketpoolNewClaimRoundStartedEventName: "reward_round",
+		types.RocketpoolCollateralMaxReached:          "collateral_max",
+		types.RocketpoolCollateralMinReached:          "collateral_min",
+	}
+	networkNotificationEventTypes = map[types.EventName]string{
+		types.NetworkGasAboveThresholdEventName:          "gas_above",
+		types.NetworkGasBelowThresholdEventName:          "gas_below",
+		types.NetworkParticipationRateThresholdEventName: "participation_rate",
+	}
+)
+
+// gas thresholds are stored in gwei as the threshold column is a float
+var gasThresholdFactor = decimal.NewFromInt(1e9)
+
+type notificationDashboardGroup struct {
+	IsAccountDashboard     bool           `db:"-"`
+	DashboardId            uint64         `db:"dashboard_id"`
+	GroupId                uint64         `db:"group_id"`
+	GroupName              string         `db:"group_name"`
+	WebhookTarget          sql.NullString `db:"webhook_target"`
+	WebhookFormat          sql.NullString `db:"webhook_format"`
+	RealtimeNotifications  bool           `db:"realtime_notifications"`
+	IgnoreSpamTransactions bool           `db:"ignore_spam_transactions"`
+	SubscribedChainIds     pq.Int64Array  `db:"subscribed_chain_ids"`
+}
+
+type notificationDashboardGroupKey struct {
+	IsAccountDashboard bool
+	DashboardId        uint64
+	GroupId            uint64
+}
+
+type notificationHistoryRow struct {
+	Id             uint64    `db:"id"`
+	EventName      string    `db:"event_name"`
+	EventFilter    string    `db:"event_filter"`
+	EventThreshold float64   `db:"event_threshold"`
+	CreatedTs      time.Time `db:"created_ts"`
+}
+
+type notificationSubscription struct {
+	EventName      string  `db:"event_name"`
+	EventFilter    string  `db:"event_filter"`
+	EventThreshold float64 `db:"event_threshold"`
+}
+
+// splitSubscriptionEventName splits a stored event name into the network and the event name, the network is empty for events that aren't network specific
+func splitSubscriptionEventName(eventName string) (string, types.EventName) {
+	network, name, found := strings.Cut(eventName, ":")
+	if !found {
+		return "", types.EventName(eventName)
+	}
+	return network, types.EventName(name)
+}
+
+func networkEventName(network string, eventName types.EventName) string {
+	return network + ":" + string(eventName)
+}
+
+// getNotificationNetworkName returns the network name used as prefix for the event names of network specific subscriptions
+func (d *DataAccessService) getNotificationNetworkName(chainId uint64) (string, error) {
+	if chainId == utils.Config.Chain.ClConfig.DepositChainID {
+		return utils.GetNetwork(), nil
+	}
+	networks, err := d.GetAllNetworks()
+	if err != nil {
+		return "", err
+	}
+	for _, network := range networks {
+		if network.ChainId != chainId {
+			continue
+		}
+		if network.Name == "ethereum" {
+			return "mainnet", nil
+		}
+		return network.Name, nil
+	}
+	return "", fmt.Errorf("%w: network with chain id %d", ErrNotFound, chainId)
+}
+
+func (d *DataAccessService) getNotificationDashboardGroups(ctx context.Context, userId uint64) ([]notificationDashboardGroup, error) {
+	var valGroups, accGroups []notificationDashboardGroup
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		err := d.alloyReader.SelectContext(ctx, &valGroups, `
+			SELECT
+				g.dashboard_id,
+				g.id AS group_id,
+				g.name AS group_name,
+				g.webhook_target,
+				g.webhook_format,
+				g.realtime_notifications
+			FROM users_val_dashboards_groups g
+			INNER JOIN users_val_dashboards d ON d.id = g.dashboard_id
+			WHERE d.user_id = $1`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting validator dashboard groups: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.alloyReader.SelectContext(ctx, &accGroups, `
+			SELECT
+				g.dashboard_id,
+				g.id AS group_id,
+				g.name AS group_name,
+				g.webhook_target,
+				g.webhook_format,
+				g.ignore_spam_transactions,
+				g.subscribed_chain_ids
+			FROM users_acc_dashboards_groups g
+			INNER JOIN users_acc_dashboards d ON d.id = g.dashboard_id
+			WHERE d.user_id = $1`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting account dashboard groups: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	for i := range accGroups {
+		accGroups[i].IsAccountDashboard = true
+	}
+	return append(valGroups, accGroups...), nil
+}
+
+func (d *DataAccessService) getNotificationChannels(ctx context.Context, userId uint64) (map[types.NotificationChannel]bool, error) {
+	// channels are enabled unless explicitly disabled
+	result := map[types.NotificationChannel]bool{
+		types.EmailNotificationChannel:          true,
+		types.PushNotificationChannel:           true,
+		types.WebhookNotificationChannel:        true,
+		types.WebhookDiscordNotificationChannel: true,
+	}
+	var channels []struct {
+		Channel types.NotificationChannel `db:"channel"`
+		Active  bool                      `db:"active"`
+	}
+	err := d.userReader.SelectContext(ctx, &channels, `SELECT channel, active FROM users_notification_channels WHERE user_id = $1`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification channels: %w", err)
+	}
+	for _, channel := range channels {
+		result[channel.Channel] = channel.Active
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) getUserSubscriptions(ctx context.Context, userId uint64) ([]notificationSubscription, error) {
+	var subs []notificationSubscription
+	err := d.userReader.SelectContext(ctx, &subs, `
+		SELECT event_name, event_filter, COALESCE(event_threshold, 0) AS event_threshold
+		FROM users_subscriptions
+		WHERE user_id = $1`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscriptions: %w", err)
+	}
+	return subs, nil
 }
 
 func (d *DataAccessService) GetNotificationOverview(ctx context.Context, userId uint64) (*t.NotificationOverviewData, error) {
-	return d.dummy.GetNotificationOverview(ctx, userId)
+	response := t.NotificationOverviewData{}
+	var groups []notificationDashboardGroup
+	var mostNotified []struct {
+		DashboardId        uint64 `db:"dashboard_id"`
+		GroupId            uint64 `db:"group_id"`
+		IsAccountDashboard bool   `db:"is_account_dashboard"`
+	}
+
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		channels, err := d.getNotificationChannels(ctx, userId)
+		if err != nil {
+			return err
+		}
+		response.IsEmailNotificationsEnabled = channels[types.EmailNotificationChannel]
+		response.IsPushNotificationsEnabled = channels[types.PushNotificationChannel]
+		return nil
+	})
+	wg.Go(func() error {
+		var counts []struct {
+			Channel types.NotificationChannel `db:"channel"`
+			Count   uint64                    `db:"count"`
+		}
+		err := d.userReader.SelectContext(ctx, &counts, `
+			SELECT channel, COUNT(*) AS count
+			FROM notification_queue
+			WHERE user_id = $1 AND sent > NOW() - INTERVAL '24 hours'
+			GROUP BY channel`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting sent notification counts: %w", err)
+		}
+		for _, count := range counts {
+			switch count.Channel {
+			case types.EmailNotificationChannel:
+				response.Last24hEmailsCount += count.Count
+			case types.PushNotificationChannel:
+				response.Last24hPushCount += count.Count
+			case types.WebhookNotificationChannel, types.WebhookDiscordNotificationChannel:
+				response.Last24hWebhookCount += count.Count
+			}
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		subs, err := d.getUserSubscriptions(ctx, userId)
+		if err != nil {
+			return err
+		}
+		vdbGroups := make(map[string]struct{})
+		adbGroups := make(map[string]struct{})
+		machines := make(map[string]struct{})
+		for _, sub := range subs {
+			_, eventName := splitSubscriptionEventName(sub.EventName)
+			switch {
+			case strings.HasPrefix(sub.EventFilter, types.ValidatorDashboardEventPrefix+":"):
+				vdbGroups[sub.EventFilter] = struct{}{}
+			case strings.HasPrefix(sub.EventFilter, types.AccountDashboardEventPrefix+":"):
+				adbGroups[sub.EventFilter] = struct{}{}
+			case eventName == types.EthClientUpdateEventName:
+				response.ClientsSubscriptionCount++
+			}
+			if _, ok := machineNotificationEventTypes[eventName]; ok {
+				machines[sub.EventFilter] = struct{}{}
+			}
+			if _, ok := rocketPoolNotificationEventTypes[eventName]; ok {
+				response.RocketPoolSubscriptionCount++
+			}
+			if _, ok := networkNotificationEventTypes[eventName]; ok {
+				response.NetworksSubscriptionCount++
+			}
+		}
+		response.VDBSubscriptionsCount = uint64(len(vdbGroups))
+		response.ADBSubscriptionsCount = uint64(len(adbGroups))
+		response.MachinesSubscriptionCount = uint64(len(machines))
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		groups, err = d.getNotificationDashboardGroups(ctx, userId)
+		return err
+	})
+	wg.Go(func() error {
+		err := d.userReader.SelectContext(ctx, &mostNotified, `
+			SELECT dashboard_id, group_id, is_account_dashboard
+			FROM users_notifications_history
+			WHERE user_id = $1 AND dashboard_id IS NOT NULL AND created_ts > NOW() - INTERVAL '24 hours'
+			GROUP BY dashboard_id, group_id, is_account_dashboard
+			ORDER BY COUNT(*) DESC`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting most notified groups: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	groupNames := make(map[notificationDashboardGroupKey]string, len(groups))
+	for _, group := range groups {
+		groupNames[notificationDashboardGroupKey{group.IsAccountDashboard, group.DashboardId, group.GroupId}] = group.GroupName
+	}
+	var vdbIdx, adbIdx int
+	for _, group := range mostNotified {
+		name, ok := groupNames[notificationDashboardGroupKey{group.IsAccountDashboard, group.DashboardId, group.GroupId}]
+		if !ok {
+			// group has been deleted in the meantime
+			continue
+		}
+		if group.IsAccountDashboard && adbIdx < len(response.ADBMostNotifiedGroups) {
+			response.ADBMostNotifiedGroups[adbIdx] = name
+			adbIdx++
+		} else if !group.IsAccountDashboard && vdbIdx < len(response.VDBMostNotifiedGroups) {
+			response.VDBMostNotifiedGroups[vdbIdx] = name
+			vdbIdx++
+		}
+	}
+
+	return &response, nil
 }
+
 func (d *DataAccessService) GetDashboardNotifications(ctx context.Context, userId uint64, chainId uint64, cursor string, colSort t.Sort[enums.NotificationDashboardsColumn], search string, limit uint64) ([]t.NotificationDashboardsTableRow, *t.Paging, error) {
-	return d.dummy.GetDashboardNotifications(ctx, userId, chainId, cursor, colSort, search, limit)
+	result := make([]t.NotificationDashboardsTableRow, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.NotificationsDashboardsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NotificationsDashboardsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NotificationsDashboardsCursor: %w", err)
+		}
+	}
+
+	// Notifications are only collected for the network of this instance
+	if chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return result, &paging, nil
+	}
+
+	groups, err := d.getNotificationDashboardGroups(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	groupNames := make(map[notificationDashboardGroupKey]string, len(groups))
+	searchDashboardIds := make([]int64, 0)
+	searchGroupIds := make([]int64, 0)
+	for _, group := range groups {
+		groupNames[notificationDashboardGroupKey{group.IsAccountDashboard, group.DashboardId, group.GroupId}] = group.GroupName
+		if search != "" && strings.Contains(strings.ToLower(group.GroupName), strings.ToLower(search)) {
+			searchDashboardIds = append(searchDashboardIds, int64(group.DashboardId))
+			searchGroupIds = append(searchGroupIds, int64(group.GroupId))
+		}
+	}
+	if search != "" && len(searchDashboardIds) == 0 {
+		return result, &paging, nil
+	}
+
+	// Prepare the sorting
+	sortSearchDirection := ">"
+	sortSearchOrder := " ASC"
+	if (colSort.Desc && !currentCursor.IsReverse()) || (!colSort.Desc && currentCursor.IsReverse()) {
+		sortSearchDirection = "<"
+		sortSearchOrder = " DESC"
+	}
+
+	// All notifications of a group in the same epoch are shown as one row
+	queryParams := []interface{}{userId}
+	groupedQuery := `
+		SELECT
+			MIN(id) AS notification_id,
+			dashboard_id,
+			group_id,
+			epoch,
+			is_account_dashboard,
+			COUNT(DISTINCT validator_index) AS entity_count,
+			ARRAY_AGG(DISTINCT event_name) AS event_names
+		FROM users_notifications_history
+		WHERE user_id = $1 AND dashboard_id IS NOT NULL`
+	if search != "" {
+		queryParams = append(queryParams, pq.Array(searchDashboardIds), pq.Array(searchGroupIds))
+		groupedQuery += fmt.Sprintf(" AND (dashboard_id, group_id) IN (SELECT * FROM UNNEST($%d::BIGINT[], $%d::INT[]))", len(queryParams)-1, len(queryParams))
+	}
+	groupedQuery += " GROUP BY dashboard_id, group_id, epoch, is_account_dashboard"
+
+	// The chain id is the same for all rows so it is sorted like the timestamp
+	sortColNames := [2]string{"epoch", "dashboard_id"}
+	sortColCursors := [2]interface{}{currentCursor.Epoch, currentCursor.DashboardId}
+	if colSort.Column == enums.NotificationsDashboardsColumns.DashboardId {
+		sortColNames[0], sortColNames[1] = sortColNames[1], sortColNames[0]
+		sortColCursors[0], sortColCursors[1] = sortColCursors[1], sortColCursors[0]
+	}
+
+	whereQuery := ""
+	if currentCursor.IsValid() {
+		// If we have a valid cursor only check the results before/after it
+		queryParams = append(queryParams, sortColCursors[0], sortColCursors[1], currentCursor.NotificationId)
+		whereQuery = fmt.Sprintf(" WHERE (%[1]s, %[2]s, notification_id) %[3]s ($%[4]d, $%[5]d, $%[6]d)",
+			sortColNames[0], sortColNames[1], sortSearchDirection, len(queryParams)-2, len(queryParams)-1, len(queryParams))
+	}
+	orderQuery := fmt.Sprintf(" ORDER BY %[1]s%[3]s, %[2]s%[3]s, notification_id%[3]s", sortColNames[0], sortColNames[1], sortSearchOrder)
+
+	queryParams = append(queryParams, limit+1)
+	limitQuery := fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		NotificationId     uint64         `db:"notification_id"`
+		DashboardId        uint64         `db:"dashboard_id"`
+		GroupId            uint64         `db:"group_id"`
+		Epoch              uint64         `db:"epoch"`
+		IsAccountDashboard bool           `db:"is_account_dashboard"`
+		EntityCount        uint64         `db:"entity_count"`
+		EventNames         pq.StringArray `db:"event_names"`
+	}
+	query := "SELECT * FROM (" + groupedQuery + ") n" + whereQuery + orderQuery + limitQuery
+	err = d.userReader.SelectContext(ctx, &queryResult, query, queryParams...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting dashboard notifications: %w", err)
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(queryResult) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		queryResult = queryResult[:len(queryResult)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(queryResult)
+	}
+
+	for _, row := range queryResult {
+		eventTypes := make([]string, 0, len(row.EventNames))
+		for _, eventName := range row.EventNames {
+			if eventType, ok := dashboardNotificationEventTypes[types.EventName(eventName)]; ok {
+				eventTypes = append(eventTypes, eventType)
+			}
+		}
+		result = append(result, t.NotificationDashboardsTableRow{
+			IsAccountDashboard: row.IsAccountDashboard,
+			ChainId:            chainId,
+			Timestamp:          utils.EpochToTime(row.Epoch).Unix(),
+			DashboardId:        row.DashboardId,
+			GroupName:          groupNames[notificationDashboardGroupKey{row.IsAccountDashboard, row.DashboardId, row.GroupId}],
+			NotificationId:     row.NotificationId,
+			EntityCount:        row.EntityCount,
+			EventTypes:         eventTypes,
+		})
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(queryResult, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+
+	return result, p, nil
+}
+
+type dashboardNotificationHistoryRow struct {
+	EventName      types.EventName `db:"event_name"`
+	DashboardId    uint64          `db:"dashboard_id"`
+	GroupId        uint64          `db:"group_id"`
+	ValidatorIndex sql.NullInt64   `db:"validator_index"`
+	Slot           sql.NullInt64   `db:"slot"`
+}
+
+// getDashboardNotificationHistory returns all history entries that are shown as the given row of the dashboard notifications table
+func (d *DataAccessService) getDashboardNotificationHistory(ctx context.Context, userId uint64, notificationId string, isAccountDashboard bool) ([]dashboardNotificationHistoryRow, error) {
+	id, err := strconv.ParseUint(notificationId, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: notification with id %s", ErrNotFound, notificationId)
+	}
+
+	var rows []dashboardNotificationHistoryRow
+	err = d.userReader.SelectContext(ctx, &rows, `
+		SELECT h.event_name, h.dashboard_id, h.group_id, h.validator_index, h.slot
+		FROM users_notifications_history n
+		INNER JOIN users_notifications_history h ON
+			h.user_id = n.user_id AND
+			h.dashboard_id = n.dashboard_id AND
+			h.group_id = n.group_id AND
+			h.epoch = n.epoch AND
+			h.is_account_dashboard = n.is_account_dashboard
+		WHERE n.id = $1 AND n.user_id = $2 AND n.is_account_dashboard = $3
+		ORDER BY h.id`, id, userId, isAccountDashboard)
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification history for notification %d: %w", id, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: notification with id %d", ErrNotFound, id)
+	}
+	return rows, nil
+}
+
+func appendIndexBlock(indexBlocks []t.IndexBlocks, index uint64, slot sql.NullInt64) []t.IndexBlocks {
+	i := slices.IndexFunc(indexBlocks, func(ib t.IndexBlocks) bool { return ib.Index == index })
+	if i == -1 {
+		indexBlocks = append(indexBlocks, t.IndexBlocks{Index: index, Blocks: make([]uint64, 0)})
+		i = len(indexBlocks) - 1
+	}
+	if slot.Valid {
+		indexBlocks[i].Blocks = append(indexBlocks[i].Blocks, uint64(slot.Int64))
+	}
+	return indexBlocks
+}
+
+func (d *DataAccessService) GetValidatorDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationValidatorDashboardDetail, error) {
+	rows, err := d.getDashboardNotificationHistory(ctx, userId, notificationId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	result := t.NotificationValidatorDashboardDetail{
+		ValidatorOffline:         make([]uint64, 0),
+		GroupOffline:             make([]t.NotificationEventGroup, 0),
+		ProposalMissed:           make([]t.IndexBlocks, 0),
+		ProposalDone:             make([]t.IndexBlocks, 0),
+		UpcomingProposals:        make([]uint64, 0),
+		Slashed:                  make([]uint64, 0),
+		HasSlashed:               make([]uint64, 0),
+		SyncCommittee:            make([]uint64, 0),
+		AttestationMissed:        make([]t.IndexBlocks, 0),
+		Withdrawal:               make([]t.IndexBlocks, 0),
+		ValidatorOfflineReminder: make([]uint64, 0),
+		GroupOfflineReminder:     make([]t.NotificationEventGroup, 0),
+		ValidatorBackOnline:      make([]t.NotificationEventValidatorBackOnline, 0),
+		GroupBackOnline:          make([]t.NotificationEventGroupBackOnline, 0),
+	}
+
+	for _, row := range rows {
+		index := uint64(row.ValidatorIndex.Int64)
+		switch row.EventName {
+		case types.ValidatorIsOfflineEventName:
+			result.ValidatorOffline = append(result.ValidatorOffline, index)
+		case types.ValidatorGroupIsOfflineEventName:
+			var groupName string
+			err := d.alloyReader.GetContext(ctx, &groupName, `SELECT name FROM users_val_dashboards_groups WHERE dashboard_id = $1 AND id = $2`, row.DashboardId, row.GroupId)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("error getting group name: %w", err)
+			}
+			result.GroupOffline = append(result.GroupOffline, t.NotificationEventGroup{
+				GroupName:   groupName,
+				DashboardID: row.DashboardId,
+			})
+		case types.ValidatorMissedProposalEventName:
+			result.ProposalMissed = appendIndexBlock(result.ProposalMissed, index, row.Slot)
+		case types.ValidatorExecutedProposalEventName:
+			result.ProposalDone = appendIndexBlock(result.ProposalDone, index, row.Slot)
+		case types.ValidatorUpcomingProposalEventName:
+			if row.Slot.Valid {
+				result.UpcomingProposals = append(result.UpcomingProposals, uint64(row.Slot.Int64))
+			}
+		case types.ValidatorGotSlashedEventName:
+			result.Slashed = append(result.Slashed, index)
+		case types.ValidatorDidSlashEventName:
+			result.HasSlashed = append(result.HasSlashed, index)
+		case types.SyncCommitteeSoon:
+			result.SyncCommittee = append(result.SyncCommittee, index)
+		case types.ValidatorMissedAttestationEventName:
+			result.AttestationMissed = appendIndexBlock(result.AttestationMissed, index, row.Slot)
+		case types.ValidatorReceivedWithdrawalEventName:
+			result.Withdrawal = appendIndexBlock(result.Withdrawal, index, row.Slot)
+		}
+	}
+
+	return &result, nil
 }
 
-func (d *DataAccessService) GetValidatorDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationValidatorDashboardDetail, error) {
-	return d.dummy.GetValidatorDashboardNotificationDetails(ctx, notificationId)
+func (d *DataAccessService) GetAccountDashboardNotificationDetails(ctx context.Context, userId uint64, notificationId string) (*t.NotificationAccountDashboardDetail, error) {
+	// the history does not contain transaction details yet, only make sure the notification exists
+	_, err := d.getDashboardNotificationHistory(ctx, userId, notificationId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t.NotificationAccountDashboardDetail{
+		IncomingTransactions:  make([]t.NotificationEventExecution, 0),
+		OutgoingTransactions:  make([]t.NotificationEventExecution, 0),
+		ERC20TokenTransfers:   make([]t.NotificationEventExecution, 0),
+		ERC721TokenTransfers:  make([]t.NotificationEventExecution, 0),
+		ERC1155TokenTransfers: make([]t.NotificationEventExecution, 0),
+	}, nil
 }
 
-func (d *DataAccessService) GetAccountDashboardNotificationDetails(ctx context.Context, notificationId string) (*t.NotificationAccountDashboardDetail, error) {
-	return d.dummy.GetAccountDashboardNotificationDetails(ctx, notificationId)
+// getNotificationHistory returns a page of the notification history of the user for the given events.
+// The rows are sorted by sortColName (empty to sort by time only) and the id.
+func (d *DataAccessService) getNotificationHistory(ctx context.Context, userId uint64, eventNames []types.EventName, cursor string, sortColName string, desc bool, search string, limit uint64) ([]notificationHistoryRow, *t.Paging, error) {
+	result := make([]notificationHistoryRow, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.NotificationsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NotificationsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NotificationsCursor: %w", err)
+		}
+	}
+
+	// Prepare the sorting
+	sortSearchDirection := ">"
+	sortSearchOrder := " ASC"
+	if (desc && !currentCursor.IsReverse()) || (!desc && currentCursor.IsReverse()) {
+		sortSearchDirection = "<"
+		sortSearchOrder = " DESC"
+	}
+
+	names := make([]string, 0, len(eventNames))
+	for _, eventName := range eventNames {
+		names = append(names, string(eventName))
+	}
+	queryParams := []interface{}{userId, pq.Array(names)}
+	query := `
+		SELECT id, event_name, event_filter, event_threshold, created_ts
+		FROM users_notifications_history
+		WHERE user_id = $1 AND event_name = ANY($2)`
+
+	if search != "" {
+		queryParams = append(queryParams, search+"%")
+		query += fmt.Sprintf(" AND event_filter ILIKE $%d", len(queryParams))
+	}
+
+	var sortColCursor interface{}
+	switch sortColName {
+	case "event_name":
+		sortColCursor = currentCursor.EventName
+	case "event_filter":
+		sortColCursor = currentCursor.EventFilter
+	case "event_threshold":
+		sortColCursor = currentCursor.EventThreshold
+	}
+
+	orderQuery := ""
+	if sortColName == "" {
+		// The ids are increasing with the creation time
+		if currentCursor.IsValid() {
+			queryParams = append(queryParams, currentCursor.Id)
+			query += fmt.Sprintf(" AND id%s$%d", sortSearchDirection, len(queryParams))
+		}
+		orderQuery = " ORDER BY id" + sortSearchOrder
+	} else {
+		if currentCursor.IsValid() {
+			queryParams = append(queryParams, sortColCursor, currentCursor.Id)
+			query += fmt.Sprintf(" AND (%[1]s%[2]s$%[3]d OR (%[1]s=$%[3]d AND id%[2]s$%[4]d))",
+				sortColName, sortSearchDirection, len(queryParams)-1, len(queryParams))
+		}
+		orderQuery = fmt.Sprintf(" ORDER BY %[1]s%[2]s, id%[2]s", sortColName, sortSearchOrder)
+	}
+
+	queryParams = append(queryParams, limit+1)
+	query += orderQuery + fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	err = d.userReader.SelectContext(ctx, &result, query, queryParams...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting notification history: %w", err)
+	}
+	if len(result) == 0 {
+		return result, &paging, nil
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(result, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+
+	return result, p, nil
 }
 
 func (d *DataAccessService) GetMachineNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationMachinesColumn], search string, limit uint64) ([]t.NotificationMachinesTableRow, *t.Paging, error) {
-	return d.dummy.GetMachineNotifications(ctx, userId, cursor, colSort, search, limit)
+	sortColName := ""
+	switch colSort.Column {
+	case enums.NotificationsMachinesColumns.MachineName:
+		sortColName = "event_filter"
+	case enums.NotificationsMachinesColumns.Threshold:
+		sortColName = "event_threshold"
+	case enums.NotificationsMachinesColumns.EventType:
+		sortColName = "event_name"
+	}
+
+	rows, paging, err := d.getNotificationHistory(ctx, userId, maps.Keys(machineNotificationEventTypes), cursor, sortColName, colSort.Desc, search, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NotificationMachinesTableRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, t.NotificationMachinesTableRow{
+			MachineName: row.EventFilter,
+			Threshold:   row.EventThreshold,
+			EventType:   machineNotificationEventTypes[types.EventName(row.EventName)],
+			Timestamp:   row.CreatedTs.Unix(),
+		})
+	}
+	return result, paging, nil
 }
+
 func (d *DataAccessService) GetClientNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationClientsColumn], search string, limit uint64) ([]t.NotificationClientsTableRow, *t.Paging, error) {
-	return d.dummy.GetClientNotifications(ctx, userId, cursor, colSort, search, limit)
+	sortColName := ""
+	if colSort.Column == enums.NotificationsClientsColumns.ClientName {
+		sortColName = "event_filter"
+	}
+
+	rows, paging, err := d.getNotificationHistory(ctx, userId, []types.EventName{types.EthClientUpdateEventName}, cursor, sortColName, colSort.Desc, strings.ToLower(search), limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NotificationClientsTableRow, 0, len(rows))
+	for _, row := range rows {
+		// the history does not contain the released version
+		result = append(result, t.NotificationClientsTableRow{
+			ClientName: row.EventFilter,
+			Timestamp:  row.CreatedTs.Unix(),
+		})
+	}
+	return result, paging, nil
 }
+
 func (d *DataAccessService) GetRocketPoolNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationRocketPoolColumn], search string, limit uint64) ([]t.NotificationRocketPoolTableRow, *t.Paging, error) {
-	return d.dummy.GetRocketPoolNotifications(ctx, userId, cursor, colSort, search, limit)
+	sortColName := ""
+	switch colSort.Column {
+	case enums.NotificationRocketPoolColumns.EventType:
+		sortColName = "event_name"
+	case enums.NotificationRocketPoolColumns.NodeAddress:
+		sortColName = "event_filter"
+	}
+
+	search = strings.TrimPrefix(strings.ToLower(search), "0x")
+	rows, paging, err := d.getNotificationHistory(ctx, userId, maps.Keys(rocketPoolNotificationEventTypes), cursor, sortColName, colSort.Desc, search, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NotificationRocketPoolTableRow, 0, len(rows))
+	for _, row := range rows {
+		eventName := types.EventName(row.EventName)
+		resultRow := t.NotificationRocketPoolTableRow{
+			Timestamp: row.CreatedTs.Unix(),
+			EventType: rocketPoolNotificationEventTypes[eventName],
+		}
+		if eventName != types.RocketpoolNewClaimRoundStartedEventName {
+			resultRow.AlertValue = row.EventThreshold
+			resultRow.NodeAddress = t.Hash("0x" + row.EventFilter)
+		}
+		result = append(result, resultRow)
+	}
+	return result, paging, nil
 }
+
 func (d *DataAccessService) GetNetworkNotifications(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationNetworksColumn], search string, limit uint64) ([]t.NotificationNetworksTableRow, *t.Paging, error) {
-	return d.dummy.GetNetworkNotifications(ctx, userId, cursor, colSort, search, limit)
+	sortColName := ""
+	if colSort.Column == enums.NotificationNetworksColumns.EventType {
+		sortColName = "event_name"
+	}
+
+	// there is nothing to search for in network notifications
+	rows, paging, err := d.getNotificationHistory(ctx, userId, maps.Keys(networkNotificationEventTypes), cursor, sortColName, colSort.Desc, "", limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NotificationNetworksTableRow, 0, len(rows))
+	for _, row := range rows {
+		eventName := types.EventName(row.EventName)
+		alertValue := decimal.NewFromFloat(row.EventThreshold)
+		if eventName != types.NetworkParticipationRateThresholdEventName {
+			alertValue = alertValue.Mul(gasThresholdFactor)
+		}
+		result = append(result, t.NotificationNetworksTableRow{
+			ChainId:    utils.Config.Chain.ClConfig.DepositChainID,
+			Timestamp:  row.CreatedTs.Unix(),
+			EventType:  networkNotificationEventTypes[eventName],
+			AlertValue: alertValue,
+		})
+	}
+	return result, paging, nil
 }
+
 func (d *DataAccessService) GetNotificationSettings(ctx context.Context, userId uint64) (*t.NotificationSettings, error) {
-	return d.dummy.GetNotificationSettings(ctx, userId)
+	result := t.NotificationSettings{
+		GeneralSettings: t.NotificationSettingsGeneral{
+			SubscribedClients: make([]string, 0),
+		},
+		Networks:      make([]t.NotificationNetwork, 0),
+		PairedDevices: make([]t.NotificationPairedDevice, 0),
+	}
+	var subs []notificationSubscription
+	var networks []t.NetworkInfo
+
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var doNotDisturbTs sql.NullTime
+		err := d.userReader.GetContext(ctx, &doNotDisturbTs, `SELECT notifications_do_not_disturb_ts FROM users WHERE id = $1`, userId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: user not found", ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting do not disturb timestamp: %w", err)
+		}
+		if doNotDisturbTs.Valid && doNotDisturbTs.Time.After(time.Now()) {
+			result.GeneralSettings.DoNotDisturbTimestamp = doNotDisturbTs.Time.Unix()
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		channels, err := d.getNotificationChannels(ctx, userId)
+		if err != nil {
+			return err
+		}
+		result.GeneralSettings.IsEmailNotificationsEnabled = channels[types.EmailNotificationChannel]
+		result.GeneralSettings.IsPushNotificationsEnabled = channels[types.PushNotificationChannel]
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		subs, err = d.getUserSubscriptions(ctx, userId)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		networks, err = d.GetAllNetworks()
+		return err
+	})
+	wg.Go(func() error {
+		var devices []struct {
+			Id                     string    `db:"device_identifier"`
+			CreatedTs              time.Time `db:"created_ts"`
+			Name                   string    `db:"device_name"`
+			IsNotificationsEnabled bool      `db:"notify_enabled"`
+		}
+		err := d.userReader.SelectContext(ctx, &devices, `
+			SELECT device_identifier, created_ts, device_name, notify_enabled
+			FROM users_devices
+			WHERE user_id = $1 AND active AND device_identifier IS NOT NULL
+			ORDER BY created_ts`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting paired devices: %w", err)
+		}
+		for _, device := range devices {
+			result.PairedDevices = append(result.PairedDevices, t.NotificationPairedDevice{
+				Id:                     device.Id,
+				PairedTimestamp:        device.CreatedTs.Unix(),
+				Name:                   device.Name,
+				IsNotificationsEnabled: device.IsNotificationsEnabled,
+			})
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	general := &result.GeneralSettings
+	networkSettings := make(map[string]*t.NotificationSettingsNetwork)
+	for _, sub := range subs {
+		network, eventName := splitSubscriptionEventName(sub.EventName)
+		if _, ok := networkNotificationEventTypes[eventName]; ok && networkSettings[network] == nil {
+			networkSettings[network] = &t.NotificationSettingsNetwork{}
+		}
+		switch eventName {
+		case types.MonitoringMachineOfflineEventName:
+			general.IsMachineOfflineSubscribed = true
+		case types.MonitoringMachineDiskAlmostFullEventName:
+			general.MachineStorageUsageThreshold = sub.EventThreshold
+		case types.MonitoringMachineCpuLoadEventName:
+			general.MachineCpuUsageThreshold = sub.EventThreshold
+		case types.MonitoringMachineMemoryUsageEventName:
+			general.MachineMemoryUsageThreshold = sub.EventThreshold
+		case types.EthClientUpdateEventName:
+			general.SubscribedClients = append(general.SubscribedClients, sub.EventFilter)
+		case types.RocketpoolNewClaimRoundStartedEventName:
+			general.IsRocketPoolNewRewardRoundSubscribed = true
+		case types.RocketpoolCollateralMaxReached:
+			general.RocketPoolMaxCollateralThreshold = sub.EventThreshold
+		case types.RocketpoolCollateralMinReached:
+			general.RocketPoolMinCollateralThreshold = sub.EventThreshold
+		case types.NetworkGasAboveThresholdEventName:
+			networkSettings[network].GasAboveThreshold = decimal.NewFromFloat(sub.EventThreshold).Mul(gasThresholdFactor)
+		case types.NetworkGasBelowThresholdEventName:
+			networkSettings[network].GasBelowThreshold = decimal.NewFromFloat(sub.EventThreshold).Mul(gasThresholdFactor)
+		case types.NetworkParticipationRateThresholdEventName:
+			networkSettings[network].ParticipationRateThreshold = sub.EventThreshold
+		}
+	}
+
+	for _, network := range networks {
+		networkName, err := d.getNotificationNetworkName(network.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		settings := t.NotificationSettingsNetwork{}
+		if s, ok := networkSettings[networkName]; ok {
+			settings = *s
+		}
+		result.Networks = append(result.Networks, t.NotificationNetwork{
+			ChainId:  network.ChainId,
+			Settings: settings,
+		})
+	}
+
+	return &result, nil
+}
+
+// setSubscriptions replaces the subscriptions of the user for the given event names with the passed ones.
+// If scopeFilter is set only subscriptions with this event filter are replaced.
+// Subscriptions that stay subscribed are updated in place so their last sent state is kept.
+func setSubscriptions(ctx context.Context, tx *sqlx.Tx, userId uint64, eventNames []string, scopeFilter string, subs []notificationSubscription) error {
+	keep := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		keep = append(keep, sub.EventName+"|"+sub.EventFilter)
+	}
+
+	queryParams := []interface{}{userId, pq.Array(eventNames), pq.Array(keep)}
+	deleteQuery := `DELETE FROM users_subscriptions WHERE user_id = $1 AND event_name = ANY($2) AND NOT (event_name || '|' || event_filter) = ANY($3)`
+	if scopeFilter != "" {
+		queryParams = append(queryParams, scopeFilter)
+		deleteQuery += " AND event_filter = $4"
+	}
+	_, err := tx.ExecContext(ctx, deleteQuery, queryParams...)
+	if err != nil {
+		return fmt.Errorf("error deleting subscriptions: %w", err)
+	}
+
+	createdEpoch := utils.TimeToEpoch(time.Now())
+	for _, sub := range subs {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO users_subscriptions (user_id, event_name, event_filter, event_threshold, created_ts, created_epoch)
+			VALUES ($1, $2, $3, $4, NOW(), $5)
+			ON CONFLICT (user_id, event_name, event_filter) DO UPDATE SET event_threshold = EXCLUDED.event_threshold`,
+			userId, sub.EventName, sub.EventFilter, sub.EventThreshold, createdEpoch)
+		if err != nil {
+			return fmt.Errorf("error adding subscription %s: %w", sub.EventName, err)
+		}
+	}
+	return nil
+}
+
+// getRocketPoolNodeAddresses returns the hex encoded addresses of the rocket pool nodes of all validators on the dashboards of the user
+func (d *DataAccessService) getRocketPoolNodeAddresses(ctx context.Context, userId uint64) ([]string, error) {
+	var validators []int64
+	err := d.alloyReader.SelectContext(ctx, &validators, `
+		SELECT v.validator_index
+		FROM users_val_dashboards_validators v
+		INNER JOIN users_val_dashboards d ON d.id = v.dashboard_id
+		WHERE d.user_id = $1`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dashboard validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil, nil
+	}
+
+	var nodeAddresses []string
+	err = d.readerDb.SelectContext(ctx, &nodeAddresses, `
+		SELECT DISTINCT ENCODE(rplm.node_address, 'hex')
+		FROM rocketpool_minipools rplm
+		INNER JOIN validators v ON v.pubkey = rplm.pubkey
+		WHERE v.validatorindex = ANY($1)`, pq.Array(validators))
+	if err != nil {
+		return nil, fmt.Errorf("error getting rocket pool node addresses: %w", err)
+	}
+	return nodeAddresses, nil
 }
+
 func (d *DataAccessService) UpdateNotificationSettingsGeneral(ctx context.Context, userId uint64, settings t.NotificationSettingsGeneral) error {
-	return d.dummy.UpdateNotificationSettingsGeneral(ctx, userId, settings)
+	var machineNames []string
+	var nodeAddresses []string
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		if d.bigtable == nil {
+			return nil
+		}
+		var err error
+		machineNames, err = d.bigtable.GetMachineMetricsMachineNames(userId)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		nodeAddresses, err = d.getRocketPoolNodeAddresses(ctx, userId)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	// machine subscriptions are per machine
+	machineSubs := make([]notificationSubscription, 0)
+	for _, machineName := range machineNames {
+		if settings.IsMachineOfflineSubscribed {
+			machineSubs = append(machineSubs, notificationSubscription{EventName: string(types.MonitoringMachineOfflineEventName), EventFilter: machineName})
+		}
+		if settings.MachineStorageUsageThreshold > 0 {
+			machineSubs = append(machineSubs, notificationSubscription{EventName: string(types.MonitoringMachineDiskAlmostFullEventName), EventFilter: machineName, EventThreshold: settings.MachineStorageUsageThreshold})
+		}
+		if settings.MachineCpuUsageThreshold > 0 {
+			machineSubs = append(machineSubs, notificationSubscription{EventName: string(types.MonitoringMachineCpuLoadEventName), EventFilter: machineName, EventThreshold: settings.MachineCpuUsageThreshold})
+		}
+		if settings.MachineMemoryUsageThreshold > 0 {
+			machineSubs = append(machineSubs, notificationSubscription{EventName: string(types.MonitoringMachineMemoryUsageEventName), EventFilter: machineName, EventThreshold: settings.MachineMemoryUsageThreshold})
+		}
+	}
+
+	clientSubs := make([]notificationSubscription, 0, len(settings.SubscribedClients))
+	for _, client := range settings.SubscribedClients {
+		clientSubs = append(clientSubs, notificationSubscription{EventName: string(types.EthClientUpdateEventName), EventFilter: strings.ToLower(client)})
+	}
+
+	// rocket pool collateral subscriptions are per node
+	network := utils.GetNetwork()
+	rocketPoolSubs := make([]notificationSubscription, 0)
+	if settings.IsRocketPoolNewRewardRoundSubscribed {
+		rocketPoolSubs = append(rocketPoolSubs, notificationSubscription{EventName: networkEventName(network, types.RocketpoolNewClaimRoundStartedEventName)})
+	}
+	for _, nodeAddress := range nodeAddresses {
+		if settings.RocketPoolMaxCollateralThreshold > 0 {
+			rocketPoolSubs = append(rocketPoolSubs, notificationSubscription{EventName: networkEventName(network, types.RocketpoolCollateralMaxReached), EventFilter: nodeAddress, EventThreshold: settings.RocketPoolMaxCollateralThreshold})
+		}
+		if settings.RocketPoolMinCollateralThreshold > 0 {
+			rocketPoolSubs = append(rocketPoolSubs, notificationSubscription{EventName: networkEventName(network, types.RocketpoolCollateralMinReached), EventFilter: nodeAddress, EventThreshold: settings.RocketPoolMinCollateralThreshold})
+		}
+	}
+
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to update general notification settings: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	var doNotDisturbTs *time.Time
+	if settings.DoNotDisturbTimestamp > 0 {
+		ts := time.Unix(settings.DoNotDisturbTimestamp, 0)
+		doNotDisturbTs = &ts
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE users SET notifications_do_not_disturb_ts = $2 WHERE id = $1`, userId, doNotDisturbTs)
+	if err != nil {
+		return fmt.Errorf("error updating do not disturb timestamp: %w", err)
+	}
+
+	channels := map[types.NotificationChannel]bool{
+		types.EmailNotificationChannel: settings.IsEmailNotificationsEnabled,
+		types.PushNotificationChannel:  settings.IsPushNotificationsEnabled,
+	}
+	for channel, active := range channels {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO users_notification_channels (user_id, channel, active)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, channel) DO UPDATE SET active = EXCLUDED.active`,
+			userId, channel, active)
+		if err != nil {
+			return fmt.Errorf("error updating notification channel %s: %w", channel, err)
+		}
+	}
+
+	machineEventNames := make([]string, 0, len(machineNotificationEventTypes))
+	for eventName := range machineNotificationEventTypes {
+		machineEventNames = append(machineEventNames, string(eventName))
+	}
+	if err = setSubscriptions(ctx, tx, userId, machineEventNames, "", machineSubs); err != nil {
+		return err
+	}
+	if err = setSubscriptions(ctx, tx, userId, []string{string(types.EthClientUpdateEventName)}, "", clientSubs); err != nil {
+		return err
+	}
+	rocketPoolEventNames := make([]string, 0, len(rocketPoolNotificationEventTypes))
+	for eventName := range rocketPoolNotificationEventTypes {
+		rocketPoolEventNames = append(rocketPoolEventNames, networkEventName(network, eventName))
+	}
+	if err = setSubscriptions(ctx, tx, userId, rocketPoolEventNames, "", rocketPoolSubs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
+
 func (d *DataAccessService) UpdateNotificationSettingsNetworks(ctx context.Context, userId uint64, chainId uint64, settings t.NotificationSettingsNetwork) error {
-	return d.dummy.UpdateNotificationSettingsNetworks(ctx, userId, chainId, settings)
+	network, err := d.getNotificationNetworkName(chainId)
+	if err != nil {
+		return err
+	}
+
+	subs := make([]notificationSubscription, 0)
+	if settings.GasAboveThreshold.IsPositive() {
+		threshold, _ := settings.GasAboveThreshold.Div(gasThresholdFactor).Float64()
+		subs = append(subs, notificationSubscription{EventName: networkEventName(network, types.NetworkGasAboveThresholdEventName), EventThreshold: threshold})
+	}
+	if settings.GasBelowThreshold.IsPositive() {
+		threshold, _ := settings.GasBelowThreshold.Div(gasThresholdFactor).Float64()
+		subs = append(subs, notificationSubscription{EventName: networkEventName(network, types.NetworkGasBelowThresholdEventName), EventThreshold: threshold})
+	}
+	if settings.ParticipationRateThreshold > 0 {
+		subs = append(subs, notificationSubscription{EventName: networkEventName(network, types.NetworkParticipationRateThresholdEventName), EventThreshold: settings.ParticipationRateThreshold})
+	}
+
+	eventNames := make([]string, 0, len(networkNotificationEventTypes))
+	for eventName := range networkNotificationEventTypes {
+		eventNames = append(eventNames, networkEventName(network, eventName))
+	}
+
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to update network notification settings: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	if err = setSubscriptions(ctx, tx, userId, eventNames, "", subs); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
-func (d *DataAccessService) UpdateNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string, name string, IsNotificationsEnabled bool) error {
-	return d.dummy.UpdateNotificationSettingsPairedDevice(ctx, pairedDeviceId, name, IsNotificationsEnabled)
+
+func (d *DataAccessService) UpdateNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string, name string, IsNotificationsEnabled bool) error {
+	result, err := d.userWriter.ExecContext(ctx, `
+		UPDATE users_devices SET device_name = $3, notify_enabled = $4
+		WHERE user_id = $1 AND device_identifier = $2`,
+		userId, pairedDeviceId, name, IsNotificationsEnabled)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: paired device with id %s", ErrNotFound, pairedDeviceId)
+	}
+	return nil
 }
-func (d *DataAccessService) DeleteNotificationSettingsPairedDevice(ctx context.Context, pairedDeviceId string) error {
-	return d.dummy.DeleteNotificationSettingsPairedDevice(ctx, pairedDeviceId)
+
+func (d *DataAccessService) DeleteNotificationSettingsPairedDevice(ctx context.Context, userId uint64, pairedDeviceId string) error {
+	result, err := d.userWriter.ExecContext(ctx, `DELETE FROM users_devices WHERE user_id = $1 AND device_identifier = $2`, userId, pairedDeviceId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: paired device with id %s", ErrNotFound, pairedDeviceId)
+	}
+	return nil
 }
+
 func (d *DataAccessService) GetNotificationSettingsDashboards(ctx context.Context, userId uint64, cursor string, colSort t.Sort[enums.NotificationSettingsDashboardColumn], search string, limit uint64) ([]t.NotificationSettingsDashboardsTableRow, *t.Paging, error) {
-	return d.dummy.GetNotificationSettingsDashboards(ctx, userId, cursor, colSort, search, limit)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.NotificationSettingsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NotificationSettingsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NotificationSettingsCursor: %w", err)
+		}
+	}
+
+	var groups []notificationDashboardGroup
+	var subs []notificationSubscription
+	var webhooks []struct {
+		EventFilter string         `db:"event_filter"`
+		Secret      sql.NullString `db:"secret"`
+	}
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		groups, err = d.getNotificationDashboardGroups(ctx, userId)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		subs, err = d.getUserSubscriptions(ctx, userId)
+		return err
+	})
+	wg.Go(func() error {
+		err := d.userReader.SelectContext(ctx, &webhooks, `SELECT event_filter, secret FROM users_webhooks WHERE user_id = $1 AND event_filter IS NOT NULL`, userId)
+		if err != nil {
+			return fmt.Errorf("error getting webhooks: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	webhookSecrets := make(map[string]string, len(webhooks))
+	for _, webhook := range webhooks {
+		webhookSecrets[webhook.EventFilter] = webhook.Secret.String
+	}
+
+	subsByFilter := make(map[string]map[types.EventName]float64)
+	for _, sub := range subs {
+		_, eventName := splitSubscriptionEventName(sub.EventName)
+		if _, ok := subsByFilter[sub.EventFilter]; !ok {
+			subsByFilter[sub.EventFilter] = make(map[types.EventName]float64)
+		}
+		subsByFilter[sub.EventFilter][eventName] = sub.EventThreshold
+	}
+
+	data := make([]t.NotificationSettingsDashboardsTableRow, 0, len(groups))
+	for _, group := range groups {
+		if search != "" && !strings.Contains(strings.ToLower(group.GroupName), strings.ToLower(search)) {
+			continue
+		}
+		eventPrefix := types.ValidatorDashboardEventPrefix
+		if group.IsAccountDashboard {
+			eventPrefix = types.AccountDashboardEventPrefix
+		}
+		eventFilter := types.DashboardEventFilter(eventPrefix, group.DashboardId, group.GroupId)
+		row := t.NotificationSettingsDashboardsTableRow{
+			IsAccountDashboard: group.IsAccountDashboard,
+			DashboardId:        group.DashboardId,
+			GroupId:            group.GroupId,
+			GroupName:          group.GroupName,
+			WebhookSecret:      webhookSecrets[eventFilter],
+		}
+		isDiscord := group.WebhookFormat.String == string(types.WebhookDiscordNotificationChannel)
+		groupSubs := subsByFilter[eventFilter]
+		if group.IsAccountDashboard {
+			settings := t.NotificationSettingsAccountDashboard{
+				WebhookUrl:                      group.WebhookTarget.String,
+				IsWebhookDiscordEnabled:         isDiscord,
+				IsIgnoreSpamTransactionsEnabled: group.IgnoreSpamTransactions,
+				SubscribedChainIds:              make([]uint64, 0, len(group.SubscribedChainIds)),
+			}
+			for _, chainId := range group.SubscribedChainIds {
+				settings.SubscribedChainIds = append(settings.SubscribedChainIds, uint64(chainId))
+			}
+			_, settings.IsIncomingTransactionsSubscribed = groupSubs[types.IncomingTransactionEventName]
+			_, settings.IsOutgoingTransactionsSubscribed = groupSubs[types.OutgoingTransactionEventName]
+			settings.ERC20TokenTransfersValueThreshold, settings.IsERC20TokenTransfersSubscribed = groupSubs[types.ERC20TokenTransferEventName]
+			_, settings.IsERC721TokenTransfersSubscribed = groupSubs[types.ERC721TokenTransferEventName]
+			_, settings.IsERC1155TokenTransfersSubscribed = groupSubs[types.ERC1155TokenTransferEventName]
+			row.Settings = settings
+			row.ChainIds = settings.SubscribedChainIds
+		} else {
+			settings := t.NotificationSettingsValidatorDashboard{
+				WebhookUrl:              group.WebhookTarget.String,
+				IsWebhookDiscordEnabled: isDiscord,
+				IsRealTimeModeEnabled:   group.RealtimeNotifications,
+				GroupOfflineThreshold:   groupSubs[types.ValidatorGroupIsOfflineEventName],
+			}
+			_, settings.IsValidatorOfflineSubscribed = groupSubs[types.ValidatorIsOfflineEventName]
+			_, settings.IsAttestationsMissedSubscribed = groupSubs[types.ValidatorMissedAttestationEventName]
+			_, settings.IsBlockProposalSubscribed = groupSubs[types.ValidatorMissedProposalEventName]
+			_, settings.IsUpcomingBlockProposalSubscribed = groupSubs[types.ValidatorUpcomingProposalEventName]
+			_, settings.IsSyncSubscribed = groupSubs[types.SyncCommitteeSoon]
+			_, settings.IsWithdrawalProcessedSubscribed = groupSubs[types.ValidatorReceivedWithdrawalEventName]
+			_, settings.IsSlashedSubscribed = groupSubs[types.ValidatorGotSlashedEventName]
+			row.Settings = settings
+			row.ChainIds = []uint64{utils.Config.Chain.ClConfig.DepositChainID}
+		}
+		data = append(data, row)
+	}
+
+	// no data found (searched for something that does not exist)
+	if len(data) == 0 {
+		return data, &paging, nil
+	}
+
+	// Sort the result
+	sort.Slice(data, func(i, j int) bool {
+		switch colSort.Column {
+		case enums.NotificationSettingsDashboardColumns.DashboardId:
+			if data[i].DashboardId != data[j].DashboardId {
+				return (data[i].DashboardId < data[j].DashboardId) != colSort.Desc
+			}
+		case enums.NotificationSettingsDashboardColumns.GroupName:
+			if data[i].GroupName != data[j].GroupName {
+				return (data[i].GroupName < data[j].GroupName) != colSort.Desc
+			}
+		}
+		if data[i].IsAccountDashboard != data[j].IsAccountDashboard {
+			return data[j].IsAccountDashboard != colSort.Desc
+		}
+		if data[i].DashboardId != data[j].DashboardId {
+			return (data[i].DashboardId < data[j].DashboardId) != colSort.Desc
+		}
+		return (data[i].GroupId < data[j].GroupId) != colSort.Desc
+	})
+
+	// Find the index for the cursor and limit the data
+	var cursorIndex uint64
+	if currentCursor.IsValid() {
+		for idx, row := range data {
+			if row.IsAccountDashboard == currentCursor.IsAccountDashboard && row.DashboardId == currentCursor.DashboardId && row.GroupId == currentCursor.GroupId {
+				cursorIndex = uint64(idx)
+				break
+			}
+		}
+	}
+
+	var result []t.NotificationSettingsDashboardsTableRow
+	if currentCursor.IsReverse() {
+		// opposite direction
+		var limitCutoff uint64
+		if cursorIndex > limit+1 {
+			limitCutoff = cursorIndex - limit - 1
+		}
+		result = data[limitCutoff:cursorIndex]
+	} else {
+		if currentCursor.IsValid() {
+			cursorIndex++
+		}
+		limitCutoff := utilMath.MinU64(cursorIndex+limit+1, uint64(len(data)))
+		result = data[cursorIndex:limitCutoff]
+	}
+
+	// flag if above limit
+	moreDataFlag := len(result) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return result, &paging, nil
+	}
+
+	// remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		if currentCursor.IsReverse() {
+			result = result[1:]
+		} else {
+			result = result[:len(result)-1]
+		}
+	}
+
+	p, err := utils.GetPagingFromData(result, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+
+	return result, p, nil
+}
+
+// setDashboardWebhook registers the webhook of a dashboard group for the subscribed events so it is picked up when queuing webhook notifications.
+// Every group has its own webhook row, identified by the event filter of the group, so groups sharing a url don't interfere with each other.
+func setDashboardWebhook(ctx context.Context, tx *sqlx.Tx, userId uint64, eventFilter string, url string, isDiscord bool, eventNames []string) error {
+	if url == "" {
+		_, err := tx.ExecContext(ctx, `DELETE FROM users_webhooks WHERE user_id = $1 AND event_filter = $2`, userId, eventFilter)
+		if err != nil {
+			return fmt.Errorf("error deleting webhook: %w", err)
+		}
+		return nil
+	}
+
+	destination := types.WebhookNotificationChannel
+	if isDiscord {
+		destination = types.WebhookDiscordNotificationChannel
+	}
+	var webhookId uint64
+	err := tx.GetContext(ctx, &webhookId, `SELECT id FROM users_webhooks WHERE user_id = $1 AND event_filter = $2 FOR UPDATE`, userId, eventFilter)
+	if errors.Is(err, sql.ErrNoRows) {
+		// every webhook gets its own secret which is used to sign its requests
+		secret, err := utils.GenerateWebhookSecret()
+		if err != nil {
+			return fmt.Errorf("error generating webhook secret: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO users_webhooks (user_id, url, destination, event_names, secret, event_filter) VALUES ($1, $2, $3, $4, $5, $6)`,
+			userId, url, destination, pq.Array(eventNames), secret, eventFilter)
+		if err != nil {
+			return fmt.Errorf("error adding webhook: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting webhook: %w", err)
+	}
+
+	// a new url starts without the failed attempts of the previous one
+	_, err = tx.ExecContext(ctx, `
+		UPDATE users_webhooks
+		SET retries = CASE WHEN url = $2 THEN retries ELSE 0 END, url = $2, destination = $3, event_names = $4
+		WHERE id = $1`,
+		webhookId, url, destination, pq.Array(eventNames))
+	if err != nil {
+		return fmt.Errorf("error updating webhook: %w", err)
+	}
+	return nil
 }
+
 func (d *DataAccessService) UpdateNotificationSettingsValidatorDashboard(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, settings t.NotificationSettingsValidatorDashboard) error {
-	return d.dummy.UpdateNotificationSettingsValidatorDashboard(ctx, dashboardId, groupId, settings)
+	var group struct {
+		UserId uint64 `db:"user_id"`
+	}
+	err := d.alloyReader.GetContext(ctx, &group, `
+		SELECT d.user_id
+		FROM users_val_dashboards_groups g
+		INNER JOIN users_val_dashboards d ON d.id = g.dashboard_id
+		WHERE g.dashboard_id = $1 AND g.id = $2`, dashboardId, groupId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: group %d of dashboard %d", ErrNotFound, groupId, dashboardId)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting validator dashboard group: %w", err)
+	}
+
+	// the collector expands dashboard subscriptions to the validators of the group
+	network := utils.GetNetwork()
+	eventFilter := types.DashboardEventFilter(types.ValidatorDashboardEventPrefix, uint64(dashboardId), groupId)
+	subscribed := map[types.EventName]bool{
+		types.ValidatorIsOfflineEventName:          settings.IsValidatorOfflineSubscribed,
+		types.ValidatorGroupIsOfflineEventName:     settings.GroupOfflineThreshold > 0,
+		types.ValidatorMissedAttestationEventName:  settings.IsAttestationsMissedSubscribed,
+		types.ValidatorMissedProposalEventName:     settings.IsBlockProposalSubscribed,
+		types.ValidatorExecutedProposalEventName:   settings.IsBlockProposalSubscribed,
+		types.ValidatorUpcomingProposalEventName:   settings.IsUpcomingBlockProposalSubscribed,
+		types.SyncCommitteeSoon:                    settings.IsSyncSubscribed,
+		types.ValidatorReceivedWithdrawalEventName: settings.IsWithdrawalProcessedSubscribed,
+		types.ValidatorGotSlashedEventName:         settings.IsSlashedSubscribed,
+		types.ValidatorDidSlashEventName:           settings.IsSlashedSubscribed,
+	}
+	eventNames := make([]string, 0, len(subscribed))
+	subs := make([]notificationSubscription, 0, len(subscribed))
+	webhookEventNames := make([]string, 0, len(subscribed))
+	for eventName, isSubscribed := range subscribed {
+		eventNames = append(eventNames, networkEventName(network, eventName))
+		if !isSubscribed {
+			continue
+		}
+		sub := notificationSubscription{EventName: networkEventName(network, eventName), EventFilter: eventFilter}
+		if eventName == types.ValidatorGroupIsOfflineEventName {
+			sub.EventThreshold = settings.GroupOfflineThreshold
+		}
+		subs = append(subs, sub)
+		webhookEventNames = append(webhookEventNames, string(eventName))
+	}
+
+	webhookFormat := types.WebhookNotificationChannel
+	if settings.IsWebhookDiscordEnabled {
+		webhookFormat = types.WebhookDiscordNotificationChannel
+	}
+	_, err = d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_val_dashboards_groups
+		SET webhook_target = NULLIF($3, ''), webhook_format = $4, realtime_notifications = $5
+		WHERE dashboard_id = $1 AND id = $2`,
+		dashboardId, groupId, settings.WebhookUrl, webhookFormat, settings.IsRealTimeModeEnabled)
+	if err != nil {
+		return fmt.Errorf("error updating validator dashboard group notification settings: %w", err)
+	}
+
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to update validator dashboard notification settings: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	if err = setSubscriptions(ctx, tx, group.UserId, eventNames, eventFilter, subs); err != nil {
+		return err
+	}
+	if err = setDashboardWebhook(ctx, tx, group.UserId, eventFilter, settings.WebhookUrl, settings.IsWebhookDiscordEnabled, webhookEventNames); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
+
 func (d *DataAccessService) UpdateNotificationSettingsAccountDashboard(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, settings t.NotificationSettingsAccountDashboard) error {
-	return d.dummy.UpdateNotificationSettingsAccountDashboard(ctx, dashboardId, groupId, settings)
+	var group struct {
+		UserId uint64 `db:"user_id"`
+	}
+	err := d.alloyReader.GetContext(ctx, &group, `
+		SELECT d.user_id
+		FROM users_acc_dashboards_groups g
+		INNER JOIN users_acc_dashboards d ON d.id = g.dashboard_id
+		WHERE g.dashboard_id = $1 AND g.id = $2`, dashboardId, groupId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: group %d of dashboard %d", ErrNotFound, groupId, dashboardId)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting account dashboard group: %w", err)
+	}
+
+	// account dashboards span multiple chains, so their event names are not network specific
+	eventFilter := types.DashboardEventFilter(types.AccountDashboardEventPrefix, uint64(dashboardId), groupId)
+	subscribed := map[types.EventName]bool{
+		types.IncomingTransactionEventName:  settings.IsIncomingTransactionsSubscribed,
+		types.OutgoingTransactionEventName:  settings.IsOutgoingTransactionsSubscribed,
+		types.ERC20TokenTransferEventName:   settings.IsERC20TokenTransfersSubscribed,
+		types.ERC721TokenTransferEventName:  settings.IsERC721TokenTransfersSubscribed,
+		types.ERC1155TokenTransferEventName: settings.IsERC1155TokenTransfersSubscribed,
+	}
+	eventNames := make([]string, 0, len(subscribed))
+	subs := make([]notificationSubscription, 0, len(subscribed))
+	for eventName, isSubscribed := range subscribed {
+		eventNames = append(eventNames, string(eventName))
+		if !isSubscribed {
+			continue
+		}
+		sub := notificationSubscription{EventName: string(eventName), EventFilter: eventFilter}
+		if eventName == types.ERC20TokenTransferEventName {
+			sub.EventThreshold = settings.ERC20TokenTransfersValueThreshold
+		}
+		subs = append(subs, sub)
+	}
+
+	chainIds := make([]int64, 0, len(settings.SubscribedChainIds))
+	for _, chainId := range settings.SubscribedChainIds {
+		chainIds = append(chainIds, int64(chainId))
+	}
+	webhookFormat := types.WebhookNotificationChannel
+	if settings.IsWebhookDiscordEnabled {
+		webhookFormat = types.WebhookDiscordNotificationChannel
+	}
+	_, err = d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_acc_dashboards_groups
+		SET webhook_target = NULLIF($3, ''), webhook_format = $4, ignore_spam_transactions = $5, subscribed_chain_ids = $6
+		WHERE dashboard_id = $1 AND id = $2`,
+		dashboardId, groupId, settings.WebhookUrl, webhookFormat, settings.IsIgnoreSpamTransactionsEnabled, pq.Array(chainIds))
+	if err != nil {
+		return fmt.Errorf("error updating account dashboard group notification settings: %w", err)
+	}
+
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to update account dashboard notification settings: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	if err = setSubscriptions(ctx, tx, group.UserId, eventNames, eventFilter, subs); err != nil {
+		return err
+	}
+	webhookEventNames := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		webhookEventNames = append(webhookEventNames, sub.EventName)
+	}
+	if err = setDashboardWebhook(ctx, tx, group.UserId, eventFilter, settings.WebhookUrl, settings.IsWebhookDiscordEnabled, webhookEventNames); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DataAccessService) GetWebhookDeliveries(ctx context.Context, userId uint64, cursor string, limit uint64) ([]t.NotificationWebhookDelivery, *t.Paging, error) {
+	result := make([]t.NotificationWebhookDelivery, 0)
+	var paging t.Paging
+
+	// Initialize the cursor
+	var currentCursor t.NotificationWebhookDeliveriesCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NotificationWebhookDeliveriesCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NotificationWebhookDeliveriesCursor: %w", err)
+		}
+	}
+
+	// Newest deliveries first
+	sortSearchDirection := "<"
+	sortSearchOrder := " DESC"
+	if currentCursor.IsReverse() {
+		sortSearchDirection = ">"
+		sortSearchOrder = " ASC"
+	}
+
+	queryParams := []interface{}{userId}
+	whereQuery := " WHERE user_id = $1"
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.Id)
+		whereQuery += fmt.Sprintf(" AND id %s $%d", sortSearchDirection, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+
+	var queryResult []struct {
+		Id              uint64         `db:"id"`
+		CreatedTs       time.Time      `db:"created_ts"`
+		Url             string         `db:"url"`
+		EventName       string         `db:"event_name"`
+		Attempt         uint64         `db:"attempt"`
+		StatusCode      sql.NullInt64  `db:"status_code"`
+		LatencyMs       uint64         `db:"latency_ms"`
+		Success         bool           `db:"success"`
+		ResponseExcerpt sql.NullString `db:"response_excerpt"`
+		Error           sql.NullString `db:"error"`
+	}
+	err = d.userReader.SelectContext(ctx, &queryResult, `
+		SELECT id, created_ts, url, event_name, attempt, status_code, latency_ms, success, response_excerpt, error
+		FROM users_webhooks_deliveries`+whereQuery+
+		" ORDER BY id"+sortSearchOrder+fmt.Sprintf(" LIMIT $%d", len(queryParams)), queryParams...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting webhook deliveries: %w", err)
+	}
+	if len(queryResult) == 0 {
+		return result, &paging, nil
+	}
+
+	cursorData := make([]t.NotificationWebhookDeliveriesCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		result = append(result, t.NotificationWebhookDelivery{
+			Id:              row.Id,
+			Timestamp:       row.CreatedTs.Unix(),
+			WebhookUrl:      row.Url,
+			EventType:       row.EventName,
+			Attempt:         row.Attempt,
+			StatusCode:      uint64(row.StatusCode.Int64),
+			LatencyMs:       row.LatencyMs,
+			IsSuccess:       row.Success,
+			ResponseExcerpt: row.ResponseExcerpt.String,
+			Error:           row.Error.String,
+		})
+		cursorData = append(cursorData, t.NotificationWebhookDeliveriesCursor{Id: row.Id})
+	}
+
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &paging, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+// returns the push tokens of all paired devices of the user that have notifications enabled
+func (d *DataAccessService) GetNotificationPushTokens(ctx context.Context, userId uint64) ([]string, error) {
+	var tokens []string
+	err := d.userReader.SelectContext(ctx, &tokens, `
+		SELECT DISTINCT notification_token
+		FROM users_devices
+		WHERE user_id = $1 AND notify_enabled AND active AND notification_token IS NOT NULL AND LENGTH(notification_token) > 20`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting push tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// returns the signing secret of a webhook the user has set up with the given url
+func (d *DataAccessService) GetNotificationWebhookSecret(ctx context.Context, userId uint64, webhookUrl string) (string, error) {
+	var secret string
+	err := d.userReader.GetContext(ctx, &secret, `
+		SELECT secret
+		FROM users_webhooks
+		WHERE user_id = $1 AND url = $2 AND secret IS NOT NULL
+		ORDER BY id DESC
+		LIMIT 1`, userId, webhookUrl)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: no webhook with url %s found", ErrNotFound, webhookUrl)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting webhook secret: %w", err)
+	}
+	return secret, nil
+}
+
+func (d *DataAccessService) IncrementNotificationTestCount(ctx context.Context, userId uint64, window time.Duration) (uint64, time.Duration, error) {
+	key := fmt.Sprintf("api:notification_tests:%d", userId)
+
+	count, err := d.persistentRedisDbClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error incrementing test notification count: %w", err)
+	}
+	// the first test in a window starts it
+	if count == 1 {
+		err = d.persistentRedisDbClient.Expire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, fmt.Errorf("error setting test notification window: %w", err)
+		}
+		return uint64(count), window, nil
+	}
+	ttl, err := d.persistentRedisDbClient.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting test notification window: %w", err)
+	}
+	if ttl < 0 {
+		// the key has no expiry, e.g. because setting it failed before, start a new window
+		err = d.persistentRedisDbClient.Expire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, fmt.Errorf("error setting test notification window: %w", err)
+		}
+		ttl = window
+	}
+	return uint64(count), ttl, nil
 }
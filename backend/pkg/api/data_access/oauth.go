@@ -0,0 +1,309 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type OAuthRepository interface {
+	CreateOAuthApp(ctx context.Context, userId uint64, name, redirectUri, clientId, clientSecretHash string, scopes []string) (*t.OAuthApp, error)
+	GetOAuthApps(ctx context.Context, userId uint64) ([]t.OAuthApp, error)
+	RemoveOAuthApp(ctx context.Context, userId, appId uint64) error
+	GetOAuthAppCredentials(ctx context.Context, clientId string) (*t.OAuthAppCredentials, error)
+
+	AddOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode, expiresAt time.Time) error
+	ConsumeOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error)
+
+	AddOAuthToken(ctx context.Context, appId, userId uint64, accessTokenHash, refreshTokenHash string, scopes []string, accessExpiresAt, refreshExpiresAt time.Time) error
+	RotateOAuthToken(ctx context.Context, appId uint64, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) (*t.OAuthToken, error)
+	GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthToken, error)
+
+	GetOAuthGrants(ctx context.Context, userId uint64) ([]t.OAuthGrant, error)
+	RevokeOAuthGrant(ctx context.Context, userId, appId uint64) error
+}
+
+type oauthApp struct {
+	Id          uint64         `db:"id"`
+	Name        string         `db:"app_name"`
+	ClientId    string         `db:"client_id"`
+	RedirectUri string         `db:"redirect_uri"`
+	Scopes      pq.StringArray `db:"scopes"`
+	CreatedTs   time.Time      `db:"created_ts"`
+}
+
+func (app oauthApp) toApiType() t.OAuthApp {
+	return t.OAuthApp{
+		Id:          app.Id,
+		Name:        app.Name,
+		ClientId:    app.ClientId,
+		RedirectUri: app.RedirectUri,
+		Scopes:      app.Scopes,
+		CreatedAt:   app.CreatedTs.Unix(),
+	}
+}
+
+func (d *DataAccessService) CreateOAuthApp(ctx context.Context, userId uint64, name, redirectUri, clientId, clientSecretHash string, scopes []string) (*t.OAuthApp, error) {
+	var app oauthApp
+	err := d.userWriter.GetContext(ctx, &app, `
+		INSERT INTO oauth_apps (owner_id, app_name, redirect_uri, client_id, client_secret_hash, scopes, active, created_ts)
+			VALUES ($1, $2, $3, $4, $5, $6, true, NOW())
+		RETURNING id, app_name, client_id, redirect_uri, scopes, created_ts`,
+		userId, name, redirectUri, clientId, clientSecretHash, pq.Array(scopes))
+	if err != nil {
+		return nil, err
+	}
+	result := app.toApiType()
+	return &result, nil
+}
+
+func (d *DataAccessService) GetOAuthApps(ctx context.Context, userId uint64) ([]t.OAuthApp, error) {
+	var apps []oauthApp
+	// apps without a client id are first party apps (e.g. the mobile app) and can't be managed by users
+	err := d.userReader.SelectContext(ctx, &apps, `
+		SELECT id, app_name, client_id, redirect_uri, scopes, created_ts
+		FROM oauth_apps
+		WHERE owner_id = $1 AND active = true AND client_id IS NOT NULL
+		ORDER BY id`, userId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.OAuthApp, 0, len(apps))
+	for _, app := range apps {
+		result = append(result, app.toApiType())
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) RemoveOAuthApp(ctx context.Context, userId, appId uint64) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transactions to remove oauth app: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM oauth_apps WHERE id = $1 AND owner_id = $2 AND client_id IS NOT NULL`, appId, userId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: oauth app %d not found", ErrNotFound, appId)
+	}
+
+	// revoke everything that was granted to the app
+	_, err = tx.ExecContext(ctx, `DELETE FROM oauth_codes WHERE app_id = $1`, appId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM oauth_tokens WHERE app_id = $1`, appId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *DataAccessService) GetOAuthAppCredentials(ctx context.Context, clientId string) (*t.OAuthAppCredentials, error) {
+	var row struct {
+		AppId            uint64         `db:"id"`
+		ClientId         string         `db:"client_id"`
+		ClientSecretHash sql.NullString `db:"client_secret_hash"`
+		RedirectUri      string         `db:"redirect_uri"`
+		Scopes           pq.StringArray `db:"scopes"`
+	}
+	err := d.userReader.GetContext(ctx, &row, `
+		SELECT id, client_id, client_secret_hash, redirect_uri, scopes
+		FROM oauth_apps
+		WHERE client_id = $1 AND active = true`, clientId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: oauth app with client id %s not found", ErrNotFound, clientId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthAppCredentials{
+		AppId:            row.AppId,
+		ClientId:         row.ClientId,
+		ClientSecretHash: row.ClientSecretHash.String,
+		RedirectUri:      row.RedirectUri,
+		Scopes:           row.Scopes,
+	}, nil
+}
+
+func (d *DataAccessService) AddOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode, expiresAt time.Time) error {
+	// a new authorization replaces any pending code of the same user and app
+	_, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO oauth_codes (user_id, code, client_id, consumed, app_id, created_ts, redirect_uri, scopes, code_challenge, code_challenge_method, expires_ts)
+			VALUES ($1, $2, $3, false, $4, NOW(), $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, app_id, client_id) DO UPDATE SET
+			code = EXCLUDED.code,
+			consumed = false,
+			created_ts = EXCLUDED.created_ts,
+			redirect_uri = EXCLUDED.redirect_uri,
+			scopes = EXCLUDED.scopes,
+			code_challenge = EXCLUDED.code_challenge,
+			code_challenge_method = EXCLUDED.code_challenge_method,
+			expires_ts = EXCLUDED.expires_ts`,
+		code.UserId, codeHash, code.ClientId, code.AppId, code.RedirectUri, pq.Array(code.Scopes), code.CodeChallenge, code.CodeChallengeMethod, expiresAt)
+	return err
+}
+
+// ConsumeOAuthAuthorizationCode marks the code as consumed and returns it, codes can only be consumed once and before they expire
+func (d *DataAccessService) ConsumeOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error) {
+	var row struct {
+		AppId               uint64         `db:"app_id"`
+		UserId              uint64         `db:"user_id"`
+		ClientId            string         `db:"client_id"`
+		RedirectUri         sql.NullString `db:"redirect_uri"`
+		Scopes              pq.StringArray `db:"scopes"`
+		CodeChallenge       sql.NullString `db:"code_challenge"`
+		CodeChallengeMethod sql.NullString `db:"code_challenge_method"`
+	}
+	err := d.userWriter.GetContext(ctx, &row, `
+		UPDATE oauth_codes SET consumed = true
+		WHERE code = $1 AND consumed = false AND expires_ts > NOW()
+		RETURNING app_id, user_id, client_id, redirect_uri, scopes, code_challenge, code_challenge_method`, codeHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: authorization code not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthAuthorizationCode{
+		AppId:               row.AppId,
+		UserId:              row.UserId,
+		ClientId:            row.ClientId,
+		RedirectUri:         row.RedirectUri.String,
+		Scopes:              row.Scopes,
+		CodeChallenge:       row.CodeChallenge.String,
+		CodeChallengeMethod: row.CodeChallengeMethod.String,
+	}, nil
+}
+
+func (d *DataAccessService) AddOAuthToken(ctx context.Context, appId, userId uint64, accessTokenHash, refreshTokenHash string, scopes []string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO oauth_tokens (app_id, user_id, access_token_hash, refresh_token_hash, scopes, access_expires_ts, refresh_expires_ts)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		appId, userId, accessTokenHash, refreshTokenHash, pq.Array(scopes), accessExpiresAt, refreshExpiresAt)
+	return err
+}
+
+// RotateOAuthToken replaces both the access and the refresh token of the token pair identified by the old refresh token
+func (d *DataAccessService) RotateOAuthToken(ctx context.Context, appId uint64, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) (*t.OAuthToken, error) {
+	var row struct {
+		UserId uint64         `db:"user_id"`
+		Scopes pq.StringArray `db:"scopes"`
+	}
+	err := d.userWriter.GetContext(ctx, &row, `
+		UPDATE oauth_tokens SET
+			access_token_hash = $3,
+			refresh_token_hash = $4,
+			access_expires_ts = $5,
+			refresh_expires_ts = $6
+		WHERE app_id = $1 AND refresh_token_hash = $2 AND revoked = false AND refresh_expires_ts > NOW()
+		RETURNING user_id, scopes`,
+		appId, oldRefreshTokenHash, accessTokenHash, refreshTokenHash, accessExpiresAt, refreshExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: refresh token not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthToken{
+		AppId:     appId,
+		UserId:    row.UserId,
+		Scopes:    row.Scopes,
+		ExpiresAt: accessExpiresAt,
+	}, nil
+}
+
+func (d *DataAccessService) GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthToken, error) {
+	var row struct {
+		AppId     uint64         `db:"app_id"`
+		UserId    uint64         `db:"user_id"`
+		Scopes    pq.StringArray `db:"scopes"`
+		ExpiresTs time.Time      `db:"access_expires_ts"`
+	}
+	err := d.userReader.GetContext(ctx, &row, `
+		SELECT app_id, user_id, scopes, access_expires_ts
+		FROM oauth_tokens
+		WHERE access_token_hash = $1 AND revoked = false AND access_expires_ts > NOW()`, accessTokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: access token not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthToken{
+		AppId:     row.AppId,
+		UserId:    row.UserId,
+		Scopes:    row.Scopes,
+		ExpiresAt: row.ExpiresTs,
+	}, nil
+}
+
+// GetOAuthGrants returns the third party apps that currently hold valid tokens of the user
+func (d *DataAccessService) GetOAuthGrants(ctx context.Context, userId uint64) ([]t.OAuthGrant, error) {
+	var grants []struct {
+		AppId     uint64         `db:"app_id"`
+		AppName   string         `db:"app_name"`
+		Scopes    pq.StringArray `db:"scopes"`
+		CreatedTs time.Time      `db:"created_ts"`
+	}
+	err := d.userReader.SelectContext(ctx, &grants, `
+		SELECT DISTINCT ON (t.app_id) t.app_id, a.app_name, t.scopes, t.created_ts
+		FROM oauth_tokens t
+		INNER JOIN oauth_apps a ON a.id = t.app_id
+		WHERE t.user_id = $1 AND t.revoked = false AND t.refresh_expires_ts > NOW()
+		ORDER BY t.app_id, t.created_ts DESC`, userId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.OAuthGrant, 0, len(grants))
+	for _, grant := range grants {
+		result = append(result, t.OAuthGrant{
+			AppId:     grant.AppId,
+			AppName:   grant.AppName,
+			Scopes:    grant.Scopes,
+			GrantedAt: grant.CreatedTs.Unix(),
+		})
+	}
+	return result, nil
+}
+
+// RevokeOAuthGrant revokes all tokens and pending authorization codes the user has issued to the app
+func (d *DataAccessService) RevokeOAuthGrant(ctx context.Context, userId, appId uint64) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transactions to revoke oauth grant: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	result, err := tx.ExecContext(ctx, `UPDATE oauth_tokens SET revoked = true WHERE user_id = $1 AND app_id = $2 AND revoked = false`, userId, appId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: no access granted to oauth app %d", ErrNotFound, appId)
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM oauth_codes WHERE user_id = $1 AND app_id = $2`, userId, appId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
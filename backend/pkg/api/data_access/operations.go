@@ -0,0 +1,697 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+// OperationsRepository serves the network wide feeds of validator related operations, i.e. deposits, withdrawals,
+// voluntary exits, bls changes and slashings. All feeds are ordered newest first and use cursor based paging.
+type OperationsRepository interface {
+	// supported filters: Address (sender or depositor), Credential, PublicKey, TxHash
+	GetNetworkDeposits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkDepositTableRow, *t.Paging, error)
+	// supported filters: Address (recipient), Credential, Validator, StartSlot, EndSlot
+	GetNetworkWithdrawals(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkWithdrawalTableRow, *t.Paging, error)
+	// supported filters: Validator, StartSlot, EndSlot
+	GetNetworkVoluntaryExits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkVoluntaryExitTableRow, *t.Paging, error)
+	// supported filters: Address (new withdrawal address), Validator, StartSlot, EndSlot
+	GetNetworkBlsChanges(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkBlsChangeTableRow, *t.Paging, error)
+	// supported filters: Validator (slashed or slasher)
+	GetNetworkSlashings(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkSlashingTableRow, *t.Paging, error)
+}
+
+// cursorDirection returns the comparison operator and the sort order for the given cursor, the default is newest first
+func cursorDirection(cursor t.CursorLike) (string, string) {
+	if cursor.IsReverse() {
+		return ">", " ASC"
+	}
+	return "<", " DESC"
+}
+
+// pageFromRows removes the additional row which was queried to detect more data, restores the requested order and creates the paging
+func pageFromRows[T any, C t.CursorLike](result []T, cursorData []C, currentCursor C, limit uint64) ([]T, *t.Paging, error) {
+	// Flag if above limit
+	moreDataFlag := len(result) > int(limit)
+
+	// Remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		result = result[:len(result)-1]
+		cursorData = cursorData[:len(cursorData)-1]
+	}
+
+	// Reverse the data if the cursor is reversed to correct it to the requested direction
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+
+	if len(result) == 0 || !moreDataFlag && !currentCursor.IsValid() {
+		// No paging required
+		return result, &t.Paging{}, nil
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+// slotRangeFilter adds the slot range of the filter to the where clause of a query on the given slot column
+func slotRangeFilter(filter t.NetworkOperationsFilter, column string, whereQuery string, queryParams []interface{}) (string, []interface{}) {
+	if filter.StartSlot != nil {
+		queryParams = append(queryParams, *filter.StartSlot)
+		whereQuery += fmt.Sprintf(" AND %s >= $%d", column, len(queryParams))
+	}
+	if filter.EndSlot != nil {
+		queryParams = append(queryParams, *filter.EndSlot)
+		whereQuery += fmt.Sprintf(" AND %s <= $%d", column, len(queryParams))
+	}
+	return whereQuery, queryParams
+}
+
+// validatorsWithCredential returns the indices of all validators which currently use the given withdrawal credential
+func (d *DataAccessService) validatorsWithCredential(credential []byte) ([]int64, error) {
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, err
+	}
+	validators := []int64{}
+	for validator, metadata := range validatorMapping.ValidatorMetadata {
+		if bytes.Equal(metadata.WithdrawalCredentials, credential) {
+			validators = append(validators, int64(validator))
+		}
+	}
+	return validators, nil
+}
+
+func (d *DataAccessService) GetNetworkDeposits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkDepositTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.ELDepositsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.ELDepositsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as ELDepositsCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE true"
+	if filter.Address != nil {
+		queryParams = append(queryParams, filter.Address)
+		whereQuery += fmt.Sprintf(" AND (ed.from_address = $%[1]d OR ed.msg_sender = $%[1]d)", len(queryParams))
+	}
+	if filter.Credential != nil {
+		queryParams = append(queryParams, filter.Credential)
+		whereQuery += fmt.Sprintf(" AND ed.withdrawal_credentials = $%d", len(queryParams))
+	}
+	if filter.PublicKey != nil {
+		queryParams = append(queryParams, filter.PublicKey)
+		whereQuery += fmt.Sprintf(" AND ed.publickey = $%d", len(queryParams))
+	}
+	if filter.TxHash != nil {
+		queryParams = append(queryParams, filter.TxHash)
+		whereQuery += fmt.Sprintf(" AND ed.tx_hash = $%d", len(queryParams))
+	}
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.BlockNumber, currentCursor.LogIndex)
+		whereQuery += fmt.Sprintf(" AND (ed.block_number, ed.log_index) %s ($%d, $%d)", sortSearchDirection, len(queryParams)-1, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	// the consensus layer inclusion is matched by public key and signature, as the execution layer deposit does not know its beacon block
+	query := `
+		SELECT
+			ed.publickey,
+			ed.block_number,
+			ed.log_index,
+			ed.block_ts,
+			ed.from_address,
+			ed.msg_sender,
+			ed.tx_hash,
+			ed.withdrawal_credentials,
+			ed.amount,
+			ed.valid_signature,
+			inclusion.block_slot
+		FROM eth1_deposits ed
+		LEFT JOIN LATERAL (
+			SELECT bd.block_slot
+			FROM blocks_deposits bd
+			INNER JOIN blocks b ON b.slot = bd.block_slot AND b.blockroot = bd.block_root AND b.status = '1'
+			WHERE bd.publickey = ed.publickey AND bd.signature = ed.signature
+			LIMIT 1
+		) inclusion ON true` + whereQuery +
+		" ORDER BY ed.block_number" + sortSearchOrder + ", ed.log_index" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		PublicKey             []byte        `db:"publickey"`
+		BlockNumber           int64         `db:"block_number"`
+		LogIndex              int64         `db:"log_index"`
+		Timestamp             time.Time     `db:"block_ts"`
+		From                  []byte        `db:"from_address"`
+		Depositor             []byte        `db:"msg_sender"`
+		TxHash                []byte        `db:"tx_hash"`
+		WithdrawalCredentials []byte        `db:"withdrawal_credentials"`
+		Amount                int64         `db:"amount"`
+		Valid                 bool          `db:"valid_signature"`
+		Slot                  sql.NullInt64 `db:"block_slot"`
+	}
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]t.NetworkDepositTableRow, 0, len(queryResult))
+	cursorData := make([]t.ELDepositsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		pubkey := hexutil.Encode(row.PublicKey)
+		from := hexutil.Encode(row.From)
+		depositor := hexutil.Encode(row.Depositor)
+		ensMapping[from] = ""
+		ensMapping[depositor] = ""
+		deposit := t.NetworkDepositTableRow{
+			PublicKey:            t.PubKey(pubkey),
+			Block:                uint64(row.BlockNumber),
+			Timestamp:            row.Timestamp.Unix(),
+			From:                 t.Address{Hash: t.Hash(from)},
+			Depositor:            t.Address{Hash: t.Hash(depositor)},
+			TxHash:               t.Hash(hexutil.Encode(row.TxHash)),
+			WithdrawalCredential: t.Hash(hexutil.Encode(row.WithdrawalCredentials)),
+			Amount:               utils.GWeiToWei(big.NewInt(row.Amount)),
+			Valid:                row.Valid,
+		}
+		if validator, ok := validatorMapping.ValidatorIndices[pubkey]; ok {
+			index := uint64(validator)
+			deposit.Index = &index
+		}
+		if row.Slot.Valid {
+			slot := uint64(row.Slot.Int64)
+			deposit.Slot = &slot
+		}
+		result = append(result, deposit)
+		cursorData = append(cursorData, t.ELDepositsCursor{BlockNumber: row.BlockNumber, LogIndex: row.LogIndex})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].From.Ens = ensMapping[string(result[i].From.Hash)]
+		result[i].Depositor.Ens = ensMapping[string(result[i].Depositor.Hash)]
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+func (d *DataAccessService) GetNetworkWithdrawals(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkWithdrawalTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.NetworkWithdrawalsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.NetworkWithdrawalsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as NetworkWithdrawalsCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE b.status = '1'"
+	if filter.Address != nil {
+		queryParams = append(queryParams, filter.Address)
+		whereQuery += fmt.Sprintf(" AND bw.address = $%d", len(queryParams))
+	}
+	if filter.Credential != nil {
+		// withdrawals only store the recipient address, so resolve the validators using the credential
+		validators, err := d.validatorsWithCredential(filter.Credential)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(validators) == 0 {
+			return []t.NetworkWithdrawalTableRow{}, &t.Paging{}, nil
+		}
+		queryParams = append(queryParams, pq.Array(validators))
+		whereQuery += fmt.Sprintf(" AND bw.validatorindex = ANY($%d)", len(queryParams))
+	}
+	if filter.Validator != nil {
+		queryParams = append(queryParams, *filter.Validator)
+		whereQuery += fmt.Sprintf(" AND bw.validatorindex = $%d", len(queryParams))
+	}
+	whereQuery, queryParams = slotRangeFilter(filter, "bw.block_slot", whereQuery, queryParams)
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.WithdrawalIndex)
+		whereQuery += fmt.Sprintf(" AND bw.withdrawalindex %s $%d", sortSearchDirection, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := `
+		SELECT
+			bw.withdrawalindex,
+			bw.validatorindex,
+			bw.block_slot,
+			bw.address,
+			bw.amount
+		FROM blocks_withdrawals bw
+		INNER JOIN blocks b ON b.slot = bw.block_slot AND b.blockroot = bw.block_root` + whereQuery +
+		" ORDER BY bw.withdrawalindex" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		Index     uint64 `db:"withdrawalindex"`
+		Validator uint64 `db:"validatorindex"`
+		Slot      uint64 `db:"block_slot"`
+		Address   []byte `db:"address"`
+		Amount    int64  `db:"amount"`
+	}
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]t.NetworkWithdrawalTableRow, 0, len(queryResult))
+	cursorData := make([]t.NetworkWithdrawalsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		address := hexutil.Encode(row.Address)
+		ensMapping[address] = ""
+		result = append(result, t.NetworkWithdrawalTableRow{
+			Index:     row.Index,
+			Validator: row.Validator,
+			Epoch:     utils.EpochOfSlot(row.Slot),
+			Slot:      row.Slot,
+			Recipient: t.Address{Hash: t.Hash(address)},
+			Amount:    utils.GWeiToWei(big.NewInt(row.Amount)),
+		})
+		cursorData = append(cursorData, t.NetworkWithdrawalsCursor{WithdrawalIndex: row.Index})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].Recipient.Ens = ensMapping[string(result[i].Recipient.Hash)]
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+func (d *DataAccessService) GetNetworkVoluntaryExits(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkVoluntaryExitTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.BlockOperationsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.BlockOperationsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as BlockOperationsCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE b.status = '1'"
+	if filter.Validator != nil {
+		queryParams = append(queryParams, *filter.Validator)
+		whereQuery += fmt.Sprintf(" AND ve.validatorindex = $%d", len(queryParams))
+	}
+	whereQuery, queryParams = slotRangeFilter(filter, "ve.block_slot", whereQuery, queryParams)
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.BlockSlot, currentCursor.BlockIndex)
+		whereQuery += fmt.Sprintf(" AND (ve.block_slot, ve.block_index) %s ($%d, $%d)", sortSearchDirection, len(queryParams)-1, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := `
+		SELECT
+			ve.block_slot,
+			ve.block_index,
+			ve.epoch,
+			ve.validatorindex,
+			ve.signature
+		FROM blocks_voluntaryexits ve
+		INNER JOIN blocks b ON b.slot = ve.block_slot AND b.blockroot = ve.block_root` + whereQuery +
+		" ORDER BY ve.block_slot" + sortSearchOrder + ", ve.block_index" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		BlockSlot  uint64 `db:"block_slot"`
+		BlockIndex uint64 `db:"block_index"`
+		Epoch      uint64 `db:"epoch"`
+		Validator  uint64 `db:"validatorindex"`
+		Signature  []byte `db:"signature"`
+	}
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NetworkVoluntaryExitTableRow, 0, len(queryResult))
+	cursorData := make([]t.BlockOperationsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		result = append(result, t.NetworkVoluntaryExitTableRow{
+			Validator: row.Validator,
+			Epoch:     row.Epoch,
+			Slot:      row.BlockSlot,
+			Signature: t.Hash(hexutil.Encode(row.Signature)),
+		})
+		cursorData = append(cursorData, t.BlockOperationsCursor{BlockSlot: row.BlockSlot, BlockIndex: row.BlockIndex})
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+func (d *DataAccessService) GetNetworkBlsChanges(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkBlsChangeTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.BlsChangesCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.BlsChangesCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as BlsChangesCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE b.status = '1'"
+	if filter.Address != nil {
+		queryParams = append(queryParams, filter.Address)
+		whereQuery += fmt.Sprintf(" AND bls.address = $%d", len(queryParams))
+	}
+	if filter.Validator != nil {
+		queryParams = append(queryParams, *filter.Validator)
+		whereQuery += fmt.Sprintf(" AND bls.validatorindex = $%d", len(queryParams))
+	}
+	whereQuery, queryParams = slotRangeFilter(filter, "bls.block_slot", whereQuery, queryParams)
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.BlockSlot, currentCursor.Validator)
+		whereQuery += fmt.Sprintf(" AND (bls.block_slot, bls.validatorindex) %s ($%d, $%d)", sortSearchDirection, len(queryParams)-1, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := `
+		SELECT
+			bls.block_slot,
+			bls.validatorindex,
+			bls.signature,
+			bls.pubkey,
+			bls.address
+		FROM blocks_bls_change bls
+		INNER JOIN blocks b ON b.slot = bls.block_slot AND b.blockroot = bls.block_root` + whereQuery +
+		" ORDER BY bls.block_slot" + sortSearchOrder + ", bls.validatorindex" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		BlockSlot uint64 `db:"block_slot"`
+		Validator uint64 `db:"validatorindex"`
+		Signature []byte `db:"signature"`
+		Pubkey    []byte `db:"pubkey"`
+		Address   []byte `db:"address"`
+	}
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]t.NetworkBlsChangeTableRow, 0, len(queryResult))
+	cursorData := make([]t.BlsChangesCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		address := hexutil.Encode(row.Address)
+		ensMapping[address] = ""
+		result = append(result, t.NetworkBlsChangeTableRow{
+			Validator:            row.Validator,
+			Epoch:                utils.EpochOfSlot(row.BlockSlot),
+			Slot:                 row.BlockSlot,
+			Signature:            t.Hash(hexutil.Encode(row.Signature)),
+			BlsPubkey:            t.Hash(hexutil.Encode(row.Pubkey)),
+			NewWithdrawalAddress: t.Address{Hash: t.Hash(address)},
+		})
+		cursorData = append(cursorData, t.BlsChangesCursor{BlockSlot: row.BlockSlot, Validator: row.Validator})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].NewWithdrawalAddress.Ens = ensMapping[string(result[i].NewWithdrawalAddress.Hash)]
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+// slashingsQuery lists one row per slashed validator, the slasher is the proposer of the including block as whistleblowing is not supported by the clients
+const slashingsQuery = `
+	SELECT
+		s.block_slot,
+		s.kind,
+		s.block_index,
+		s.slashed,
+		b.epoch,
+		b.proposer AS slasher
+	FROM (
+		SELECT
+			block_slot,
+			block_root,
+			0 AS kind,
+			block_index,
+			proposerindex AS slashed
+		FROM blocks_proposerslashings
+		UNION ALL
+		SELECT
+			block_slot,
+			block_root,
+			1 AS kind,
+			block_index,
+			UNNEST(ARRAY(
+				SELECT UNNEST(attestation1_indices)
+					INTERSECT
+				SELECT UNNEST(attestation2_indices)
+			)) AS slashed
+		FROM blocks_attesterslashings
+	) s
+	INNER JOIN blocks b ON b.slot = s.block_slot AND b.blockroot = s.block_root`
+
+// slashingParameters returns the initial penalty quotient and the proportional slashing multiplier of the fork active in the given epoch
+func slashingParameters(epoch uint64) (uint64, uint64) {
+	cfg := utils.Config.Chain.ClConfig
+	switch {
+	case epoch >= cfg.BellatrixForkEpoch:
+		return cfg.MinSlashingPenaltyQuotientBellatrix, cfg.ProportionalSlashingMultiplierBellatrix
+	case epoch >= cfg.AltairForkEpoch:
+		return cfg.MinSlashingPenaltyQuotientAltair, cfg.ProportionalSlashingMultiplierAltair
+	default:
+		return cfg.MinSlashingPenaltyQuotient, cfg.ProportionalSlashingMultiplier
+	}
+}
+
+func (d *DataAccessService) GetNetworkSlashings(ctx context.Context, chainId uint64, filter t.NetworkOperationsFilter, cursor string, limit uint64) ([]t.NetworkSlashingTableRow, *t.Paging, error) {
+	// TODO: implement handling of chainid
+	var currentCursor t.SlashingsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.SlashingsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as SlashingsCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE b.status = '1'"
+	if filter.Validator != nil {
+		queryParams = append(queryParams, *filter.Validator)
+		whereQuery += fmt.Sprintf(" AND (s.slashed = $%[1]d OR b.proposer = $%[1]d)", len(queryParams))
+	}
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.BlockSlot, currentCursor.Kind, currentCursor.BlockIndex, currentCursor.Validator)
+		whereQuery += fmt.Sprintf(" AND (s.block_slot, s.kind, s.block_index, s.slashed) %s ($%d, $%d, $%d, $%d)", sortSearchDirection, len(queryParams)-3, len(queryParams)-2, len(queryParams)-1, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := slashingsQuery + whereQuery +
+		" ORDER BY s.block_slot" + sortSearchOrder + ", s.kind" + sortSearchOrder + ", s.block_index" + sortSearchOrder + ", s.slashed" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []slashingRow
+	if err := d.readerDb.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, err
+	}
+	if len(queryResult) == 0 {
+		return []t.NetworkSlashingTableRow{}, &t.Paging{}, nil
+	}
+
+	penalties, err := d.getSlashingPenalties(ctx, queryResult)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.NetworkSlashingTableRow, 0, len(queryResult))
+	cursorData := make([]t.SlashingsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		slashingType := "proposer"
+		if row.Kind == 1 {
+			slashingType = "attester"
+		}
+		penalty := penalties[slashingKey{row.Slashed, row.Epoch}]
+		result = append(result, t.NetworkSlashingTableRow{
+			Slot:                row.BlockSlot,
+			Epoch:               row.Epoch,
+			Type:                slashingType,
+			Slashed:             row.Slashed,
+			Slasher:             row.Slasher,
+			WhistleblowerReward: penalty.whistleblowerReward,
+			Penalty:             penalty.NetworkSlashingPenalty,
+		})
+		cursorData = append(cursorData, t.SlashingsCursor{BlockSlot: row.BlockSlot, Kind: row.Kind, BlockIndex: row.BlockIndex, Validator: row.Slashed})
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+type slashingRow struct {
+	BlockSlot  uint64 `db:"block_slot"`
+	Kind       uint64 `db:"kind"`
+	BlockIndex uint64 `db:"block_index"`
+	Slashed    uint64 `db:"slashed"`
+	Epoch      uint64 `db:"epoch"`
+	Slasher    uint64 `db:"slasher"`
+}
+
+type slashingKey struct {
+	validator uint64
+	epoch     uint64
+}
+
+type slashingPenalty struct {
+	t.NetworkSlashingPenalty
+	whistleblowerReward decimal.Decimal
+}
+
+// getSlashingPenalties derives the rewards and penalties of the given slashings from the effective balance of the slashed validators in the slashing epoch,
+// the correlation penalty additionally depends on the number of validators slashed within EPOCHS_PER_SLASHINGS_VECTOR around the correlation epoch
+func (d *DataAccessService) getSlashingPenalties(ctx context.Context, slashings []slashingRow) (map[slashingKey]slashingPenalty, error) {
+	cfg := utils.Config.Chain.ClConfig
+	halfVector := cfg.EpochsPerSlashingsVector / 2
+
+	validatorsByEpoch := make(map[uint64][]uint64)
+	minEpoch, maxEpoch := slashings[0].Epoch, slashings[0].Epoch
+	for _, slashing := range slashings {
+		validatorsByEpoch[slashing.Epoch] = append(validatorsByEpoch[slashing.Epoch], slashing.Slashed)
+		minEpoch, maxEpoch = min(minEpoch, slashing.Epoch), max(maxEpoch, slashing.Epoch)
+	}
+
+	// effective balances in the slashing epochs, they are only updated at the end of the epoch
+	var mutex sync.Mutex
+	effectiveBalances := make(map[slashingKey]uint64)
+	wg := errgroup.Group{}
+	wg.SetLimit(10)
+	for epoch, validators := range validatorsByEpoch {
+		wg.Go(func() error {
+			balances, err := d.bigtable.GetValidatorBalanceHistory(validators, epoch, epoch)
+			if err != nil {
+				return err
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			for validator, history := range balances {
+				for _, balance := range history {
+					effectiveBalances[slashingKey{validator, balance.Epoch}] = balance.EffectiveBalance
+				}
+			}
+			return nil
+		})
+	}
+
+	// number of slashed validators per epoch in the range relevant for the correlation penalties
+	slashedPerEpoch := make(map[uint64]uint64)
+	var latestEpoch, totalBalance uint64
+	wg.Go(func() error {
+		var counts []struct {
+			Epoch uint64 `db:"epoch"`
+			Count uint64 `db:"count"`
+		}
+		startEpoch := uint64(0)
+		if minEpoch > halfVector {
+			startEpoch = minEpoch - halfVector
+		}
+		err := d.readerDb.SelectContext(ctx, &counts, `
+			SELECT s.epoch, COUNT(*) AS count
+			FROM (`+slashingsQuery+`
+				WHERE b.status = '1' AND b.epoch BETWEEN $1 AND $2
+			) s
+			GROUP BY s.epoch`, startEpoch, maxEpoch+halfVector)
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, count := range counts {
+			slashedPerEpoch[count.Epoch] = count.Count
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		// the total active balance barely changes within the range of a slashings vector, so the latest value is used
+		var data struct {
+			Epoch         uint64 `db:"epoch"`
+			EligibleEther uint64 `db:"eligibleether"`
+		}
+		err := d.readerDb.GetContext(ctx, &data, `SELECT epoch, COALESCE(eligibleether, 0) AS eligibleether FROM epochs ORDER BY epoch DESC LIMIT 1`)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		latestEpoch, totalBalance = data.Epoch, data.EligibleEther
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	gweiToWei := func(gwei uint64) decimal.Decimal {
+		return utils.GWeiToWei(new(big.Int).SetUint64(gwei))
+	}
+	result := make(map[slashingKey]slashingPenalty, len(slashings))
+	for _, slashing := range slashings {
+		key := slashingKey{slashing.Slashed, slashing.Epoch}
+		effectiveBalance, ok := effectiveBalances[key]
+		if !ok {
+			effectiveBalance = cfg.MaxEffectiveBalance
+		}
+		penaltyQuotient, multiplier := slashingParameters(slashing.Epoch)
+		correlationEpoch := slashing.Epoch + halfVector
+
+		// the slashings vector at the correlation epoch covers all slashings since the slashing epoch minus half the vector
+		slashedBalance := uint64(0)
+		for epoch := correlationEpoch + 1 - min(correlationEpoch+1, cfg.EpochsPerSlashingsVector); epoch <= correlationEpoch; epoch++ {
+			// the effective balances of the other slashed validators are unknown, assume the maximum
+			slashedBalance += slashedPerEpoch[epoch] * cfg.MaxEffectiveBalance
+		}
+		correlation := uint64(0)
+		if totalBalance > 0 {
+			adjustedSlashedBalance := min(slashedBalance*multiplier, totalBalance)
+			increments := effectiveBalance / cfg.EffectiveBalanceIncrement
+			correlation = new(big.Int).Div(
+				new(big.Int).Mul(new(big.Int).SetUint64(increments), new(big.Int).SetUint64(adjustedSlashedBalance)),
+				new(big.Int).SetUint64(totalBalance),
+			).Uint64() * cfg.EffectiveBalanceIncrement
+		}
+
+		result[key] = slashingPenalty{
+			NetworkSlashingPenalty: t.NetworkSlashingPenalty{
+				Initial:           gweiToWei(effectiveBalance / penaltyQuotient),
+				CorrelationEpoch:  correlationEpoch,
+				Correlation:       gweiToWei(correlation),
+				IsEstimated:       correlationEpoch > latestEpoch,
+				WithdrawableEpoch: slashing.Epoch + cfg.EpochsPerSlashingsVector,
+			},
+			whistleblowerReward: gweiToWei(effectiveBalance / cfg.WhistleblowerRewardQuotient),
+		}
+	}
+	return result, nil
+}
@@ -1,19 +1,333 @@
 package dataaccess
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	rpTypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/shopspring/decimal"
 )
 
 type ProtocolRepository interface {
 	// Rocket Pool
 	GetRocketPoolOverview(context.Context) (*types.RocketPoolData, error)
+	GetRocketPoolNetwork(ctx context.Context) (*types.RocketPoolNetwork, error)
+	GetRocketPoolNodes(ctx context.Context, cursor string, colSort types.Sort[enums.RocketPoolNodesColumn], filter types.RocketPoolNodesFilter, limit uint64) ([]types.RocketPoolNode, *types.Paging, error)
+	GetRocketPoolMinipools(ctx context.Context, cursor string, filter types.RocketPoolMinipoolsFilter, limit uint64) ([]types.RocketPoolMinipool, *types.Paging, error)
 
 	// Lido, ...
 }
 
 func (d *DataAccessService) GetRocketPoolOverview(ctx context.Context) (*types.RocketPoolData, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetRocketPoolOverview(ctx)
+	stats, err := d.getRocketPoolNetworkStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// rewards are updated at the end of each claim interval
+	lastUpdate := stats.ClaimIntervalTimeStart
+	nextUpdate := lastUpdate.Add(time.Duration(stats.ClaimIntervalSeconds) * time.Second)
+	ret := &types.RocketPoolData{
+		LastUpdateSlot: utils.TimeToSlot(uint64(lastUpdate.Unix())),
+		NextUpdateSlot: utils.TimeToSlot(uint64(nextUpdate.Unix())),
+	}
+	// rpl price is denominated in wei per rpl
+	ret.EthRates.Rpl, _ = stats.RplPrice.Div(decimal.NewFromInt(1e18)).Float64()
+	ret.EthRates.Reth = stats.RethExchangeRate
+	return ret, nil
+}
+
+func (d *DataAccessService) GetRocketPoolNetwork(ctx context.Context) (*types.RocketPoolNetwork, error) {
+	stats, err := d.getRocketPoolNetworkStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	intervalEnd := stats.ClaimIntervalTimeStart.Add(time.Duration(stats.ClaimIntervalSeconds) * time.Second)
+	return &types.RocketPoolNetwork{
+		RethExchangeRate:   stats.RethExchangeRate,
+		RethSupply:         stats.RethSupply,
+		RplPrice:           stats.RplPrice,
+		NodeFee:            stats.CurrentNodeFee * 100,
+		NodeDemand:         stats.CurrentNodeDemand,
+		NodeCount:          stats.NodeCount,
+		MinipoolCount:      stats.MinipoolCount,
+		OdaoMemberCount:    stats.OdaoMemberCount,
+		TotalEthStaking:    stats.TotalEthStaking,
+		TotalEthBalance:    stats.TotalEthBalance,
+		EffectiveRplStaked: stats.EffectiveRplStaked,
+		RplApr:             stats.rplApr(),
+		ClaimInterval: types.RocketPoolClaimInterval{
+			StartTs:             stats.ClaimIntervalTimeStart.Unix(),
+			EndTs:               intervalEnd.Unix(),
+			Duration:            uint64(stats.ClaimIntervalSeconds),
+			NodeOperatorRewards: stats.NodeOperatorRewards,
+		},
+		UpdateTs: stats.Ts.Unix(),
+	}, nil
+}
+
+func (d *DataAccessService) GetRocketPoolNodes(ctx context.Context, cursor string, colSort types.Sort[enums.RocketPoolNodesColumn], filter types.RocketPoolNodesFilter, limit uint64) ([]types.RocketPoolNode, *types.Paging, error) {
+	var currentCursor types.RocketPoolCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[types.RocketPoolCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolCursor: %w", err)
+		}
+	}
+
+	stats, err := d.getRocketPoolNetworkStats(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	nodes, err := d.getRocketPoolNodeList(ctx, stats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make([]types.RocketPoolNode, 0, len(nodes))
+	for _, node := range nodes {
+		if filter.SmoothingPool != nil && node.SmoothingPool.IsOptIn != *filter.SmoothingPool ||
+			filter.MinRplStake != nil && node.RplStake.LessThan(*filter.MinRplStake) ||
+			filter.MinCollateral != nil && node.Collateral.Percentage < *filter.MinCollateral ||
+			filter.MaxCollateral != nil && node.Collateral.Percentage > *filter.MaxCollateral {
+			continue
+		}
+		data = append(data, node)
+	}
+	if len(data) == 0 {
+		return []types.RocketPoolNode{}, &types.Paging{}, nil
+	}
+
+	// Sort the nodes, using the address as tiebreaker to keep the order stable for the cursor
+	slices.SortFunc(data, func(a, b types.RocketPoolNode) int {
+		c := 0
+		switch colSort.Column {
+		case enums.RocketPoolNodesRplStake:
+			c = a.RplStake.Cmp(b.RplStake)
+		case enums.RocketPoolNodesEffectiveRplStake:
+			c = a.EffectiveRplStake.Cmp(b.EffectiveRplStake)
+		case enums.RocketPoolNodesCollateral:
+			c = cmp.Compare(a.Collateral.Percentage, b.Collateral.Percentage)
+		case enums.RocketPoolNodesMinipools:
+			c = cmp.Compare(a.Minipools, b.Minipools)
+		}
+		if c == 0 {
+			c = strings.Compare(string(a.Node.Hash), string(b.Node.Hash))
+		}
+		if colSort.Desc {
+			return -c
+		}
+		return c
+	})
+
+	result, paging, err := getSortedDataPage(data, currentCursor, func(row types.RocketPoolNode) bool {
+		return row.Node.Hash == currentCursor.Node.Hash
+	}, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string, len(result))
+	for _, row := range result {
+		ensMapping[string(row.Node.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].Node.Ens = ensMapping[string(result[i].Node.Hash)]
+	}
+	return result, paging, nil
+}
+
+// the rocket pool exporter updates all nodes and the network stats in one run, so the node list is only rebuilt once per run
+var rocketPoolNodeListCache struct {
+	sync.Mutex
+	ts    time.Time
+	nodes []types.RocketPoolNode
+}
+
+// getRocketPoolNodeList returns all rocket pool nodes with their minipool aggregates and collateral, the result must not be modified
+func (d *DataAccessService) getRocketPoolNodeList(ctx context.Context, stats *rpNetworkStats) ([]types.RocketPoolNode, error) {
+	rocketPoolNodeListCache.Lock()
+	defer rocketPoolNodeListCache.Unlock()
+	if rocketPoolNodeListCache.nodes != nil && rocketPoolNodeListCache.ts.Equal(stats.Ts) {
+		return rocketPoolNodeListCache.nodes, nil
+	}
+
+	var nodes []struct {
+		rpNode
+		Minipools   uint64          `db:"minipools"`
+		BorrowedEth decimal.Decimal `db:"borrowed_eth"`
+	}
+	err := d.alloyReader.SelectContext(ctx, &nodes, `
+		SELECT
+			n.address,
+			n.timezone_location,
+			n.rpl_stake,
+			n.min_rpl_stake,
+			n.max_rpl_stake,
+			n.rpl_cumulative_rewards,
+			n.smoothing_pool_opted_in,
+			n.claimed_smoothing_pool,
+			n.unclaimed_smoothing_pool,
+			n.unclaimed_rpl_rewards,
+			n.effective_rpl_stake,
+			n.deposit_credit,
+			COALESCE(mp.minipools, 0) AS minipools,
+			COALESCE(mp.borrowed_eth, 0) AS borrowed_eth
+		FROM rocketpool_nodes n
+		LEFT JOIN (
+			SELECT node_address, COUNT(*) AS minipools, SUM(user_deposit_balance) AS borrowed_eth
+			FROM rocketpool_minipools
+			GROUP BY node_address
+		) mp ON mp.node_address = n.address`)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool nodes: %w", err)
+	}
+
+	result := make([]types.RocketPoolNode, 0, len(nodes))
+	for _, node := range nodes {
+		row := types.RocketPoolNode{
+			Node:              types.Address{Hash: types.Hash(hexutil.Encode(node.Address))},
+			Timezone:          node.TimezoneLocation,
+			RplStake:          node.RplStake,
+			EffectiveRplStake: node.EffectiveRplStake,
+			Collateral:        getRocketPoolCollateral(node.rpNode, node.BorrowedEth, stats.RplPrice),
+			BorrowedEth:       node.BorrowedEth,
+			Minipools:         node.Minipools,
+			DepositCredit:     node.DepositCredit.Decimal,
+		}
+		row.Rpl.Claimed = node.RplCumulativeRewards
+		row.Rpl.Unclaimed = node.UnclaimedRplRewards
+		row.SmoothingPool.IsOptIn = node.SmoothingPoolOptedIn
+		row.SmoothingPool.Claimed = node.ClaimedSmoothingPool
+		row.SmoothingPool.Unclaimed = node.UnclaimedSmoothingPool
+		result = append(result, row)
+	}
+	rocketPoolNodeListCache.ts = stats.Ts
+	rocketPoolNodeListCache.nodes = result
+	return result, nil
+}
+
+func (d *DataAccessService) GetRocketPoolMinipools(ctx context.Context, cursor string, filter types.RocketPoolMinipoolsFilter, limit uint64) ([]types.RocketPoolMinipool, *types.Paging, error) {
+	var currentCursor types.RocketPoolNetworkMinipoolsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[types.RocketPoolNetworkMinipoolsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolNetworkMinipoolsCursor: %w", err)
+		}
+	}
+	sortSearchDirection, sortSearchOrder := cursorDirection(currentCursor)
+
+	queryParams := []interface{}{}
+	whereQuery := " WHERE true"
+	if filter.Node != nil {
+		queryParams = append(queryParams, filter.Node)
+		whereQuery += fmt.Sprintf(" AND node_address = $%d", len(queryParams))
+	}
+	// compare against the stored casing instead of transforming every row of the columns
+	if filter.Status != "" {
+		queryParams = append(queryParams, getRocketPoolStoredValue(rpTypes.MinipoolStatuses, filter.Status))
+		whereQuery += fmt.Sprintf(" AND status = $%d", len(queryParams))
+	}
+	if filter.DepositType != "" {
+		queryParams = append(queryParams, getRocketPoolStoredValue(rpTypes.MinipoolDepositTypes, filter.DepositType))
+		whereQuery += fmt.Sprintf(" AND deposit_type = $%d", len(queryParams))
+	}
+	if currentCursor.IsValid() {
+		queryParams = append(queryParams, currentCursor.Address)
+		whereQuery += fmt.Sprintf(" AND address %s $%d", sortSearchDirection, len(queryParams))
+	}
+	queryParams = append(queryParams, limit+1)
+	query := `
+		SELECT
+			address,
+			pubkey,
+			node_address,
+			node_fee,
+			deposit_type,
+			status,
+			status_time,
+			penalty_count,
+			node_deposit_balance,
+			user_deposit_balance,
+			COALESCE(is_vacant, false) AS is_vacant
+		FROM rocketpool_minipools` + whereQuery +
+		" ORDER BY address" + sortSearchOrder +
+		fmt.Sprintf(" LIMIT $%d", len(queryParams))
+
+	var queryResult []struct {
+		rpDashboardMinipool
+		Address     []byte `db:"address"`
+		DepositType string `db:"deposit_type"`
+		IsVacant    bool   `db:"is_vacant"`
+	}
+	if err := d.alloyReader.SelectContext(ctx, &queryResult, query, queryParams...); err != nil {
+		return nil, nil, fmt.Errorf("error retrieving rocketpool minipools: %w", err)
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ensMapping := make(map[string]string)
+	result := make([]types.RocketPoolMinipool, 0, len(queryResult))
+	cursorData := make([]types.RocketPoolNetworkMinipoolsCursor, 0, len(queryResult))
+	for _, row := range queryResult {
+		pubkey := hexutil.Encode(row.Pubkey)
+		minipool := types.RocketPoolMinipool{
+			Address:     types.Address{Hash: types.Hash(hexutil.Encode(row.Address))},
+			Node:        types.Address{Hash: types.Hash(hexutil.Encode(row.NodeAddress))},
+			PublicKey:   types.PubKey(pubkey),
+			Status:      strings.ToLower(row.Status),
+			DepositType: strings.ToLower(row.DepositType),
+			Deposit:     row.NodeDepositBalance.Decimal,
+			BorrowedEth: row.UserDepositBalance.Decimal,
+			Commission:  row.NodeFee * 100,
+			Penalties:   row.PenaltyCount,
+			IsVacant:    row.IsVacant,
+		}
+		if validator, ok := validatorMapping.ValidatorIndices[pubkey]; ok {
+			index := uint64(validator)
+			minipool.ValidatorIndex = &index
+		}
+		if row.StatusTime.Valid {
+			minipool.StatusTs = row.StatusTime.Time.Unix()
+		}
+		ensMapping[string(minipool.Node.Hash)] = ""
+		result = append(result, minipool)
+		cursorData = append(cursorData, types.RocketPoolNetworkMinipoolsCursor{Address: row.Address})
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].Node.Ens = ensMapping[string(result[i].Node.Hash)]
+	}
+	return pageFromRows(result, cursorData, currentCursor, limit)
+}
+
+// minipool statuses and deposit types are stored as named by rocketpool-go, e.g. "Staking"
+func getRocketPoolStoredValue(storedValues []string, value string) string {
+	for _, stored := range storedValues {
+		if strings.EqualFold(stored, value) {
+			return stored
+		}
+	}
+	return value
 }
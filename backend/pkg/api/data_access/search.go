@@ -2,10 +2,15 @@ package dataaccess
 
 import (
 	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/pkg/errors"
 )
 
 type SearchRepository interface {
@@ -16,6 +21,15 @@ type SearchRepository interface {
 	GetSearchValidatorsByWithdrawalCredential(ctx context.Context, chainId uint64, credential []byte) (*t.SearchValidatorsByWithdrwalCredential, error)
 	GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithrawalEnsName, error)
 	GetSearchValidatorsByGraffiti(ctx context.Context, chainId uint64, graffiti string) (*t.SearchValidatorsByGraffiti, error)
+	GetSearchSlot(ctx context.Context, chainId, slot uint64) (*t.SearchSlot, error)
+	GetSearchSlotByBlockRoot(ctx context.Context, chainId uint64, blockRoot []byte) (*t.SearchSlot, error)
+	GetSearchEpoch(ctx context.Context, chainId, epoch uint64) (*t.SearchEpoch, error)
+	GetSearchBlock(ctx context.Context, chainId, number uint64) (*t.SearchBlock, error)
+	GetSearchBlockByHash(ctx context.Context, chainId uint64, hash []byte) (*t.SearchBlock, error)
+	GetSearchTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.SearchTransaction, error)
+	// addressPrefix is a hex string without 0x prefix, a full address always returns a result
+	GetSearchAddresses(ctx context.Context, chainId uint64, addressPrefix string, limit uint64) ([]t.SearchAddress, error)
+	GetSearchEnsNames(ctx context.Context, chainId uint64, ensNamePrefix string, limit uint64) ([]t.SearchEnsName, error)
 }
 
 func (d *DataAccessService) GetSearchValidatorByIndex(ctx context.Context, chainId, index uint64) (*t.SearchValidator, error) {
@@ -70,8 +84,19 @@ func (d *DataAccessService) GetSearchValidatorsByDepositAddress(ctx context.Cont
 
 func (d *DataAccessService) GetSearchValidatorsByDepositEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByDepositEnsName, error) {
 	// TODO: implement handling of chainid
-	// TODO: finalize ens implementation first
-	return nil, ErrNotFound
+	address, err := d.getEnsNameAddress(ctx, ensName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := d.GetSearchValidatorsByDepositAddress(ctx, chainId, address)
+	if err != nil {
+		return nil, err
+	}
+	return &t.SearchValidatorsByDepositEnsName{
+		EnsName: ensName,
+		Address: address,
+		Count:   result.Count,
+	}, nil
 }
 
 func (d *DataAccessService) GetSearchValidatorsByWithdrawalCredential(ctx context.Context, chainId uint64, credential []byte) (*t.SearchValidatorsByWithdrwalCredential, error) {
@@ -91,8 +116,23 @@ func (d *DataAccessService) GetSearchValidatorsByWithdrawalCredential(ctx contex
 
 func (d *DataAccessService) GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithrawalEnsName, error) {
 	// TODO: implement handling of chainid
-	// TODO: finalize ens implementation first
-	return nil, ErrNotFound
+	address, err := d.getEnsNameAddress(ctx, ensName)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := hex.DecodeString("010000000000000000000000" + hex.EncodeToString(address))
+	if err != nil {
+		return nil, err
+	}
+	result, err := d.GetSearchValidatorsByWithdrawalCredential(ctx, chainId, credential)
+	if err != nil {
+		return nil, err
+	}
+	return &t.SearchValidatorsByWithrawalEnsName{
+		EnsName: ensName,
+		Address: address,
+		Count:   result.Count,
+	}, nil
 }
 
 func (d *DataAccessService) GetSearchValidatorsByGraffiti(ctx context.Context, chainId uint64, graffiti string) (*t.SearchValidatorsByGraffiti, error) {
@@ -109,3 +149,178 @@ func (d *DataAccessService) GetSearchValidatorsByGraffiti(ctx context.Context, c
 	}
 	return ret, nil
 }
+
+// returns the address an ens name currently resolves to
+func (d *DataAccessService) getEnsNameAddress(ctx context.Context, ensName string) ([]byte, error) {
+	var address []byte
+	err := d.readerDb.GetContext(ctx, &address, `
+		SELECT address
+		FROM ens
+		WHERE ens_name = $1 AND valid_to >= now()`, ensName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: ens name %s not found", ErrNotFound, ensName)
+	}
+	return address, err
+}
+
+func (d *DataAccessService) GetSearchSlot(ctx context.Context, chainId, slot uint64) (*t.SearchSlot, error) {
+	// TODO: implement handling of chainid
+	ret := &t.SearchSlot{Slot: slot}
+	err := d.readerDb.GetContext(ctx, &ret.BlockRoot, `SELECT blockroot FROM blocks WHERE slot = $1 ORDER BY status = '1' DESC LIMIT 1`, slot)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (d *DataAccessService) GetSearchSlotByBlockRoot(ctx context.Context, chainId uint64, blockRoot []byte) (*t.SearchSlot, error) {
+	// TODO: implement handling of chainid
+	ret := &t.SearchSlot{BlockRoot: blockRoot}
+	err := d.readerDb.GetContext(ctx, &ret.Slot, `SELECT slot FROM blocks WHERE blockroot = $1 LIMIT 1`, blockRoot)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (d *DataAccessService) GetSearchEpoch(ctx context.Context, chainId, epoch uint64) (*t.SearchEpoch, error) {
+	// TODO: implement handling of chainid
+	var exists bool
+	err := d.readerDb.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM epochs WHERE epoch = $1)`, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return &t.SearchEpoch{Epoch: epoch}, nil
+}
+
+func (d *DataAccessService) GetSearchBlock(ctx context.Context, chainId, number uint64) (*t.SearchBlock, error) {
+	// TODO: implement handling of chainid
+	ret := &t.SearchBlock{Number: number}
+	err := d.readerDb.GetContext(ctx, &ret.Hash, `SELECT exec_block_hash FROM blocks WHERE exec_block_number = $1 AND status = '1' LIMIT 1`, number)
+	if err == nil {
+		return ret, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	// pre-merge blocks are only available in bigtable
+	block, err := d.bigtable.GetBlockFromBlocksTable(number)
+	if err != nil {
+		if errors.Is(err, db.ErrBlockNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	ret.Hash = block.Hash
+	return ret, nil
+}
+
+func (d *DataAccessService) GetSearchBlockByHash(ctx context.Context, chainId uint64, hash []byte) (*t.SearchBlock, error) {
+	// TODO: implement handling of chainid
+	ret := &t.SearchBlock{Hash: hash}
+	err := d.readerDb.GetContext(ctx, &ret.Number, `SELECT exec_block_number FROM blocks WHERE exec_block_hash = $1 AND status = '1' LIMIT 1`, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (d *DataAccessService) GetSearchTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.SearchTransaction, error) {
+	// TODO: implement handling of chainid
+	tx, err := d.bigtable.GetIndexedEth1Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ErrNotFound
+	}
+	return &t.SearchTransaction{
+		Hash:        tx.Hash,
+		BlockNumber: tx.BlockNumber,
+	}, nil
+}
+
+func (d *DataAccessService) GetSearchAddresses(ctx context.Context, chainId uint64, addressPrefix string, limit uint64) ([]t.SearchAddress, error) {
+	// TODO: implement handling of chainid
+	addressPrefix = strings.ToLower(addressPrefix)
+	// the metadata index is keyed by bytes, an odd number of nibbles has to be filtered after querying
+	queryLimit := limit
+	if len(addressPrefix)%2 == 1 {
+		queryLimit = limit * 16
+	}
+	prefix, err := hex.DecodeString(addressPrefix[:len(addressPrefix)/2*2])
+	if err != nil {
+		return nil, err
+	}
+	items, err := d.bigtable.SearchForAddress(prefix, int(queryLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.SearchAddress, 0, len(items))
+	for _, item := range items {
+		if !strings.HasPrefix(item.Address, addressPrefix) {
+			continue
+		}
+		address, err := hex.DecodeString(item.Address)
+		if err != nil {
+			// other metadata rows share the key space
+			continue
+		}
+		result = append(result, t.SearchAddress{
+			Address: address,
+			Name:    item.Name,
+			IsToken: item.Token != "",
+		})
+		if uint64(len(result)) >= limit {
+			break
+		}
+	}
+
+	// every full address is valid, even if no metadata is known about it
+	if len(result) == 0 && len(addressPrefix) == 40 {
+		result = append(result, t.SearchAddress{Address: prefix})
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetSearchEnsNames(ctx context.Context, chainId uint64, ensNamePrefix string, limit uint64) ([]t.SearchEnsName, error) {
+	// TODO: implement handling of chainid
+	var rows []struct {
+		EnsName string `db:"ens_name"`
+		Address []byte `db:"address"`
+	}
+	pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToLower(ensNamePrefix)) + "%"
+	err := d.readerDb.SelectContext(ctx, &rows, `
+		SELECT ens_name, address
+		FROM ens
+		WHERE ens_name LIKE $1 AND valid_to >= now()
+		ORDER BY LENGTH(ens_name), ens_name
+		LIMIT $2`, pattern, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	result := make([]t.SearchEnsName, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, t.SearchEnsName{EnsName: row.EnsName, Address: row.Address})
+	}
+	return result, nil
+}
@@ -0,0 +1,154 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	itypes "github.com/gobitfly/eth-rewards/types"
+	"golang.org/x/sync/errgroup"
+)
+
+type SyncCommitteeRepository interface {
+	// the committee of the next period is available as soon as it has been elected at the start of the current period
+	GetSyncCommittee(ctx context.Context, chainId uint64, period uint64) (*t.SyncCommittee, error)
+}
+
+func (d *DataAccessService) GetSyncCommittee(ctx context.Context, chainId uint64, period uint64) (*t.SyncCommittee, error) {
+	// TODO: implement handling of chainid
+	startEpoch := max(utils.FirstEpochOfSyncPeriod(period), utils.Config.Chain.ClConfig.AltairForkEpoch)
+	endEpoch := utils.FirstEpochOfSyncPeriod(period+1) - 1
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+	latestSlot, err := d.GetLatestSlot()
+	if err != nil {
+		return nil, err
+	}
+	latestEpoch := latestSlot / slotsPerEpoch
+
+	result := &t.SyncCommittee{
+		Period:     period,
+		StartEpoch: startEpoch,
+		EndEpoch:   endEpoch,
+		Status:     "ongoing",
+		Members:    []t.SyncCommitteeMember{},
+		Slots:      []t.SyncCommitteeSlot{},
+	}
+	switch {
+	case latestEpoch < startEpoch:
+		result.Status = "upcoming"
+	case latestEpoch > endEpoch:
+		result.Status = "completed"
+	}
+
+	// the committee index of a member is the position of its bit in the sync aggregates
+	var committee []t.VDBValidator
+	err = d.readerDb.SelectContext(ctx, &committee, `SELECT validatorindex FROM sync_committees WHERE period = $1 ORDER BY committeeindex`, period)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sync committee of period %d: %w", period, err)
+	}
+	if len(committee) == 0 {
+		return nil, fmt.Errorf("%w: sync committee of period %d", ErrNotFound, period)
+	}
+
+	// members are listed in the order of their first position
+	memberIdx := make(map[t.VDBValidator]int)
+	validators := make([]t.VDBValidator, 0, len(committee))
+	for position, validator := range committee {
+		idx, ok := memberIdx[validator]
+		if !ok {
+			idx = len(result.Members)
+			memberIdx[validator] = idx
+			validators = append(validators, validator)
+			result.Members = append(result.Members, t.SyncCommitteeMember{
+				Validator:   validator,
+				MissedSlots: []uint64{},
+			})
+		}
+		result.Members[idx].Positions = append(result.Members[idx].Positions, uint64(position))
+	}
+	if result.Status == "upcoming" {
+		return result, nil
+	}
+
+	var blocks []struct {
+		Slot     uint64 `db:"slot"`
+		Status   string `db:"status"`
+		SyncBits []byte `db:"syncaggregate_bits"`
+	}
+	var income map[uint64]map[uint64]*itypes.ValidatorEpochIncome
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		// orphaned blocks don't count towards the duties of the committee
+		err := d.readerDb.SelectContext(ctx, &blocks, `
+			SELECT slot, status, syncaggregate_bits
+			FROM blocks
+			WHERE slot BETWEEN $1 AND $2 AND status != '3'
+			ORDER BY slot`, startEpoch*slotsPerEpoch, min((endEpoch+1)*slotsPerEpoch-1, latestSlot))
+		if err != nil {
+			return fmt.Errorf("error retrieving blocks of sync period %d: %w", period, err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		income, err = d.bigtable.GetValidatorIncomeDetailsHistory(validators, startEpoch, min(endEpoch, latestEpoch))
+		if err != nil {
+			return fmt.Errorf("error retrieving sync committee rewards of period %d: %w", period, err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	var participationSum float64
+	var proposedSlots uint64
+	for _, block := range blocks {
+		slot := t.SyncCommitteeSlot{Slot: block.Slot}
+		switch block.Status {
+		case "0":
+			slot.Status = "scheduled"
+		case "2":
+			slot.Status = "missed"
+		case "1":
+			slot.Status = "proposed"
+			for position, validator := range committee {
+				member := &result.Members[memberIdx[validator]]
+				if utils.BitAtVector(block.SyncBits, position) {
+					member.Participated++
+					slot.Participants++
+					continue
+				}
+				member.Missed++
+				if len(member.MissedSlots) == 0 || member.MissedSlots[len(member.MissedSlots)-1] != block.Slot {
+					member.MissedSlots = append(member.MissedSlots, block.Slot)
+				}
+			}
+			slot.Participation = float64(slot.Participants) / float64(len(committee))
+			participationSum += slot.Participation
+			proposedSlots++
+		}
+		result.Slots = append(result.Slots, slot)
+	}
+	if proposedSlots > 0 {
+		result.Participation = participationSum / float64(proposedSlots)
+	}
+
+	var totalRewards, totalPenalties uint64
+	for i := range result.Members {
+		var rewards, penalties uint64
+		for _, epochIncome := range income[result.Members[i].Validator] {
+			rewards += epochIncome.SyncCommitteeReward
+			penalties += epochIncome.SyncCommitteePenalty
+		}
+		result.Members[i].Rewards = utils.GWeiToWei(new(big.Int).SetUint64(rewards))
+		result.Members[i].Penalties = utils.GWeiToWei(new(big.Int).SetUint64(penalties))
+		totalRewards += rewards
+		totalPenalties += penalties
+	}
+	result.Rewards = utils.GWeiToWei(new(big.Int).SetUint64(totalRewards))
+	result.Penalties = utils.GWeiToWei(new(big.Int).SetUint64(totalPenalties))
+	return result, nil
+}
@@ -27,7 +27,6 @@ type UserRepository interface {
 	UpdateEmailConfirmationHash(ctx context.Context, userId uint64, email, confirmationHash string) error
 	UpdatePasswordResetHash(ctx context.Context, userId uint64, passwordHash string) error
 	GetUserCredentialInfo(ctx context.Context, userId uint64) (*t.UserCredentialInfo, error)
-	GetUserIdByApiKey(ctx context.Context, apiKey string) (uint64, error)
 	GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error)
 	GetUserIdByResetHash(ctx context.Context, hash string) (uint64, error)
 	GetUserInfo(ctx context.Context, id uint64) (*t.UserInfo, error)
@@ -225,15 +224,6 @@ func (d *DataAccessService) GetUserCredentialInfo(ctx context.Context, userId ui
 	return result, err
 }
 
-func (d *DataAccessService) GetUserIdByApiKey(ctx context.Context, apiKey string) (uint64, error) {
-	var userId uint64
-	err := d.userReader.GetContext(ctx, &userId, `SELECT user_id FROM api_keys WHERE api_key = $1 LIMIT 1`, apiKey)
-	if errors.Is(err, sql.ErrNoRows) {
-		return 0, fmt.Errorf("%w: user for api_key not found", ErrNotFound)
-	}
-	return userId, err
-}
-
 func (d *DataAccessService) GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error) {
 	var result uint64
 
@@ -294,7 +284,7 @@ func (d *DataAccessService) GetUserInfo(ctx context.Context, userId uint64) (*t.
 
 	userInfo.Email = utils.CensorEmail(userInfo.Email)
 
-	err = d.userReader.SelectContext(ctx, &userInfo.ApiKeys, `SELECT api_key FROM api_keys WHERE user_id = $1`, userId)
+	err = d.userReader.SelectContext(ctx, &userInfo.ApiKeys, `SELECT api_key FROM api_keys WHERE user_id = $1 AND revoked = false AND valid_until > NOW() ORDER BY id`, userId)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, fmt.Errorf("error getting userApiKeys for user %v: %w", userId, err)
 	}
@@ -444,14 +434,18 @@ var freeTierProduct t.PremiumProduct = t.PremiumProduct{
 		ValidatorDashboards:         1,
 		ValidatorsPerDashboard:      20,
 		ValidatorGroupsPerDashboard: 1,
+		AccountDashboards:           1,
+		AccountsPerDashboard:        20,
+		AccountGroupsPerDashboard:   1,
 		ShareCustomDashboards:       false,
 		ManageDashboardViaApi:       false,
 		BulkAdding:                  false,
 		ChartHistorySeconds: t.ChartHistorySeconds{
-			Epoch:  0,
-			Hourly: 12 * hour,
-			Daily:  0,
-			Weekly: 0,
+			Epoch:   0,
+			Hourly:  12 * hour,
+			Daily:   0,
+			Weekly:  0,
+			Monthly: 0,
 		},
 		EmailNotificationsPerDay:        5,
 		ConfigureNotificationsViaApi:    false,
@@ -549,14 +543,18 @@ func (d *DataAccessService) GetProductSummary(ctx context.Context) (*t.ProductSu
 					ValidatorDashboards:         1,
 					ValidatorsPerDashboard:      100,
 					ValidatorGroupsPerDashboard: 3,
+					AccountDashboards:           1,
+					AccountsPerDashboard:        100,
+					AccountGroupsPerDashboard:   3,
 					ShareCustomDashboards:       true,
 					ManageDashboardViaApi:       false,
 					BulkAdding:                  true,
 					ChartHistorySeconds: t.ChartHistorySeconds{
-						Epoch:  day,
-						Hourly: 7 * day,
-						Daily:  month,
-						Weekly: 0,
+						Epoch:   day,
+						Hourly:  7 * day,
+						Daily:   month,
+						Weekly:  0,
+						Monthly: 0,
 					},
 					EmailNotificationsPerDay:        15,
 					ConfigureNotificationsViaApi:    false,
@@ -582,14 +580,18 @@ func (d *DataAccessService) GetProductSummary(ctx context.Context) (*t.ProductSu
 					ValidatorDashboards:         2,
 					ValidatorsPerDashboard:      300,
 					ValidatorGroupsPerDashboard: 10,
+					AccountDashboards:           2,
+					AccountsPerDashboard:        300,
+					AccountGroupsPerDashboard:   10,
 					ShareCustomDashboards:       true,
 					ManageDashboardViaApi:       false,
 					BulkAdding:                  true,
 					ChartHistorySeconds: t.ChartHistorySeconds{
-						Epoch:  5 * day,
-						Hourly: month,
-						Daily:  2 * month,
-						Weekly: 8 * week,
+						Epoch:   5 * day,
+						Hourly:  month,
+						Daily:   2 * month,
+						Weekly:  8 * week,
+						Monthly: 8 * week,
 					},
 					EmailNotificationsPerDay:        20,
 					ConfigureNotificationsViaApi:    false,
@@ -615,14 +617,18 @@ func (d *DataAccessService) GetProductSummary(ctx context.Context) (*t.ProductSu
 					ValidatorDashboards:         2,
 					ValidatorsPerDashboard:      1000,
 					ValidatorGroupsPerDashboard: 30,
+					AccountDashboards:           2,
+					AccountsPerDashboard:        1000,
+					AccountGroupsPerDashboard:   30,
 					ShareCustomDashboards:       true,
 					ManageDashboardViaApi:       true,
 					BulkAdding:                  true,
 					ChartHistorySeconds: t.ChartHistorySeconds{
-						Epoch:  3 * week,
-						Hourly: 6 * month,
-						Daily:  12 * month,
-						Weekly: fullHistory,
+						Epoch:   3 * week,
+						Hourly:  6 * month,
+						Daily:   12 * month,
+						Weekly:  fullHistory,
+						Monthly: fullHistory,
 					},
 					EmailNotificationsPerDay:        50,
 					ConfigureNotificationsViaApi:    true,
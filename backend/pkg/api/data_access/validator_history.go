@@ -0,0 +1,283 @@
+package dataaccess
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	itypes "github.com/gobitfly/eth-rewards/types"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+// historyBucket returns the epoch and the day (only for daily aggregation) the given epoch is aggregated into
+func historyBucket(epoch uint64, aggregation enums.ChartAggregation) (uint64, *uint64) {
+	if aggregation != enums.IntervalDaily {
+		return epoch, nil
+	}
+	day := epoch / utils.EpochsPerDay()
+	firstEpoch, _ := utils.GetFirstAndLastEpochForDay(day)
+	return firstEpoch, &day
+}
+
+type validatorHistoryKey struct {
+	validator t.VDBValidator
+	epoch     uint64
+}
+
+// sortedHistoryRows returns the rows ordered by epoch and validator
+func sortedHistoryRows[T any](rows map[validatorHistoryKey]*T) []T {
+	keys := make([]validatorHistoryKey, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b validatorHistoryKey) int {
+		if a.epoch != b.epoch {
+			if a.epoch < b.epoch {
+				return -1
+			}
+			return 1
+		}
+		return int(a.validator) - int(b.validator)
+	})
+	result := make([]T, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *rows[key])
+	}
+	return result
+}
+
+func (d *DataAccessService) GetNetworkValidatorsRewardHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorRewardHistoryRow, error) {
+	// TODO: implement handling of chainid
+	income, err := d.bigtable.GetValidatorIncomeDetailsHistory(validators, startEpoch, endEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// sum up in gwei (wei for el rewards) and convert once per row
+	type rewardSums struct {
+		source, target, head, sync, slashing, inactivity int64
+		attInc, syncInc, slashingInc                     int64
+		el                                               *big.Int
+		proposalsMissed                                  uint64
+		day                                              *uint64
+	}
+	sums := make(map[validatorHistoryKey]*rewardSums)
+	for validator, epochs := range income {
+		for epoch, details := range epochs {
+			if details == nil {
+				continue
+			}
+			bucketEpoch, day := historyBucket(epoch, aggregation)
+			key := validatorHistoryKey{validator: validator, epoch: bucketEpoch}
+			s, ok := sums[key]
+			if !ok {
+				s = &rewardSums{el: new(big.Int), day: day}
+				sums[key] = s
+			}
+			s.source += int64(details.AttestationSourceReward) - int64(details.AttestationSourcePenalty)
+			s.target += int64(details.AttestationTargetReward) - int64(details.AttestationTargetPenalty)
+			s.head += int64(details.AttestationHeadReward)
+			s.sync += int64(details.SyncCommitteeReward) - int64(details.SyncCommitteePenalty)
+			s.slashing += int64(details.SlashingReward) - int64(details.SlashingPenalty)
+			s.inactivity -= int64(details.FinalityDelayPenalty)
+			s.attInc += int64(details.ProposerAttestationInclusionReward)
+			s.syncInc += int64(details.ProposerSyncInclusionReward)
+			s.slashingInc += int64(details.ProposerSlashingInclusionReward)
+			s.el.Add(s.el, new(big.Int).SetBytes(details.TxFeeRewardWei))
+			s.proposalsMissed += details.ProposalsMissed
+		}
+	}
+
+	gweiToWei := func(gwei int64) decimal.Decimal {
+		return utils.GWeiToWei(big.NewInt(gwei))
+	}
+	rows := make(map[validatorHistoryKey]*t.NetworkValidatorRewardHistoryRow, len(sums))
+	for key, s := range sums {
+		rows[key] = &t.NetworkValidatorRewardHistoryRow{
+			Validator: key.validator,
+			Epoch:     key.epoch,
+			Day:       s.day,
+			Reward: t.ClElValue[decimal.Decimal]{
+				Cl: gweiToWei(s.source + s.target + s.head + s.sync + s.slashing + s.inactivity + s.attInc + s.syncInc + s.slashingInc),
+				El: decimal.NewFromBigInt(s.el, 0),
+			},
+			ClDetails: t.NetworkValidatorRewardDetails{
+				AttestationSource:           gweiToWei(s.source),
+				AttestationTarget:           gweiToWei(s.target),
+				AttestationHead:             gweiToWei(s.head),
+				Sync:                        gweiToWei(s.sync),
+				Slashing:                    gweiToWei(s.slashing),
+				Inactivity:                  gweiToWei(s.inactivity),
+				ProposalClAttIncReward:      gweiToWei(s.attInc),
+				ProposalClSyncIncReward:     gweiToWei(s.syncInc),
+				ProposalClSlashingIncReward: gweiToWei(s.slashingInc),
+			},
+			ProposalsMissed: s.proposalsMissed,
+		}
+	}
+	return sortedHistoryRows(rows), nil
+}
+
+func (d *DataAccessService) GetNetworkValidatorsBalanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorBalanceHistoryRow, error) {
+	// TODO: implement handling of chainid
+	rows := make(map[validatorHistoryKey]*t.NetworkValidatorBalanceHistoryRow)
+	addBalances := func(epochStart, epochEnd uint64, day *uint64) error {
+		balances, err := d.bigtable.GetValidatorBalanceHistory(validators, epochStart, epochEnd)
+		if err != nil {
+			return err
+		}
+		for validator, history := range balances {
+			for _, balance := range history {
+				rows[validatorHistoryKey{validator, balance.Epoch}] = &t.NetworkValidatorBalanceHistoryRow{
+					Validator:        validator,
+					Epoch:            balance.Epoch,
+					Day:              day,
+					Balance:          utils.GWeiToWei(new(big.Int).SetUint64(balance.Balance)),
+					EffectiveBalance: utils.GWeiToWei(new(big.Int).SetUint64(balance.EffectiveBalance)),
+				}
+			}
+		}
+		return nil
+	}
+
+	if aggregation != enums.IntervalDaily {
+		if err := addBalances(startEpoch, endEpoch, nil); err != nil {
+			return nil, err
+		}
+		return sortedHistoryRows(rows), nil
+	}
+
+	// only the end of day balance is relevant, so query the last epoch of each day
+	for day := startEpoch / utils.EpochsPerDay(); day <= endEpoch/utils.EpochsPerDay(); day++ {
+		_, lastEpoch := utils.GetFirstAndLastEpochForDay(day)
+		lastEpoch = min(lastEpoch, endEpoch)
+		if err := addBalances(lastEpoch, lastEpoch, &day); err != nil {
+			return nil, err
+		}
+	}
+	return sortedHistoryRows(rows), nil
+}
+
+func (d *DataAccessService) GetNetworkValidatorsPerformanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorPerformanceHistoryRow, error) {
+	// TODO: implement handling of chainid
+	attestations, err := d.bigtable.GetValidatorAttestationHistory(validators, startEpoch, endEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[validatorHistoryKey]*t.NetworkValidatorPerformanceHistoryRow)
+	inclusionDelaySums := make(map[validatorHistoryKey]int64)
+	for validator, history := range attestations {
+		for _, attestation := range history {
+			bucketEpoch, day := historyBucket(attestation.Epoch, aggregation)
+			key := validatorHistoryKey{validator: validator, epoch: bucketEpoch}
+			row, ok := rows[key]
+			if !ok {
+				row = &t.NetworkValidatorPerformanceHistoryRow{
+					Validator: validator,
+					Epoch:     bucketEpoch,
+					Day:       day,
+				}
+				rows[key] = row
+			}
+			if attestation.Status == 1 {
+				row.Attestations.Success++
+				inclusionDelaySums[key] += attestation.Delay
+			} else {
+				row.Attestations.Failed++
+			}
+		}
+	}
+	for key, row := range rows {
+		if row.Attestations.Success > 0 {
+			row.AvgInclusionDelay = float64(inclusionDelaySums[key]) / float64(row.Attestations.Success)
+		}
+	}
+	return sortedHistoryRows(rows), nil
+}
+
+func (d *DataAccessService) GetNetworkValidatorsAttestations(ctx context.Context, chainId uint64, validators []t.VDBValidator, startEpoch, endEpoch uint64) ([]t.NetworkValidatorAttestation, error) {
+	// TODO: implement handling of chainid
+	var attestations map[uint64][]*types.ValidatorAttestation
+	var income map[uint64]map[uint64]*itypes.ValidatorEpochIncome
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		attestations, err = d.bigtable.GetValidatorAttestationHistory(validators, startEpoch, endEpoch)
+		return err
+	})
+	wg.Go(func() error {
+		// the rewards and penalties of the epoch tell whether the votes were correct and timely
+		var err error
+		income, err = d.bigtable.GetValidatorIncomeDetailsHistory(validators, startEpoch, endEpoch)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	rows := make(map[validatorHistoryKey]*t.NetworkValidatorAttestation)
+	for validator, history := range attestations {
+		for _, attestation := range history {
+			row := &t.NetworkValidatorAttestation{
+				Validator:    validator,
+				Epoch:        attestation.Epoch,
+				AttesterSlot: attestation.AttesterSlot,
+				Status:       "missed",
+			}
+			if attestation.Status == 1 {
+				row.Status = "success"
+				inclusionSlot := attestation.InclusionSlot
+				row.InclusionSlot = &inclusionSlot
+				if attestation.Delay >= 0 {
+					inclusionDelay := uint64(attestation.Delay)
+					row.InclusionDelay = &inclusionDelay
+				}
+			}
+			if attestation.Status != 1 {
+				// a vote that wasn't included can't be timely
+				notTimely := false
+				row.TimelySource, row.TimelyTarget, row.TimelyHead = &notTimely, &notTimely, &notTimely
+			} else if details, ok := income[validator][attestation.Epoch]; ok && details != nil {
+				row.TimelySource, row.TimelyTarget, row.TimelyHead = getAttestationFlags(details, attestation.InclusionSlot-attestation.AttesterSlot)
+			}
+			rows[validatorHistoryKey{validator: validator, epoch: attestation.Epoch}] = row
+		}
+	}
+	return sortedHistoryRows(rows), nil
+}
+
+// getAttestationFlags derives the timely flags of an included vote from the rewards of its epoch.
+// During an inactivity leak no flag is rewarded, so a vote that is neither rewarded nor penalized is unknown (nil).
+// Missed head votes aren't penalized, they are only known if the vote wasn't included in the next slot or other flags were rewarded.
+func getAttestationFlags(details *itypes.ValidatorEpochIncome, inclusionDistance uint64) (source, target, head *bool) {
+	flag := func(reward, penalty uint64) *bool {
+		var timely bool
+		switch {
+		case reward > 0:
+			timely = true
+		case penalty > 0:
+			timely = false
+		default:
+			return nil
+		}
+		return &timely
+	}
+	source = flag(details.AttestationSourceReward, details.AttestationSourcePenalty)
+	target = flag(details.AttestationTargetReward, details.AttestationTargetPenalty)
+	var timelyHead bool
+	switch {
+	case details.AttestationHeadReward > 0:
+		timelyHead = true
+	case inclusionDistance > 1 || details.AttestationSourceReward > 0 || details.AttestationTargetReward > 0:
+		timelyHead = false
+	default:
+		return source, target, nil
+	}
+	return source, target, &timelyHead
+}
@@ -0,0 +1,363 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+type ValidatorRepository interface {
+	GetNetworkValidators(ctx context.Context, chainId uint64, validators []t.VDBValidator, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], search string, limit uint64) ([]t.NetworkValidator, *t.Paging, error)
+	GetNetworkValidator(ctx context.Context, chainId uint64, validator t.VDBValidator) (*t.NetworkValidator, error)
+	GetNetworkValidatorDuties(ctx context.Context, chainId uint64, validator t.VDBValidator) ([]t.NetworkValidatorDuty, error)
+	GetNetworkAddressValidators(ctx context.Context, chainId uint64, address string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error)
+	GetNetworkWithdrawalCredentialValidators(ctx context.Context, chainId uint64, credential string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error)
+
+	// startEpoch and endEpoch are inclusive, aggregation is either epoch or daily
+	GetNetworkValidatorsRewardHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorRewardHistoryRow, error)
+	GetNetworkValidatorsBalanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorBalanceHistoryRow, error)
+	GetNetworkValidatorsPerformanceHistory(ctx context.Context, chainId uint64, validators []t.VDBValidator, aggregation enums.ChartAggregation, startEpoch, endEpoch uint64) ([]t.NetworkValidatorPerformanceHistoryRow, error)
+	GetNetworkValidatorsAttestations(ctx context.Context, chainId uint64, validators []t.VDBValidator, startEpoch, endEpoch uint64) ([]t.NetworkValidatorAttestation, error)
+}
+
+func toNetworkValidator(index t.VDBValidator, metadata *types.CachedValidator) t.NetworkValidator {
+	nullableEpoch := func(epoch int64, valid bool) *uint64 {
+		// far future epochs are stored as the max sql number
+		if !valid || epoch < 0 || uint64(epoch) >= db.MaxSqlNumber {
+			return nil
+		}
+		e := uint64(epoch)
+		return &e
+	}
+
+	row := t.NetworkValidator{
+		Index:                      index,
+		PublicKey:                  t.PubKey(hexutil.Encode(metadata.PublicKey)),
+		Status:                     metadata.Status,
+		Balance:                    utils.GWeiToWei(new(big.Int).SetUint64(metadata.Balance)),
+		EffectiveBalance:           utils.GWeiToWei(new(big.Int).SetUint64(metadata.EffectiveBalance)),
+		WithdrawalCredential:       t.Hash(hexutil.Encode(metadata.WithdrawalCredentials)),
+		Slashed:                    metadata.Slashed,
+		ActivationEligibilityEpoch: nullableEpoch(metadata.ActivationEligibilityEpoch.Int64, metadata.ActivationEligibilityEpoch.Valid),
+		ActivationEpoch:            nullableEpoch(metadata.ActivationEpoch.Int64, metadata.ActivationEpoch.Valid),
+		ExitEpoch:                  nullableEpoch(metadata.ExitEpoch.Int64, metadata.ExitEpoch.Valid),
+		WithdrawableEpoch:          nullableEpoch(metadata.WithdrawableEpoch.Int64, metadata.WithdrawableEpoch.Valid),
+	}
+	if constypes.ValidatorDbStatus(metadata.Status) == constypes.DbPending && metadata.Queues.ActivationIndex.Valid {
+		activationIndex := uint64(metadata.Queues.ActivationIndex.Int64)
+		row.QueuePosition = &activationIndex
+	}
+	return row
+}
+
+// getNetworkValidatorsPage sorts and pages the given validators (all validators if nil) using the current validator mapping.
+// Rows are only built for the returned page, so this also works for the full validator set.
+func (d *DataAccessService) getNetworkValidatorsPage(validators []t.VDBValidator, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], search string, limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	var currentCursor t.ValidatorsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.ValidatorsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as ValidatorsCursor: %w", err)
+		}
+	}
+
+	var paging t.Paging
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorCount := t.VDBValidator(len(validatorMapping.ValidatorMetadata))
+
+	if validators == nil {
+		validators = make([]t.VDBValidator, 0, validatorCount)
+		for i := t.VDBValidator(0); i < validatorCount; i++ {
+			validators = append(validators, i)
+		}
+	}
+
+	// Apply the search and drop validators unknown to the mapping
+	searchIndex, searchIndexErr := strconv.ParseUint(search, 10, 64)
+	searchPubkey := strings.ToLower(strings.TrimPrefix(search, "0x"))
+	data := make([]t.VDBValidator, 0, len(validators))
+	for _, validator := range validators {
+		if validator >= validatorCount {
+			continue
+		}
+		if search != "" {
+			indexSearch := searchIndexErr == nil && searchIndex == validator
+			pubkeySearch := searchPubkey == strings.TrimPrefix(validatorMapping.ValidatorPubkeys[validator], "0x")
+			if !indexSearch && !pubkeySearch {
+				continue
+			}
+		}
+		data = append(data, validator)
+	}
+
+	if len(data) == 0 {
+		return []t.NetworkValidator{}, &paging, nil
+	}
+
+	// Sort the validators, using the index as tiebreaker to keep the order stable for the cursor
+	sort.Slice(data, func(i, j int) bool {
+		a := validatorMapping.ValidatorMetadata[data[i]]
+		b := validatorMapping.ValidatorMetadata[data[j]]
+		switch colSort.Column {
+		case enums.NetworkValidatorsPublicKey:
+			if c := bytes.Compare(a.PublicKey, b.PublicKey); c != 0 {
+				return (c < 0) != colSort.Desc
+			}
+		case enums.NetworkValidatorsBalance:
+			if a.Balance != b.Balance {
+				return (a.Balance < b.Balance) != colSort.Desc
+			}
+		case enums.NetworkValidatorsStatus:
+			if a.Status != b.Status {
+				return (a.Status < b.Status) != colSort.Desc
+			}
+		case enums.NetworkValidatorsWithdrawalCredential:
+			if c := bytes.Compare(a.WithdrawalCredentials, b.WithdrawalCredentials); c != 0 {
+				return (c < 0) != colSort.Desc
+			}
+		}
+		return (data[i] < data[j]) != colSort.Desc
+	})
+
+	// Find the index for the cursor and limit the data
+	var cursorIndex uint64
+	if currentCursor.IsValid() {
+		for idx, validator := range data {
+			if validator == currentCursor.Index {
+				cursorIndex = uint64(idx)
+				break
+			}
+		}
+	}
+
+	var page []t.VDBValidator
+	if currentCursor.IsReverse() {
+		// opposite direction
+		var limitCutoff uint64
+		if cursorIndex > limit+1 {
+			limitCutoff = cursorIndex - limit - 1
+		}
+		page = data[limitCutoff:cursorIndex]
+	} else {
+		if currentCursor.IsValid() {
+			cursorIndex++
+		}
+		limitCutoff := min(cursorIndex+limit+1, uint64(len(data)))
+		page = data[cursorIndex:limitCutoff]
+	}
+
+	result := make([]t.NetworkValidator, 0, len(page))
+	for _, validator := range page {
+		result = append(result, toNetworkValidator(validator, validatorMapping.ValidatorMetadata[validator]))
+	}
+
+	// flag if above limit
+	moreDataFlag := len(result) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return result, &paging, nil
+	}
+
+	// remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		if currentCursor.IsReverse() {
+			result = result[1:]
+		} else {
+			result = result[:len(result)-1]
+		}
+	}
+
+	p, err := utils.GetPagingFromData(result, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetNetworkValidators(ctx context.Context, chainId uint64, validators []t.VDBValidator, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], search string, limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	return d.getNetworkValidatorsPage(validators, cursor, colSort, search, limit)
+}
+
+func (d *DataAccessService) GetNetworkValidator(ctx context.Context, chainId uint64, validator t.VDBValidator) (*t.NetworkValidator, error) {
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, err
+	}
+	if validator >= t.VDBValidator(len(validatorMapping.ValidatorMetadata)) {
+		return nil, fmt.Errorf("%w: validator %d", ErrNotFound, validator)
+	}
+	result := toNetworkValidator(validator, validatorMapping.ValidatorMetadata[validator])
+	return &result, nil
+}
+
+// GetNetworkValidatorDuties returns the duties of the validator for the epochs currently tracked by the slot viz service,
+// i.e. the last few finished epochs, the head epoch and the next epoch.
+func (d *DataAccessService) GetNetworkValidatorDuties(ctx context.Context, chainId uint64, validator t.VDBValidator) ([]t.NetworkValidatorDuty, error) {
+	if _, err := d.GetNetworkValidator(ctx, chainId, validator); err != nil {
+		return nil, err
+	}
+
+	dutiesInfo, err := d.services.GetCurrentDutiesInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []t.NetworkValidatorDuty{}
+
+	// Proposals
+	for slot, proposer := range dutiesInfo.PropAssignmentsForSlot {
+		if proposer != validator {
+			continue
+		}
+		duty := t.NetworkValidatorDuty{
+			Type:   "proposal",
+			Epoch:  utils.EpochOfSlot(slot),
+			Slot:   slot,
+			Status: "scheduled",
+		}
+		if status, ok := dutiesInfo.SlotStatus[slot]; ok {
+			switch status {
+			case 1:
+				duty.Status = "success"
+				if block, ok := dutiesInfo.SlotBlock[slot]; ok {
+					duty.Block = &block
+				}
+			case 0, 2, 3:
+				duty.Status = "failed"
+			}
+		}
+		result = append(result, duty)
+	}
+
+	// Attestations
+	for slot, attested := range dutiesInfo.EpochAttestationDuties[validator] {
+		duty := t.NetworkValidatorDuty{
+			Type:   "attestation",
+			Epoch:  utils.EpochOfSlot(uint64(slot)),
+			Slot:   uint64(slot),
+			Status: "scheduled",
+		}
+		if uint64(slot) < dutiesInfo.LatestProposedSlot {
+			duty.Status = "failed"
+			if attested {
+				duty.Status = "success"
+			}
+		}
+		result = append(result, duty)
+	}
+
+	// Sync committee
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+	for epoch, assigned := range dutiesInfo.SyncAssignmentsForEpoch {
+		if !assigned[validator] {
+			continue
+		}
+		for slot := epoch * slotsPerEpoch; slot < (epoch+1)*slotsPerEpoch; slot++ {
+			duty := t.NetworkValidatorDuty{
+				Type:   "sync",
+				Epoch:  epoch,
+				Slot:   slot,
+				Status: "scheduled",
+			}
+			if slot <= dutiesInfo.LatestSlot {
+				duty.Status = "failed"
+				if dutiesInfo.SlotSyncParticipated[slot][validator] {
+					duty.Status = "success"
+				}
+			}
+			result = append(result, duty)
+		}
+	}
+
+	// Newest duties first
+	slices.SortFunc(result, func(a, b t.NetworkValidatorDuty) int {
+		if a.Slot != b.Slot {
+			return int(b.Slot) - int(a.Slot)
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	return result, nil
+}
+
+// GetNetworkAddressValidators returns the validators that were deposited from the given address
+// or that withdraw to it.
+func (d *DataAccessService) GetNetworkAddressValidators(ctx context.Context, chainId uint64, address string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	addressBytes, err := hexutil.Decode(address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode address %s: %w", address, err)
+	}
+
+	var depositPubkeys [][]byte
+	err = d.readerDb.SelectContext(ctx, &depositPubkeys, `
+		SELECT DISTINCT publickey
+		FROM eth1_deposits
+		WHERE from_address = $1 AND valid_signature`, addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validatorSet := make(map[t.VDBValidator]bool)
+	for _, pubkey := range depositPubkeys {
+		if validator, ok := validatorMapping.ValidatorIndices[hexutil.Encode(pubkey)]; ok {
+			validatorSet[validator] = true
+		}
+	}
+	for validator, metadata := range validatorMapping.ValidatorMetadata {
+		credentials := metadata.WithdrawalCredentials
+		// only execution layer withdrawal credentials (0x01, 0x02) point to an address
+		if len(credentials) == 32 && (credentials[0] == 0x01 || credentials[0] == 0x02) && bytes.Equal(credentials[12:], addressBytes) {
+			validatorSet[t.VDBValidator(validator)] = true
+		}
+	}
+
+	validators := make([]t.VDBValidator, 0, len(validatorSet))
+	for validator := range validatorSet {
+		validators = append(validators, validator)
+	}
+	return d.getNetworkValidatorsPage(validators, cursor, colSort, "", limit)
+}
+
+func (d *DataAccessService) GetNetworkWithdrawalCredentialValidators(ctx context.Context, chainId uint64, credential string, cursor string, colSort t.Sort[enums.NetworkValidatorsColumn], limit uint64) ([]t.NetworkValidator, *t.Paging, error) {
+	credentialBytes, err := hexutil.Decode(credential)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode withdrawal credential %s: %w", credential, err)
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validators := []t.VDBValidator{}
+	for validator, metadata := range validatorMapping.ValidatorMetadata {
+		if bytes.Equal(metadata.WithdrawalCredentials, credentialBytes) {
+			validators = append(validators, t.VDBValidator(validator))
+		}
+	}
+	return d.getNetworkValidatorsPage(validators, cursor, colSort, "", limit)
+}
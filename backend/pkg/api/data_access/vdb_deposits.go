@@ -150,6 +150,19 @@ func (d *DataAccessService) GetValidatorDashboardElDeposits(ctx context.Context,
 			responseData[i].Index = &v
 		}
 	}
+
+	ensMapping := make(map[string]string)
+	for _, row := range responseData {
+		ensMapping[string(row.From.Hash)] = ""
+		ensMapping[string(row.Depositor.Hash)] = ""
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range responseData {
+		responseData[i].From.Ens = ensMapping[string(responseData[i].From.Hash)]
+		responseData[i].Depositor.Ens = ensMapping[string(responseData[i].Depositor.Hash)]
+	}
 	var paging t.Paging
 
 	moreDataFlag := len(responseData) > int(limit)
@@ -2,18 +2,202 @@ package dataaccess
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
 
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/pkg/errors"
 )
 
-// retrieve data for last hour
 func (d *DataAccessService) GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error) {
-	// WORKING Rami
-	return d.dummy.GetValidatorDashboardHeatmap(ctx, dashboardId, protocolModes, aggregation, afterTs, beforeTs)
+	dataTable, dateColumn, err := getChartTableAndDateColumn(aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	type heatmapRow struct {
+		t.VDBValidatorSummaryChartRow
+		Slashed           bool   `db:"slashed"`
+		SlashingsIncluded uint64 `db:"slashings_included"`
+	}
+	var queryResults []heatmapRow
+
+	selectColumns := `
+		COALESCE(SUM(d.attestations_reward), 0) AS attestation_reward,
+		COALESCE(SUM(d.attestations_ideal_reward), 0) AS attestations_ideal_reward,
+		COALESCE(SUM(d.blocks_proposed), 0) AS blocks_proposed,
+		COALESCE(SUM(d.blocks_scheduled), 0) AS blocks_scheduled,
+		COALESCE(SUM(d.sync_executed), 0) AS sync_executed,
+		COALESCE(SUM(d.sync_scheduled), 0) AS sync_scheduled,
+		MAX(d.slashed) AS slashed,
+		COALESCE(SUM(d.blocks_slashing_count), 0) AS slashings_included`
+
+	if dashboardId.Validators != nil {
+		query := fmt.Sprintf(`
+			SELECT
+				%[2]s AS ts,
+				0 AS group_id,
+				%[3]s
+			FROM %[1]s d
+			WHERE %[2]s >= fromUnixTimestamp($1) AND %[2]s <= fromUnixTimestamp($2) AND validator_index IN ($3)
+			GROUP BY %[2]s;
+		`, dataTable, dateColumn, selectColumns)
+		err = d.clickhouseReader.SelectContext(ctx, &queryResults, query, afterTs, beforeTs, dashboardId.Validators)
+	} else {
+		query := fmt.Sprintf(`
+			WITH validators AS (
+				SELECT validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = $3
+			)
+			SELECT
+				%[2]s AS ts,
+				v.group_id,
+				%[3]s
+			FROM %[1]s d
+			INNER JOIN validators v ON d.validator_index = v.validator_index
+			WHERE %[2]s >= fromUnixTimestamp($1) AND %[2]s <= fromUnixTimestamp($2) AND validator_index IN (SELECT validator_index FROM validators)
+			GROUP BY 1, 2;
+		`, dataTable, dateColumn, selectColumns)
+		err = d.clickhouseReader.SelectContext(ctx, &queryResults, query, afterTs, beforeTs, dashboardId.Id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving data from table %s: %v", dataTable, err)
+	}
+
+	ret := &t.VDBHeatmap{
+		Timestamps:  []int64{},
+		GroupIds:    []uint64{},
+		Data:        make([]t.VDBHeatmapCell, 0, len(queryResults)),
+		Aggregation: aggregation.ToString(),
+	}
+	tsMap := make(map[int64]bool)
+	groupMap := make(map[uint64]bool)
+	for _, row := range queryResults {
+		efficiency, err := d.calculateChartEfficiency(enums.VDBSummaryChartAll, &row.VDBValidatorSummaryChartRow)
+		if err != nil {
+			return nil, err
+		}
+		cell := t.VDBHeatmapCell{
+			X:     row.Timestamp.Unix(),
+			Y:     uint64(row.GroupId),
+			Value: efficiency,
+		}
+		events := t.VDBHeatmapEvents{
+			Proposal: row.BlocksScheduled > 0,
+			Slash:    row.Slashed || row.SlashingsIncluded > 0,
+			Sync:     row.SyncScheduled > 0,
+		}
+		if events.Proposal || events.Slash || events.Sync {
+			cell.Events = &events
+		}
+		ret.Data = append(ret.Data, cell)
+
+		if !tsMap[cell.X] {
+			tsMap[cell.X] = true
+			ret.Timestamps = append(ret.Timestamps, cell.X)
+		}
+		if !groupMap[cell.Y] {
+			groupMap[cell.Y] = true
+			ret.GroupIds = append(ret.GroupIds, cell.Y)
+		}
+	}
+	slices.Sort(ret.Timestamps)
+	slices.Sort(ret.GroupIds)
+
+	return ret, nil
 }
 
 func (d *DataAccessService) GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error) {
-	// WORKING Rami
-	return d.dummy.GetValidatorDashboardGroupHeatmap(ctx, dashboardId, groupId, protocolModes, aggregation, timestamp)
+	dataTable, dateColumn, err := getChartTableAndDateColumn(aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	var row struct {
+		AttestationReward         int64  `db:"attestations_reward"`
+		AttestationIdealReward    int64  `db:"attestations_ideal_reward"`
+		AttestationsScheduled     uint64 `db:"attestations_scheduled"`
+		AttestationHeadExecuted   uint64 `db:"attestation_head_executed"`
+		AttestationSourceExecuted uint64 `db:"attestation_source_executed"`
+		AttestationTargetExecuted uint64 `db:"attestation_target_executed"`
+		BlocksScheduled           uint64 `db:"blocks_scheduled"`
+		BlocksProposed            uint64 `db:"blocks_proposed"`
+		SyncExecuted              uint64 `db:"sync_executed"`
+		SlashedValidators         uint64 `db:"slashed_validators"`
+		SlashingsIncluded         uint64 `db:"slashings_included"`
+	}
+	selectColumns := `
+		COALESCE(SUM(d.attestations_reward), 0) AS attestations_reward,
+		COALESCE(SUM(d.attestations_ideal_reward), 0) AS attestations_ideal_reward,
+		COALESCE(SUM(d.attestations_scheduled), 0) AS attestations_scheduled,
+		COALESCE(SUM(d.attestation_head_executed), 0) AS attestation_head_executed,
+		COALESCE(SUM(d.attestation_source_executed), 0) AS attestation_source_executed,
+		COALESCE(SUM(d.attestation_target_executed), 0) AS attestation_target_executed,
+		COALESCE(SUM(d.blocks_scheduled), 0) AS blocks_scheduled,
+		COALESCE(SUM(d.blocks_proposed), 0) AS blocks_proposed,
+		COALESCE(SUM(d.sync_executed), 0) AS sync_executed,
+		countIf(d.slashed) AS slashed_validators,
+		COALESCE(SUM(d.blocks_slashing_count), 0) AS slashings_included`
+
+	if dashboardId.Validators != nil {
+		if groupId != t.DefaultGroupId {
+			return nil, fmt.Errorf("%w: group %d not found", ErrNotFound, groupId)
+		}
+		query := fmt.Sprintf(`
+			SELECT
+				%[3]s
+			FROM %[1]s d
+			WHERE %[2]s = fromUnixTimestamp($1) AND validator_index IN ($2);
+		`, dataTable, dateColumn, selectColumns)
+		err = d.clickhouseReader.GetContext(ctx, &row, query, timestamp, dashboardId.Validators)
+	} else {
+		query := fmt.Sprintf(`
+			WITH validators AS (
+				SELECT validator_index FROM users_val_dashboards_validators WHERE dashboard_id = $2 AND group_id = $3
+			)
+			SELECT
+				%[3]s
+			FROM %[1]s d
+			WHERE %[2]s = fromUnixTimestamp($1) AND validator_index IN (SELECT validator_index FROM validators);
+		`, dataTable, dateColumn, selectColumns)
+		err = d.clickhouseReader.GetContext(ctx, &row, query, timestamp, dashboardId.Id, groupId)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("error retrieving data from table %s: %v", dataTable, err)
+	}
+
+	ret := &t.VDBHeatmapTooltipData{
+		Timestamp: int64(timestamp),
+		Proposers: t.StatusCount{
+			Success: row.BlocksProposed,
+			Failed:  row.BlocksScheduled - row.BlocksProposed,
+		},
+		Syncs: row.SyncExecuted,
+		// success: slashings included by the group's proposals, failed: group validators that got slashed
+		Slashings: t.StatusCount{
+			Success: row.SlashingsIncluded,
+			Failed:  row.SlashedValidators,
+		},
+		AttestationsHead: t.StatusCount{
+			Success: row.AttestationHeadExecuted,
+			Failed:  row.AttestationsScheduled - row.AttestationHeadExecuted,
+		},
+		AttestationsSource: t.StatusCount{
+			Success: row.AttestationSourceExecuted,
+			Failed:  row.AttestationsScheduled - row.AttestationSourceExecuted,
+		},
+		AttestationsTarget: t.StatusCount{
+			Success: row.AttestationTargetExecuted,
+			Failed:  row.AttestationsScheduled - row.AttestationTargetExecuted,
+		},
+		AttestationIncome: utils.GWeiToWei(big.NewInt(row.AttestationReward)),
+	}
+	if row.AttestationIdealReward > 0 {
+		ret.AttestationEfficiency = float64(row.AttestationReward) / float64(row.AttestationIdealReward) * 100
+	}
+
+	return ret, nil
 }
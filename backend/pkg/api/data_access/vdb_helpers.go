@@ -41,6 +41,24 @@ func (d DataAccessService) getDashboardValidators(ctx context.Context, dashboard
 	return dashboardId.Validators, nil
 }
 
+// returns the clickhouse table holding the validator dashboard data for the aggregation and the name of its timestamp column
+func getChartTableAndDateColumn(aggregation enums.ChartAggregation) (table string, dateColumn string, err error) {
+	switch aggregation {
+	case enums.IntervalEpoch:
+		return "validator_dashboard_data_epoch", "epoch_timestamp", nil
+	case enums.IntervalHourly:
+		return "validator_dashboard_data_hourly", "hour", nil
+	case enums.IntervalDaily:
+		return "validator_dashboard_data_daily", "day", nil
+	case enums.IntervalWeekly:
+		return "validator_dashboard_data_weekly", "week", nil
+	case enums.IntervalMonthly:
+		return "validator_dashboard_data_monthly", "month", nil
+	default:
+		return "", "", fmt.Errorf("unexpected aggregation type: %v", aggregation)
+	}
+}
+
 func (d DataAccessService) calculateTotalEfficiency(attestationEff, proposalEff, syncEff sql.NullFloat64) float64 {
 	efficiency := float64(0)
 
@@ -152,3 +170,57 @@ func (d *DataAccessService) getTimeToNextWithdrawal(distance uint64) time.Time {
 
 	return timeToWithdrawal
 }
+
+// getSortedDataPage returns the page after (or before, if reversed) the cursor of the sorted data
+func getSortedDataPage[T any, C t.CursorLike](data []T, currentCursor C, isCursor func(T) bool, limit uint64) ([]T, *t.Paging, error) {
+	var cursorIndex uint64
+	if currentCursor.IsValid() {
+		for idx, row := range data {
+			if isCursor(row) {
+				cursorIndex = uint64(idx)
+				break
+			}
+		}
+	}
+
+	var result []T
+	if currentCursor.IsReverse() {
+		var limitCutoff uint64
+		if cursorIndex > limit+1 {
+			limitCutoff = cursorIndex - limit - 1
+		}
+		result = data[limitCutoff:cursorIndex]
+	} else {
+		if currentCursor.IsValid() {
+			cursorIndex++
+		}
+		cursorIndex = min(cursorIndex, uint64(len(data)))
+		limitCutoff := min(cursorIndex+limit+1, uint64(len(data)))
+		result = data[cursorIndex:limitCutoff]
+	}
+
+	// flag if above limit
+	moreDataFlag := len(result) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return result, &t.Paging{}, nil
+	}
+
+	// remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		if currentCursor.IsReverse() {
+			result = result[1:]
+		} else {
+			result = result[:len(result)-1]
+		}
+	}
+	if len(result) == 0 {
+		return result, &t.Paging{}, nil
+	}
+
+	p, err := utils.GetPagingFromData(result, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
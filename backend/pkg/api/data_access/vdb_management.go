@@ -15,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
@@ -670,6 +671,10 @@ func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context,
 			WithdrawalCredential: t.Hash(hexutil.Encode(metadata.WithdrawalCredentials)),
 		}
 
+		if address, err := utils.GetAddressOfWithdrawalCredentials(metadata.WithdrawalCredentials); err == nil {
+			row.WithdrawalAddress = &t.Address{Hash: t.Hash(hexutil.Encode(address.Bytes()))}
+		}
+
 		if constypes.ValidatorDbStatus(metadata.Status) == constypes.DbPending && metadata.Queues.ActivationIndex.Valid {
 			activationIndex := uint64(metadata.Queues.ActivationIndex.Int64)
 			row.QueuePosition = &activationIndex
@@ -751,6 +756,22 @@ func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context,
 		result = data[cursorIndex:limitCutoff]
 	}
 
+	// only look up the ens names of the returned page
+	ensMapping := make(map[string]string)
+	for _, row := range result {
+		if row.WithdrawalAddress != nil {
+			ensMapping[string(row.WithdrawalAddress.Hash)] = ""
+		}
+	}
+	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		if result[i].WithdrawalAddress != nil {
+			result[i].WithdrawalAddress.Ens = ensMapping[string(result[i].WithdrawalAddress.Hash)]
+		}
+	}
+
 	// flag if above limit
 	moreDataFlag := len(result) > int(limit)
 	if !moreDataFlag && !currentCursor.IsValid() {
@@ -1,28 +1,522 @@
 package dataaccess
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
 )
 
+// minipool as exported by the rocketpool exporter, joined with the dashboard validator it belongs to
+type rpDashboardMinipool struct {
+	ValidatorIndex     t.VDBValidator
+	GroupId            uint64
+	Pubkey             []byte              `db:"pubkey"`
+	NodeAddress        []byte              `db:"node_address"`
+	NodeFee            float64             `db:"node_fee"`
+	Status             string              `db:"status"`
+	StatusTime         sql.NullTime        `db:"status_time"`
+	PenaltyCount       uint64              `db:"penalty_count"`
+	NodeDepositBalance decimal.NullDecimal `db:"node_deposit_balance"`
+	NodeRefundBalance  decimal.NullDecimal `db:"node_refund_balance"`
+	UserDepositBalance decimal.NullDecimal `db:"user_deposit_balance"`
+}
+
+type rpNode struct {
+	Address                []byte              `db:"address"`
+	TimezoneLocation       string              `db:"timezone_location"`
+	RplStake               decimal.Decimal     `db:"rpl_stake"`
+	MinRplStake            decimal.Decimal     `db:"min_rpl_stake"`
+	MaxRplStake            decimal.Decimal     `db:"max_rpl_stake"`
+	RplCumulativeRewards   decimal.Decimal     `db:"rpl_cumulative_rewards"`
+	SmoothingPoolOptedIn   bool                `db:"smoothing_pool_opted_in"`
+	ClaimedSmoothingPool   decimal.Decimal     `db:"claimed_smoothing_pool"`
+	UnclaimedSmoothingPool decimal.Decimal     `db:"unclaimed_smoothing_pool"`
+	UnclaimedRplRewards    decimal.Decimal     `db:"unclaimed_rpl_rewards"`
+	EffectiveRplStake      decimal.Decimal     `db:"effective_rpl_stake"`
+	DepositCredit          decimal.NullDecimal `db:"deposit_credit"`
+}
+
+type rpNetworkStats struct {
+	Ts                     time.Time       `db:"ts"`
+	RplPrice               decimal.Decimal `db:"rpl_price"`
+	ClaimIntervalSeconds   float64         `db:"claim_interval_seconds"`
+	ClaimIntervalTimeStart time.Time       `db:"claim_interval_time_start"`
+	EffectiveRplStaked     decimal.Decimal `db:"effective_rpl_staked"`
+	NodeOperatorRewards    decimal.Decimal `db:"node_operator_rewards"`
+	RethExchangeRate       float64         `db:"reth_exchange_rate"`
+	CurrentNodeFee         float64         `db:"current_node_fee"`
+	CurrentNodeDemand      decimal.Decimal `db:"current_node_demand"`
+	RethSupply             decimal.Decimal `db:"reth_supply"`
+	NodeCount              uint64          `db:"node_count"`
+	MinipoolCount          uint64          `db:"minipool_count"`
+	OdaoMemberCount        uint64          `db:"odao_member_count"`
+	TotalEthStaking        decimal.Decimal `db:"total_eth_staking"`
+	TotalEthBalance        decimal.Decimal `db:"total_eth_balance"`
+}
+
+var rpLeb8Deposit = decimal.New(8, 18)
+var rpLeb16Deposit = decimal.New(16, 18)
+
+// getRocketPoolDashboardMinipools returns the minipools of all dashboard validators, optionally limited to the given node
+func (d *DataAccessService) getRocketPoolDashboardMinipools(ctx context.Context, dashboardId t.VDBId, node []byte) ([]rpDashboardMinipool, error) {
+	type dashboardValidator struct {
+		ValidatorIndex t.VDBValidator `db:"validator_index"`
+		GroupId        uint64         `db:"group_id"`
+	}
+	var validators []dashboardValidator
+	if dashboardId.Validators != nil {
+		for _, validator := range dashboardId.Validators {
+			validators = append(validators, dashboardValidator{ValidatorIndex: validator, GroupId: t.DefaultGroupId})
+		}
+	} else {
+		err := d.alloyReader.SelectContext(ctx, &validators, `
+			SELECT validator_index, group_id
+			FROM users_val_dashboards_validators
+			WHERE dashboard_id = $1`, dashboardId.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving dashboard validators: %w", err)
+		}
+	}
+	if len(validators) == 0 {
+		return nil, nil
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, err
+	}
+	pubKeyList := make([][]byte, 0, len(validators))
+	validatorsByPubkey := make(map[string]dashboardValidator, len(validators))
+	for _, validator := range validators {
+		if validator.ValidatorIndex >= t.VDBValidator(len(validatorMapping.ValidatorMetadata)) {
+			continue
+		}
+		pubkey := validatorMapping.ValidatorMetadata[validator.ValidatorIndex].PublicKey
+		pubKeyList = append(pubKeyList, pubkey)
+		validatorsByPubkey[string(pubkey)] = validator
+	}
+
+	var minipools []rpDashboardMinipool
+	err = d.alloyReader.SelectContext(ctx, &minipools, `
+		SELECT
+			pubkey,
+			node_address,
+			node_fee,
+			status,
+			status_time,
+			penalty_count,
+			node_deposit_balance,
+			node_refund_balance,
+			user_deposit_balance
+		FROM rocketpool_minipools
+		WHERE pubkey = ANY($1) AND ($2::bytea IS NULL OR node_address = $2)`, pubKeyList, node)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool minipools: %w", err)
+	}
+	for i := range minipools {
+		validator := validatorsByPubkey[string(minipools[i].Pubkey)]
+		minipools[i].ValidatorIndex = validator.ValidatorIndex
+		minipools[i].GroupId = validator.GroupId
+	}
+	return minipools, nil
+}
+
+func (d *DataAccessService) getRocketPoolNodes(ctx context.Context, nodes [][]byte) (map[string]rpNode, error) {
+	var rows []rpNode
+	err := d.alloyReader.SelectContext(ctx, &rows, `
+		SELECT
+			address,
+			timezone_location,
+			rpl_stake,
+			min_rpl_stake,
+			max_rpl_stake,
+			rpl_cumulative_rewards,
+			smoothing_pool_opted_in,
+			claimed_smoothing_pool,
+			unclaimed_smoothing_pool,
+			unclaimed_rpl_rewards,
+			effective_rpl_stake,
+			deposit_credit
+		FROM rocketpool_nodes
+		WHERE address = ANY($1)`, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool nodes: %w", err)
+	}
+	result := make(map[string]rpNode, len(rows))
+	for _, row := range rows {
+		result[string(row.Address)] = row
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) getRocketPoolNetworkStats(ctx context.Context) (*rpNetworkStats, error) {
+	var stats rpNetworkStats
+	err := d.alloyReader.GetContext(ctx, &stats, `
+		SELECT
+			ts,
+			rpl_price,
+			EXTRACT(EPOCH FROM claim_interval_time) AS claim_interval_seconds,
+			claim_interval_time_start,
+			effective_rpl_staked,
+			node_operator_rewards,
+			reth_exchange_rate,
+			current_node_fee,
+			current_node_demand,
+			reth_supply,
+			node_count,
+			minipool_count,
+			odao_member_count,
+			total_eth_staking,
+			total_eth_balance
+		FROM rocketpool_network_stats
+		ORDER BY id DESC
+		LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no rocketpool network stats exported yet", ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool network stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// rpl rewards of the current interval in percent per year, relative to the effective rpl staked in the network
+func (stats rpNetworkStats) rplApr() float64 {
+	if stats.EffectiveRplStaked.IsZero() || stats.ClaimIntervalSeconds <= 0 {
+		return 0
+	}
+	intervalsPerYear := (365 * 24 * time.Hour).Seconds() / stats.ClaimIntervalSeconds
+	apr, _ := stats.NodeOperatorRewards.Div(stats.EffectiveRplStaked).Mul(decimal.NewFromFloat(intervalsPerYear * 100)).Float64()
+	return apr
+}
+
+// rpl rewards the node can expect at the end of the current interval
+func (stats rpNetworkStats) rplEstimate(effectiveRplStake decimal.Decimal) decimal.Decimal {
+	if stats.EffectiveRplStaked.IsZero() {
+		return decimal.Zero
+	}
+	return stats.NodeOperatorRewards.Mul(effectiveRplStake).Div(stats.EffectiveRplStaked)
+}
+
+// getRocketPoolTableRows aggregates the dashboard minipools by node, nodes that don't match the search are dropped.
+// Also returns the total eth borrowed by the minipools of the returned nodes.
+func (d *DataAccessService) getRocketPoolTableRows(ctx context.Context, dashboardId t.VDBId, search string) ([]t.VDBRocketPoolTableRow, decimal.Decimal, *rpNetworkStats, error) {
+	totalBorrowedEth := decimal.Zero
+	minipools, err := d.getRocketPoolDashboardMinipools(ctx, dashboardId, nil)
+	if err != nil {
+		return nil, totalBorrowedEth, nil, err
+	}
+	if len(minipools) == 0 {
+		return nil, totalBorrowedEth, nil, nil
+	}
+
+	search = strings.ToLower(strings.TrimPrefix(search, "0x"))
+	minipoolsByNode := make(map[string][]rpDashboardMinipool)
+	var nodeList [][]byte
+	for _, minipool := range minipools {
+		if search != "" && !strings.HasPrefix(hexutil.Encode(minipool.NodeAddress)[2:], search) {
+			continue
+		}
+		if _, ok := minipoolsByNode[string(minipool.NodeAddress)]; !ok {
+			nodeList = append(nodeList, minipool.NodeAddress)
+		}
+		minipoolsByNode[string(minipool.NodeAddress)] = append(minipoolsByNode[string(minipool.NodeAddress)], minipool)
+	}
+	if len(nodeList) == 0 {
+		return nil, totalBorrowedEth, nil, nil
+	}
+
+	var nodes map[string]rpNode
+	var stats *rpNetworkStats
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		nodes, err = d.getRocketPoolNodes(ctx, nodeList)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		stats, err = d.getRocketPoolNetworkStats(ctx)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, totalBorrowedEth, nil, err
+	}
+
+	result := make([]t.VDBRocketPoolTableRow, 0, len(nodeList))
+	for _, nodeAddress := range nodeList {
+		node, ok := nodes[string(nodeAddress)]
+		if !ok {
+			// minipool exported before its node, skip until the next export
+			continue
+		}
+		row := t.VDBRocketPoolTableRow{
+			Node:           t.Address{Hash: t.Hash(hexutil.Encode(nodeAddress))},
+			EffectiveRpl:   node.EffectiveRplStake,
+			RplApr:         stats.rplApr(),
+			RplAprUpdateTs: stats.Ts.Unix(),
+			RplEstimate:    stats.rplEstimate(node.EffectiveRplStake),
+		}
+		row.Staked.Rpl = node.RplStake
+		row.Rpl.Claimed = node.RplCumulativeRewards
+		row.Rpl.Unclaimed = node.UnclaimedRplRewards
+		row.SmoothingPool.IsOptIn = node.SmoothingPoolOptedIn
+		row.SmoothingPool.Claimed = node.ClaimedSmoothingPool
+		row.SmoothingPool.Unclaimed = node.UnclaimedSmoothingPool
+
+		borrowedEth := decimal.Zero
+		commissionSum := float64(0)
+		for _, minipool := range minipoolsByNode[string(nodeAddress)] {
+			row.Staked.Eth = row.Staked.Eth.Add(minipool.NodeDepositBalance.Decimal)
+			borrowedEth = borrowedEth.Add(minipool.UserDepositBalance.Decimal)
+			commissionSum += minipool.NodeFee
+			row.Minipools.Total++
+			switch {
+			case minipool.NodeDepositBalance.Decimal.Equal(rpLeb8Deposit):
+				row.Minipools.Leb8++
+			case minipool.NodeDepositBalance.Decimal.Equal(rpLeb16Deposit):
+				row.Minipools.Leb16++
+			}
+		}
+		row.AvgCommission = commissionSum / float64(row.Minipools.Total) * 100
+		row.Collateral = getRocketPoolCollateral(node, borrowedEth, stats.RplPrice)
+		totalBorrowedEth = totalBorrowedEth.Add(borrowedEth)
+		result = append(result, row)
+	}
+	return result, totalBorrowedEth, stats, nil
+}
+
+func getRocketPoolCollateral(node rpNode, borrowedEth decimal.Decimal, rplPrice decimal.Decimal) t.PercentageDetails[decimal.Decimal] {
+	return t.PercentageDetails[decimal.Decimal]{
+		Percentage: getRocketPoolCollateralPercentage(node.RplStake, borrowedEth, rplPrice),
+		MinValue:   node.MinRplStake,
+		MaxValue:   node.MaxRplStake,
+	}
+}
+
+// collateral is the value of the staked rpl relative to the eth borrowed from the protocol
+func getRocketPoolCollateralPercentage(rplStake, borrowedEth, rplPrice decimal.Decimal) float64 {
+	if !borrowedEth.IsPositive() {
+		return 0
+	}
+	// rpl price is denominated in wei per rpl
+	percentage, _ := rplStake.Mul(rplPrice).Div(decimal.NewFromInt(1e18)).Div(borrowedEth).Mul(decimal.NewFromInt(100)).Float64()
+	return percentage
+}
+
 func (d *DataAccessService) GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardRocketPool(ctx, dashboardId, cursor, colSort, search, limit)
+	var currentCursor t.RocketPoolCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.RocketPoolCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolCursor: %w", err)
+		}
+	}
+
+	data, _, _, err := d.getRocketPoolTableRows(ctx, dashboardId, search)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 {
+		return []t.VDBRocketPoolTableRow{}, &t.Paging{}, nil
+	}
+
+	// Sort the nodes, using the address as tiebreaker to keep the order stable for the cursor
+	slices.SortFunc(data, func(a, b t.VDBRocketPoolTableRow) int {
+		c := 0
+		switch colSort.Column {
+		case enums.VDBRocketPoolMinipools:
+			c = int(a.Minipools.Total) - int(b.Minipools.Total)
+		case enums.VDBRocketPoolCollateral:
+			c = cmp.Compare(a.Collateral.Percentage, b.Collateral.Percentage)
+		case enums.VDBRocketPoolRpl:
+			c = a.Rpl.Claimed.Add(a.Rpl.Unclaimed).Cmp(b.Rpl.Claimed.Add(b.Rpl.Unclaimed))
+		case enums.VDBRocketPoolEffectiveRpl:
+			c = a.EffectiveRpl.Cmp(b.EffectiveRpl)
+		case enums.VDBRocketPoolRplApr:
+			c = cmp.Compare(a.RplApr, b.RplApr)
+		case enums.VDBRocketPoolSmoothingPool:
+			c = a.SmoothingPool.Claimed.Add(a.SmoothingPool.Unclaimed).Cmp(b.SmoothingPool.Claimed.Add(b.SmoothingPool.Unclaimed))
+		}
+		if c == 0 {
+			c = strings.Compare(string(a.Node.Hash), string(b.Node.Hash))
+		}
+		if colSort.Desc {
+			return -c
+		}
+		return c
+	})
+
+	return getSortedDataPage(data, currentCursor, func(row t.VDBRocketPoolTableRow) bool {
+		return row.Node.Hash == currentCursor.Node.Hash
+	}, limit)
 }
 
 func (d *DataAccessService) GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, search string) (*t.VDBRocketPoolTableRow, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardTotalRocketPool(ctx, dashboardId, search)
+	data, borrowedEth, stats, err := d.getRocketPoolTableRows(ctx, dashboardId, search)
+	if err != nil {
+		return nil, err
+	}
+	ret := &t.VDBRocketPoolTableRow{}
+	if len(data) == 0 {
+		return ret, nil
+	}
+
+	for _, row := range data {
+		ret.Staked.Eth = ret.Staked.Eth.Add(row.Staked.Eth)
+		ret.Staked.Rpl = ret.Staked.Rpl.Add(row.Staked.Rpl)
+		ret.Minipools.Total += row.Minipools.Total
+		ret.Minipools.Leb8 += row.Minipools.Leb8
+		ret.Minipools.Leb16 += row.Minipools.Leb16
+		ret.Collateral.MinValue = ret.Collateral.MinValue.Add(row.Collateral.MinValue)
+		ret.Collateral.MaxValue = ret.Collateral.MaxValue.Add(row.Collateral.MaxValue)
+		ret.AvgCommission += row.AvgCommission * float64(row.Minipools.Total)
+		ret.Rpl.Claimed = ret.Rpl.Claimed.Add(row.Rpl.Claimed)
+		ret.Rpl.Unclaimed = ret.Rpl.Unclaimed.Add(row.Rpl.Unclaimed)
+		ret.EffectiveRpl = ret.EffectiveRpl.Add(row.EffectiveRpl)
+		ret.RplEstimate = ret.RplEstimate.Add(row.RplEstimate)
+		ret.SmoothingPool.Claimed = ret.SmoothingPool.Claimed.Add(row.SmoothingPool.Claimed)
+		ret.SmoothingPool.Unclaimed = ret.SmoothingPool.Unclaimed.Add(row.SmoothingPool.Unclaimed)
+		ret.SmoothingPool.IsOptIn = ret.SmoothingPool.IsOptIn || row.SmoothingPool.IsOptIn
+	}
+	if ret.Minipools.Total > 0 {
+		ret.AvgCommission /= float64(ret.Minipools.Total)
+	}
+	ret.Collateral.Percentage = getRocketPoolCollateralPercentage(ret.Staked.Rpl, borrowedEth, stats.RplPrice)
+	ret.RplApr = stats.rplApr()
+	ret.RplAprUpdateTs = stats.Ts.Unix()
+
+	return ret, nil
 }
 
 func (d *DataAccessService) GetValidatorDashboardNodeRocketPool(ctx context.Context, dashboardId t.VDBId, node string) (*t.VDBNodeRocketPoolData, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardNodeRocketPool(ctx, dashboardId, node)
+	nodeAddress := common.HexToAddress(node).Bytes()
+	minipools, err := d.getRocketPoolDashboardMinipools(ctx, dashboardId, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(minipools) == 0 {
+		return nil, fmt.Errorf("%w: node %s not found on dashboard", ErrNotFound, node)
+	}
+
+	var nodes map[string]rpNode
+	var refundBalance decimal.NullDecimal
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		nodes, err = d.getRocketPoolNodes(ctx, [][]byte{nodeAddress})
+		return err
+	})
+	wg.Go(func() error {
+		// the refund balance belongs to the node, so all of its minipools are considered
+		err := d.alloyReader.GetContext(ctx, &refundBalance, `
+			SELECT SUM(node_refund_balance)
+			FROM rocketpool_minipools
+			WHERE node_address = $1`, nodeAddress)
+		if err != nil {
+			return fmt.Errorf("error retrieving rocketpool node refund balance: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	nodeData, ok := nodes[string(nodeAddress)]
+	if !ok {
+		return nil, fmt.Errorf("%w: rocketpool node %s not found", ErrNotFound, node)
+	}
+
+	ret := &t.VDBNodeRocketPoolData{
+		Timezone:      nodeData.TimezoneLocation,
+		RefundBalance: refundBalance.Decimal,
+		DepositCredit: nodeData.DepositCredit.Decimal,
+	}
+	ret.RplStake.Min = nodeData.MinRplStake
+	ret.RplStake.Max = nodeData.MaxRplStake
+	return ret, nil
 }
 
 func (d *DataAccessService) GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, node, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardRocketPoolMinipools(ctx, dashboardId, node, cursor, colSort, search, limit)
+	var currentCursor t.RocketPoolMinipoolsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.RocketPoolMinipoolsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolMinipoolsCursor: %w", err)
+		}
+	}
+
+	minipools, err := d.getRocketPoolDashboardMinipools(ctx, dashboardId, common.HexToAddress(node).Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchIndex, searchIndexErr := strconv.ParseUint(search, 10, 64)
+	searchPubkey := strings.ToLower(strings.TrimPrefix(search, "0x"))
+	data := make([]t.VDBRocketPoolMinipoolsTableRow, 0, len(minipools))
+	for _, minipool := range minipools {
+		if search != "" {
+			indexSearch := searchIndexErr == nil && searchIndex == minipool.ValidatorIndex
+			pubkeySearch := searchPubkey == hexutil.Encode(minipool.Pubkey)[2:]
+			if !indexSearch && !pubkeySearch {
+				continue
+			}
+		}
+		row := t.VDBRocketPoolMinipoolsTableRow{
+			Node:            t.Address{Hash: t.Hash(hexutil.Encode(minipool.NodeAddress))},
+			ValidatorIndex:  minipool.ValidatorIndex,
+			MinipoolStatus:  strings.ToLower(minipool.Status),
+			ValidatorStatus: validatorMapping.ValidatorMetadata[minipool.ValidatorIndex].Status,
+			GroupId:         minipool.GroupId,
+			Deposit:         minipool.NodeDepositBalance.Decimal,
+			Commission:      minipool.NodeFee * 100,
+			Penalties:       minipool.PenaltyCount,
+		}
+		// the exporter only keeps the time of the latest status change, which is the creation time for minipools that didn't change yet
+		if minipool.StatusTime.Valid {
+			row.CreatedTimestamp = minipool.StatusTime.Time.Unix()
+		}
+		data = append(data, row)
+	}
+	if len(data) == 0 {
+		return []t.VDBRocketPoolMinipoolsTableRow{}, &t.Paging{}, nil
+	}
+
+	// Sort the minipools, using the validator index as tiebreaker to keep the order stable for the cursor
+	slices.SortFunc(data, func(a, b t.VDBRocketPoolMinipoolsTableRow) int {
+		c := 0
+		if colSort.Column == enums.VDBRocketPoolMinipoolsGroup {
+			c = int(a.GroupId) - int(b.GroupId)
+		}
+		if c == 0 {
+			c = int(a.ValidatorIndex) - int(b.ValidatorIndex)
+		}
+		if colSort.Desc {
+			return -c
+		}
+		return c
+	})
+
+	return getSortedDataPage(data, currentCursor, func(row t.VDBRocketPoolMinipoolsTableRow) bool {
+		return row.ValidatorIndex == currentCursor.ValidatorIndex
+	}, limit)
 }
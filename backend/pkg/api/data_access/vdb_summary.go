@@ -900,23 +900,9 @@ func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Contex
 	}
 
 	// log.Infof("retrieving data between %v and %v for aggregation %v", time.Unix(int64(afterTs), 0), time.Unix(int64(beforeTs), 0), aggregation)
-	dataTable := ""
-	dateColumn := ""
-	switch aggregation {
-	case enums.IntervalEpoch:
-		dataTable = "validator_dashboard_data_epoch"
-		dateColumn = "epoch_timestamp"
-	case enums.IntervalHourly:
-		dataTable = "validator_dashboard_data_hourly"
-		dateColumn = "hour"
-	case enums.IntervalDaily:
-		dataTable = "validator_dashboard_data_daily"
-		dateColumn = "day"
-	case enums.IntervalWeekly:
-		dataTable = "validator_dashboard_data_weekly"
-		dateColumn = "week"
-	default:
-		return nil, fmt.Errorf("unexpected aggregation type: %v", aggregation)
+	dataTable, dateColumn, err := getChartTableAndDateColumn(aggregation)
+	if err != nil {
+		return nil, err
 	}
 
 	var queryResults []*t.VDBValidatorSummaryChartRow
@@ -1087,28 +1073,14 @@ func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Contex
 }
 
 func (d *DataAccessService) GetLatestExportedChartTs(ctx context.Context, aggregation enums.ChartAggregation) (uint64, error) {
-	var table string
-	var dateColumn string
-	switch aggregation {
-	case enums.IntervalEpoch:
-		table = "validator_dashboard_data_epoch"
-		dateColumn = "epoch_timestamp"
-	case enums.IntervalHourly:
-		table = "validator_dashboard_data_hourly"
-		dateColumn = "hour"
-	case enums.IntervalDaily:
-		table = "validator_dashboard_data_daily"
-		dateColumn = "day"
-	case enums.IntervalWeekly:
-		table = "validator_dashboard_data_weekly"
-		dateColumn = "week"
-	default:
-		return 0, fmt.Errorf("unexpected aggregation type: %v", aggregation)
+	table, dateColumn, err := getChartTableAndDateColumn(aggregation)
+	if err != nil {
+		return 0, err
 	}
 
 	query := fmt.Sprintf(`SELECT max(%s) FROM %s`, dateColumn, table)
 	var ts time.Time
-	err := d.clickhouseReader.GetContext(ctx, &ts, query)
+	err = d.clickhouseReader.GetContext(ctx, &ts, query)
 	if err != nil {
 		return 0, fmt.Errorf("error retrieving latest exported chart timestamp: %v", err)
 	}
@@ -256,7 +256,11 @@ func (d *DataAccessService) GetValidatorDashboardWithdrawals(ctx context.Context
 		if nextData != nil {
 			// Complete the next data
 			nextData.GroupId = validatorGroupMap[nextData.Index]
-			nextData.Recipient.Ens = addressEns[string(nextData.Recipient.Hash)]
+			nextAddressEns := map[string]string{string(nextData.Recipient.Hash): ""}
+			if err := db.GetEnsNamesForAddresses(nextAddressEns); err != nil {
+				return nil, nil, err
+			}
+			nextData.Recipient.Ens = nextAddressEns[string(nextData.Recipient.Hash)]
 		} else {
 			// If there is no next data, add a missing estimate row
 			nextData = &t.VDBWithdrawalsTableRow{
@@ -398,7 +402,7 @@ func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidato
 		Slot:  nextWithdrawalSlot,
 		Index: *nextValidator,
 		Recipient: t.Address{
-			Hash: t.Hash(address.String()),
+			Hash: t.Hash(hexutil.Encode(address.Bytes())),
 		},
 		Amount: utils.GWeiToWei(big.NewInt(int64(withdrawalAmount))),
 	}
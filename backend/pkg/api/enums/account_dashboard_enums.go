@@ -0,0 +1,36 @@
+package enums
+
+// ----------------
+// Account Dashboard Manage Accounts Table
+
+type ADBManageAccountsColumn int
+
+var _ EnumFactory[ADBManageAccountsColumn] = ADBManageAccountsColumn(0)
+
+const (
+	ADBManageAccountsAddress ADBManageAccountsColumn = iota
+	ADBManageAccountsGroup
+)
+
+func (c ADBManageAccountsColumn) Int() int {
+	return int(c)
+}
+
+func (ADBManageAccountsColumn) NewFromString(s string) ADBManageAccountsColumn {
+	switch s {
+	case "address":
+		return ADBManageAccountsAddress
+	case "group_id":
+		return ADBManageAccountsGroup
+	default:
+		return ADBManageAccountsColumn(-1)
+	}
+}
+
+var ADBManageAccountsColumns = struct {
+	Address ADBManageAccountsColumn
+	Group   ADBManageAccountsColumn
+}{
+	ADBManageAccountsAddress,
+	ADBManageAccountsGroup,
+}
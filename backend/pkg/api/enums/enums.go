@@ -221,6 +221,7 @@ const (
 	IntervalHourly
 	IntervalDaily
 	IntervalWeekly
+	IntervalMonthly
 )
 
 func (c ChartAggregation) Int() int {
@@ -237,21 +238,42 @@ func (ChartAggregation) NewFromString(s string) ChartAggregation {
 		return IntervalDaily
 	case "weekly":
 		return IntervalWeekly
+	case "monthly":
+		return IntervalMonthly
 	default:
 		return ChartAggregation(-1)
 	}
 }
 
+func (c ChartAggregation) ToString() string {
+	switch c {
+	case IntervalEpoch:
+		return "epoch"
+	case IntervalHourly:
+		return "hourly"
+	case IntervalDaily:
+		return "daily"
+	case IntervalWeekly:
+		return "weekly"
+	case IntervalMonthly:
+		return "monthly"
+	default:
+		return ""
+	}
+}
+
 var ChartAggregations = struct {
-	Epoch  ChartAggregation
-	Hourly ChartAggregation
-	Daily  ChartAggregation
-	Weekly ChartAggregation
+	Epoch   ChartAggregation
+	Hourly  ChartAggregation
+	Daily   ChartAggregation
+	Weekly  ChartAggregation
+	Monthly ChartAggregation
 }{
 	IntervalEpoch,
 	IntervalHourly,
 	IntervalDaily,
 	IntervalWeekly,
+	IntervalMonthly,
 }
 
 func (c ChartAggregation) Duration(secondsPerEpoch uint64) time.Duration {
@@ -264,6 +286,8 @@ func (c ChartAggregation) Duration(secondsPerEpoch uint64) time.Duration {
 		return 24 * time.Hour
 	case IntervalWeekly:
 		return 7 * 24 * time.Hour
+	case IntervalMonthly:
+		return 30 * 24 * time.Hour
 	default:
 		return 0
 	}
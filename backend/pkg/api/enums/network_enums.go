@@ -0,0 +1,101 @@
+package enums
+
+// ----------------
+// Network Validators Table
+
+type NetworkValidatorsColumn int
+
+var _ EnumFactory[NetworkValidatorsColumn] = NetworkValidatorsColumn(0)
+
+const (
+	NetworkValidatorsIndex NetworkValidatorsColumn = iota
+	NetworkValidatorsPublicKey
+	NetworkValidatorsBalance
+	NetworkValidatorsStatus
+	NetworkValidatorsWithdrawalCredential
+)
+
+func (c NetworkValidatorsColumn) Int() int {
+	return int(c)
+}
+
+func (NetworkValidatorsColumn) NewFromString(s string) NetworkValidatorsColumn {
+	switch s {
+	case "index":
+		return NetworkValidatorsIndex
+	case "public_key":
+		return NetworkValidatorsPublicKey
+	case "balance":
+		return NetworkValidatorsBalance
+	case "status":
+		return NetworkValidatorsStatus
+	case "withdrawal_credential":
+		return NetworkValidatorsWithdrawalCredential
+	default:
+		return NetworkValidatorsColumn(-1)
+	}
+}
+
+var NetworkValidatorsColumns = struct {
+	Index                NetworkValidatorsColumn
+	PublicKey            NetworkValidatorsColumn
+	Balance              NetworkValidatorsColumn
+	Status               NetworkValidatorsColumn
+	WithdrawalCredential NetworkValidatorsColumn
+}{
+	NetworkValidatorsIndex,
+	NetworkValidatorsPublicKey,
+	NetworkValidatorsBalance,
+	NetworkValidatorsStatus,
+	NetworkValidatorsWithdrawalCredential,
+}
+
+// ----------------
+// Rocket Pool Nodes Table
+
+type RocketPoolNodesColumn int
+
+var _ EnumFactory[RocketPoolNodesColumn] = RocketPoolNodesColumn(0)
+
+const (
+	RocketPoolNodesNode RocketPoolNodesColumn = iota
+	RocketPoolNodesRplStake
+	RocketPoolNodesEffectiveRplStake
+	RocketPoolNodesCollateral
+	RocketPoolNodesMinipools
+)
+
+func (c RocketPoolNodesColumn) Int() int {
+	return int(c)
+}
+
+func (RocketPoolNodesColumn) NewFromString(s string) RocketPoolNodesColumn {
+	switch s {
+	case "node":
+		return RocketPoolNodesNode
+	case "rpl_stake":
+		return RocketPoolNodesRplStake
+	case "effective_rpl_stake":
+		return RocketPoolNodesEffectiveRplStake
+	case "collateral":
+		return RocketPoolNodesCollateral
+	case "minipools":
+		return RocketPoolNodesMinipools
+	default:
+		return RocketPoolNodesColumn(-1)
+	}
+}
+
+var RocketPoolNodesColumns = struct {
+	Node              RocketPoolNodesColumn
+	RplStake          RocketPoolNodesColumn
+	EffectiveRplStake RocketPoolNodesColumn
+	Collateral        RocketPoolNodesColumn
+	Minipools         RocketPoolNodesColumn
+}{
+	RocketPoolNodesNode,
+	RocketPoolNodesRplStake,
+	RocketPoolNodesEffectiveRplStake,
+	RocketPoolNodesCollateral,
+	RocketPoolNodesMinipools,
+}
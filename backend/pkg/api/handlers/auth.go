@@ -2,10 +2,17 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"html"
+	"net"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +21,7 @@ import (
 	"github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/mail"
+	"github.com/gobitfly/beaconchain/pkg/commons/ratelimit"
 	commonTypes "github.com/gobitfly/beaconchain/pkg/commons/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gobitfly/beaconchain/pkg/userservice"
@@ -36,6 +44,39 @@ const authEmailExpireTime = time.Minute * 30
 type ctxKet string
 
 const ctxUserIdKey ctxKet = "user_id"
+const ctxOauthScopesKey ctxKet = "oauth_scopes"
+const ctxOauthScopeCheckedKey ctxKet = "oauth_scope_checked"
+
+const (
+	oauthAccessTokenPrefix      = "bcat_"
+	oauthRefreshTokenPrefix     = "bcrt_"
+	oauthCodeChallengeS256      = "S256"
+	oauthClientIdLength         = 32
+	oauthTokenLength            = 48
+	oauthCodeExpireTime         = time.Minute * 10
+	oauthAccessTokenExpireTime  = time.Hour
+	oauthRefreshTokenExpireTime = time.Hour * 24 * 90
+	maxOauthAppNameLength       = 35
+	maxOauthAppsPerUser         = 10
+)
+
+// scopes that can be granted to third party apps
+const (
+	oauthScopeValidatorDashboardsRead  = "validator-dashboards:read"
+	oauthScopeValidatorDashboardsWrite = "validator-dashboards:write"
+	oauthScopeAccountDashboardsRead    = "account-dashboards:read"
+	oauthScopeAccountDashboardsWrite   = "account-dashboards:write"
+)
+
+var oauthScopes = []string{oauthScopeValidatorDashboardsRead, oauthScopeValidatorDashboardsWrite, oauthScopeAccountDashboardsRead, oauthScopeAccountDashboardsWrite}
+
+const (
+	apiKeyScopeRead        = "read"
+	apiKeyScopeWrite       = "write"
+	maxApiKeyAllowedIps    = 20
+	defaultApiKeyUsageDays = 30
+	maxApiKeyUsageDays     = 90
+)
 
 var errBadCredentials = newUnauthorizedErr("invalid email or password")
 
@@ -190,34 +231,602 @@ func (h *HandlerService) GetUserIdByApiKey(r *http.Request) (uint64, error) {
 	if apiKey == "" {
 		return 0, newUnauthorizedErr("missing api key")
 	}
-	userId, err := h.dai.GetUserIdByApiKey(r.Context(), apiKey)
-	if errors.Is(err, dataaccess.ErrNotFound) {
-		err = newUnauthorizedErr("api key not found")
+	keyInfo, err := h.dai.GetApiKeyInfo(r.Context(), apiKey)
+	if err != nil {
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			err = newUnauthorizedErr("api key not found or expired")
+		}
+		return 0, err
+	}
+	if keyInfo.Scope == apiKeyScopeRead && !isReadRequest(r) {
+		return 0, newForbiddenErr("api key is read-only")
+	}
+	if len(keyInfo.AllowedIps) > 0 && !isIpAllowed(getClientIp(r, utils.Config.TrustedProxies), keyInfo.AllowedIps) {
+		return 0, newForbiddenErr("api key can't be used from this ip address")
 	}
-	return userId, err
+	return keyInfo.UserId, nil
+}
+
+// returns true if the request method doesn't modify any data
+func isReadRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions
+}
+
+// returns the address of the client, forwarding headers are only taken into account if the request was sent by a trusted proxy
+func getClientIp(r *http.Request, trustedProxies []string) string {
+	remoteIp, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIp = r.RemoteAddr
+	}
+	if isIpAllowed(remoteIp, trustedProxies) {
+		return ratelimit.GetIP(r)
+	}
+	return remoteIp
+}
+
+// allowedIps may contain single addresses and CIDR ranges
+func isIpAllowed(ip string, allowedIps []string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, allowed := range allowedIps {
+		if prefix, err := netip.ParsePrefix(allowed); err == nil {
+			if prefix.Contains(addr) {
+				return true
+			}
+		} else if allowedAddr, err := netip.ParseAddr(allowed); err == nil && allowedAddr.Unmap() == addr {
+			return true
+		}
+	}
+	return false
 }
 
 // if this is used, user ID should've been stored in context (by GetUserIdStoreMiddleware)
+// requests authenticated by an oauth access token are denied unless a scope middleware has granted access to the route
 func GetUserIdByContext(r *http.Request) (uint64, error) {
 	userId, ok := r.Context().Value(ctxUserIdKey).(uint64)
 	if !ok {
 		return 0, newUnauthorizedErr("user not authenticated")
 	}
+	if _, isOauth := getOauthScopesByContext(r); isOauth {
+		if checked, _ := r.Context().Value(ctxOauthScopeCheckedKey).(bool); !checked {
+			return 0, newForbiddenErr("access tokens can't be used for this endpoint")
+		}
+	}
 	return userId, nil
 }
 
+// returns the oauth access token of the request, if one was passed as bearer token
+func getOauthAccessToken(r *http.Request) (string, bool) {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, authHeaderPrefix+oauthAccessTokenPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(authHeader, authHeaderPrefix), true
+}
+
+// returns the scopes granted to the oauth access token the request was authenticated with
+// ok is false if the request wasn't authenticated by an oauth access token
+func getOauthScopesByContext(r *http.Request) (scopes []string, ok bool) {
+	scopes, ok = r.Context().Value(ctxOauthScopesKey).([]string)
+	return scopes, ok
+}
+
+// PKCE code challenge for the S256 method, see RFC 7636
+func getPkceCodeChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func returnOauthError(w http.ResponseWriter, r *http.Request, code int, errorCode, description string) {
+	writeResponse(w, r, code, types.OAuthErrorResponse{
+		Error:            errorCode,
+		ErrorDescription: description,
+	})
+}
+
 // Handlers
 
+// Registers a third party app, the client secret is only returned once
+func (h *HandlerService) InternalPostOauthApps(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	req := struct {
+		Name        string   `json:"name"`
+		RedirectUri string   `json:"redirect_uri"`
+		Scopes      []string `json:"scopes"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	name := v.checkNameNotEmpty(req.Name)
+	if len(name) > maxOauthAppNameLength {
+		v.add("name", fmt.Sprintf("given value '%s' is too long, maximum length is %d", name, maxOauthAppNameLength))
+	}
+	redirectUri := v.checkOauthRedirectUri(req.RedirectUri)
+	scopes := v.checkOauthScopes(req.Scopes)
+	if len(scopes) == 0 {
+		v.add("scopes", "at least one scope must be requested")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	apps, err := h.dai.GetOAuthApps(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if len(apps) >= maxOauthAppsPerUser {
+		handleErr(w, r, newConflictErr("maximum number of oauth apps reached"))
+		return
+	}
+
+	clientId := utils.RandomString(oauthClientIdLength)
+	clientSecret := utils.RandomString(oauthTokenLength)
+	app, err := h.dai.CreateOAuthApp(r.Context(), user.Id, name, redirectUri, clientId, utils.HashAndEncode(clientSecret), scopes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	app.ClientSecret = clientSecret
+
+	response := types.InternalPostOauthAppsResponse{
+		Data: *app,
+	}
+	returnCreated(w, r, response)
+}
+
+func (h *HandlerService) InternalGetOauthApps(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	apps, err := h.dai.GetOAuthApps(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetOauthAppsResponse{
+		Data: apps,
+	}
+	returnOk(w, r, response)
+}
+
+// Removes a third party app, all tokens that were issued to it are revoked
+func (h *HandlerService) InternalDeleteOauthApp(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	appId := v.checkUint(mux.Vars(r)["app_id"], "app_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	err = h.dai.RemoveOAuthApp(r.Context(), user.Id, appId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	returnNoContent(w, r)
+}
+
+// Lists the third party apps the user has granted access to
+func (h *HandlerService) InternalGetOauthGrants(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	grants, err := h.dai.GetOAuthGrants(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetOauthGrantsResponse{
+		Data: grants,
+	}
+	returnOk(w, r, response)
+}
+
+// Revokes the access the user has granted to a third party app, the app has to go through the authorization flow again to regain it
+func (h *HandlerService) InternalDeleteOauthGrant(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	appId := v.checkUint(mux.Vars(r)["app_id"], "app_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	err = h.dai.RevokeOAuthGrant(r.Context(), user.Id, appId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	returnNoContent(w, r)
+}
+
+// Authorization endpoint of the authorization code flow, requires an authenticated session.
+// Called by the consent page once the user approved the request, the returned redirect uri carries
+// the authorization code (or an error as described in RFC 6749) back to the third party app.
+// Only PKCE protected requests (code_challenge_method S256) are accepted.
 func (h *HandlerService) InternalPostOauthAuthorize(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	req := struct {
+		ResponseType        string `json:"response_type"`
+		ClientId            string `json:"client_id"`
+		RedirectUri         string `json:"redirect_uri"`
+		Scope               string `json:"scope"`
+		State               string `json:"state"`
+		CodeChallenge       string `json:"code_challenge"`
+		CodeChallengeMethod string `json:"code_challenge_method"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	clientId := v.checkRegex(reOauthClientId, req.ClientId, "client_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	// client and redirect uri have to be validated before redirecting anything back to the app
+	app, err := h.dai.GetOAuthAppCredentials(r.Context(), clientId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if req.RedirectUri != app.RedirectUri {
+		handleErr(w, r, newBadRequestErr("redirect_uri does not match the registered redirect uri"))
+		return
+	}
+	redirectUri, err := url.Parse(app.RedirectUri)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	query := redirectUri.Query()
+	if req.State != "" {
+		query.Set("state", req.State)
+	}
+	redirectWith := func(params map[string]string) {
+		for key, value := range params {
+			query.Set(key, value)
+		}
+		redirectUri.RawQuery = query.Encode()
+		response := types.InternalPostOauthAuthorizeResponse{
+			Data: types.OAuthAuthorization{
+				RedirectUri: redirectUri.String(),
+			},
+		}
+		returnOk(w, r, response)
+	}
+
+	if req.ResponseType != "code" {
+		redirectWith(map[string]string{"error": "unsupported_response_type"})
+		return
+	}
+	if req.CodeChallengeMethod != oauthCodeChallengeS256 || !reOauthCodeChallenge.MatchString(req.CodeChallenge) {
+		redirectWith(map[string]string{"error": "invalid_request", "error_description": "a S256 code_challenge is required"})
+		return
+	}
+	scopes := app.Scopes
+	if req.Scope != "" {
+		scopes = strings.Fields(req.Scope)
+		for _, scope := range scopes {
+			if !slices.Contains(app.Scopes, scope) {
+				redirectWith(map[string]string{"error": "invalid_scope", "error_description": fmt.Sprintf("scope '%s' is not allowed for this app", scope)})
+				return
+			}
+		}
+	}
+
+	code := utils.RandomString(oauthTokenLength)
+	err = h.dai.AddOAuthAuthorizationCode(r.Context(), utils.HashAndEncode(code), types.OAuthAuthorizationCode{
+		AppId:               app.AppId,
+		UserId:              user.Id,
+		ClientId:            app.ClientId,
+		RedirectUri:         app.RedirectUri,
+		Scopes:              scopes,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+	}, time.Now().UTC().Add(oauthCodeExpireTime))
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	redirectWith(map[string]string{"code": code})
 }
 
+// Token endpoint of the authorization code flow, supports the authorization_code and refresh_token grants.
+// Request and response must conform to RFC 6749 (form encoded request, unwrapped json response).
+// Confidential clients may authenticate with their client secret, public clients are authenticated by PKCE or the refresh token.
 func (h *HandlerService) InternalPostOauthToken(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	if err := r.ParseForm(); err != nil {
+		returnOauthError(w, r, http.StatusBadRequest, "invalid_request", "error parsing form")
+		return
+	}
+	clientId, clientSecret, hasBasicAuth := r.BasicAuth()
+	if !hasBasicAuth {
+		clientId = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+	if !reOauthClientId.MatchString(clientId) {
+		returnOauthError(w, r, http.StatusUnauthorized, "invalid_client", "")
+		return
+	}
+	app, err := h.dai.GetOAuthAppCredentials(r.Context(), clientId)
+	if err != nil {
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			returnOauthError(w, r, http.StatusUnauthorized, "invalid_client", "")
+		} else {
+			handleErr(w, r, err)
+		}
+		return
+	}
+	if clientSecret != "" && subtle.ConstantTimeCompare([]byte(utils.HashAndEncode(clientSecret)), []byte(app.ClientSecretHash)) != 1 {
+		returnOauthError(w, r, http.StatusUnauthorized, "invalid_client", "")
+		return
+	}
+
+	accessToken := oauthAccessTokenPrefix + utils.RandomString(oauthTokenLength)
+	refreshToken := oauthRefreshTokenPrefix + utils.RandomString(oauthTokenLength)
+	now := time.Now().UTC()
+	accessExpiresAt, refreshExpiresAt := now.Add(oauthAccessTokenExpireTime), now.Add(oauthRefreshTokenExpireTime)
+
+	var scopes []string
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		code, err := h.dai.ConsumeOAuthAuthorizationCode(r.Context(), utils.HashAndEncode(r.PostForm.Get("code")))
+		if err != nil {
+			if errors.Is(err, dataaccess.ErrNotFound) {
+				returnOauthError(w, r, http.StatusBadRequest, "invalid_grant", "authorization code is invalid or expired")
+			} else {
+				handleErr(w, r, err)
+			}
+			return
+		}
+		if code.AppId != app.AppId || code.RedirectUri != r.PostForm.Get("redirect_uri") {
+			returnOauthError(w, r, http.StatusBadRequest, "invalid_grant", "authorization code was not issued to this client")
+			return
+		}
+		codeVerifier := r.PostForm.Get("code_verifier")
+		if !reOauthCodeVerifier.MatchString(codeVerifier) || getPkceCodeChallenge(codeVerifier) != code.CodeChallenge {
+			returnOauthError(w, r, http.StatusBadRequest, "invalid_grant", "code_verifier does not match the code_challenge")
+			return
+		}
+		scopes = code.Scopes
+		err = h.dai.AddOAuthToken(r.Context(), app.AppId, code.UserId, utils.HashAndEncode(accessToken), utils.HashAndEncode(refreshToken), scopes, accessExpiresAt, refreshExpiresAt)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	case "refresh_token":
+		// refresh tokens are rotated, the old access and refresh token are invalid afterwards
+		token, err := h.dai.RotateOAuthToken(r.Context(), app.AppId, utils.HashAndEncode(r.PostForm.Get("refresh_token")),
+			utils.HashAndEncode(accessToken), utils.HashAndEncode(refreshToken), accessExpiresAt, refreshExpiresAt)
+		if err != nil {
+			if errors.Is(err, dataaccess.ErrNotFound) {
+				returnOauthError(w, r, http.StatusBadRequest, "invalid_grant", "refresh token is invalid or expired")
+			} else {
+				handleErr(w, r, err)
+			}
+			return
+		}
+		scopes = token.Scopes
+	default:
+		returnOauthError(w, r, http.StatusBadRequest, "unsupported_grant_type", "")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	returnOk(w, r, types.OAuthTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    uint64(oauthAccessTokenExpireTime.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(scopes, " "),
+	})
+}
+
+func (h *HandlerService) InternalGetApiKeys(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	keys, err := h.dai.GetApiKeys(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetApiKeysResponse{
+		Data: keys,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) InternalPostApiKeys(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	req := struct {
+		Name       string   `json:"name"`
+		Scope      string   `json:"scope,omitempty"`
+		AllowedIps []string `json:"allowed_ips,omitempty"`
+		ExpiresAt  *int64   `json:"expires_at,omitempty"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	name := v.checkNameNotEmpty(req.Name)
+	scope := apiKeyScopeWrite
+	if req.Scope != "" {
+		scope = v.checkApiKeyScope(req.Scope)
+	}
+	allowedIps := v.checkAllowedIps(req.AllowedIps)
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		expiry := time.Unix(*req.ExpiresAt, 0)
+		if !expiry.After(time.Now()) {
+			v.add("expires_at", "expiry must be in the future")
+		}
+		expiresAt = &expiry
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	userInfo, err := h.dai.GetUserInfo(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	keys, err := h.dai.GetApiKeys(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if uint64(len(keys)) >= userInfo.ApiPerks.ApiKeys {
+		handleErr(w, r, newConflictErr("maximum number of api keys reached"))
+		return
+	}
+
+	key, err := h.dai.CreateApiKey(r.Context(), user.Id, name, scope, allowedIps, expiresAt)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalPostApiKeysResponse{
+		Data: *key,
+	}
+	returnCreated(w, r, response)
+}
+
+// Replaces the key with a new one, the old key can't be used anymore afterwards
+func (h *HandlerService) InternalPostApiKeyRotation(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	keyId := v.checkUint(mux.Vars(r)["key_id"], "key_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	key, err := h.dai.RotateApiKey(r.Context(), user.Id, keyId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalPostApiKeysResponse{
+		Data: *key,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) InternalDeleteApiKey(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	keyId := v.checkUint(mux.Vars(r)["key_id"], "key_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	err = h.dai.RevokeApiKey(r.Context(), user.Id, keyId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	returnNoContent(w, r)
+}
+
+func (h *HandlerService) InternalGetApiKeyUsage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	var v validationError
+	keyId := v.checkUint(mux.Vars(r)["key_id"], "key_id")
+	days := uint64(defaultApiKeyUsageDays)
+	if daysParam := r.URL.Query().Get("days"); daysParam != "" {
+		days = v.checkUintMinMax(daysParam, 1, maxApiKeyUsageDays, "days")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -int(days-1))
+	usage, err := h.dai.GetApiKeyUsage(r.Context(), user.Id, keyId, since)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetApiKeyUsageResponse{
+		Data: *usage,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) InternalPostUsers(w http.ResponseWriter, r *http.Request) {
@@ -901,9 +1510,27 @@ func (h *HandlerService) InternalPutUserPassword(w http.ResponseWriter, r *http.
 // Middlewares
 
 // returns a middleware that stores user id in context, using the provided function
-func GetUserIdStoreMiddleware(userIdFunc func(r *http.Request) (uint64, error)) func(http.Handler) http.Handler {
+// requests that carry an oauth access token are authenticated by the token instead, its scopes are stored in context as well
+func (h *HandlerService) GetUserIdStoreMiddleware(userIdFunc func(r *http.Request) (uint64, error)) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if accessToken, ok := getOauthAccessToken(r); ok {
+				token, err := h.dai.GetOAuthAccessToken(r.Context(), utils.HashAndEncode(accessToken))
+				if err != nil {
+					if errors.Is(err, dataaccess.ErrNotFound) {
+						err = newUnauthorizedErr("access token is invalid or expired")
+					}
+					handleErr(w, r, err)
+					return
+				}
+				ctx := r.Context()
+				ctx = context.WithValue(ctx, ctxUserIdKey, token.UserId)
+				ctx = context.WithValue(ctx, ctxOauthScopesKey, token.Scopes)
+				r = r.WithContext(ctx)
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			userId, err := userIdFunc(r)
 			if err != nil {
 				if errors.Is(err, errUnauthorized) {
@@ -961,6 +1588,77 @@ func (h *HandlerService) VDBAuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// returns a middleware that checks if the oauth access token a request was authenticated with has been granted the given scopes
+// reading requires the read scope, everything else the write scope; requests authenticated otherwise are not affected
+func oauthScopeMiddleware(readScope, writeScope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scopes, ok := getOauthScopesByContext(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			requiredScope := writeScope
+			if isReadRequest(r) {
+				requiredScope = readScope
+			}
+			if !slices.Contains(scopes, requiredScope) {
+				handleErr(w, r, newForbiddenErr("access token has not been granted the '%s' scope", requiredScope))
+				return
+			}
+			ctx := context.WithValue(r.Context(), ctxOauthScopeCheckedKey, true)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// returns a middleware that checks if the oauth access token a request was authenticated with has been granted access to validator dashboards
+func (h *HandlerService) VDBOauthScopeMiddleware(next http.Handler) http.Handler {
+	return oauthScopeMiddleware(oauthScopeValidatorDashboardsRead, oauthScopeValidatorDashboardsWrite)(next)
+}
+
+// returns a middleware that checks if the oauth access token a request was authenticated with has been granted access to account dashboards
+func (h *HandlerService) ADBOauthScopeMiddleware(next http.Handler) http.Handler {
+	return oauthScopeMiddleware(oauthScopeAccountDashboardsRead, oauthScopeAccountDashboardsWrite)(next)
+}
+
+// returns a middleware that checks if user has access to account dashboard when a primary id is used
+func (h *HandlerService) ADBAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dashboardId, err := strconv.ParseUint(mux.Vars(r)["dashboard_id"], 10, 64)
+		if err != nil {
+			// if primary id is not used, no need to check access
+			next.ServeHTTP(w, r)
+			return
+		}
+		// primary id is used -> user needs to have access to dashboard
+
+		userId, err := GetUserIdByContext(r)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		// store user id in context
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, ctxUserIdKey, userId)
+		r = r.WithContext(ctx)
+
+		dashboardUser, err := h.dai.GetAccountDashboardUser(r.Context(), types.ADBIdPrimary(dashboardId))
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+
+		if dashboardUser.UserId != userId {
+			// user does not have access to dashboard, return 404 Not Found to not leak information
+			handleErr(w, r, newNotFoundErr("dashboard with id %v not found", dashboardId))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // returns a middleware that checks if user has premium perk to use public validator dashboard api
 // in the middleware chain, this should be used after GetVDBAuthMiddleware
 func (h *HandlerService) ManageViaApiCheckMiddleware(next http.Handler) http.Handler {
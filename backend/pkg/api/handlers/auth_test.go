@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOauthClientId    = "abcdefghijklmnopqrstuvwxyz012345"
+	testOauthRedirectUri = "https://app.example.com/callback"
+)
+
+// oauthTestDataAccessor serves a single app, a single authorization code and a single token pair
+type oauthTestDataAccessor struct {
+	*dataaccess.DummyService
+	code             *types.OAuthAuthorizationCode
+	refreshTokenHash string
+	rotations        []string // access and refresh token hashes of each rotation
+}
+
+func (d *oauthTestDataAccessor) GetOAuthAppCredentials(ctx context.Context, clientId string) (*types.OAuthAppCredentials, error) {
+	if clientId != testOauthClientId {
+		return nil, dataaccess.ErrNotFound
+	}
+	return &types.OAuthAppCredentials{AppId: 1, ClientId: clientId, RedirectUri: testOauthRedirectUri}, nil
+}
+
+func (d *oauthTestDataAccessor) ConsumeOAuthAuthorizationCode(ctx context.Context, codeHash string) (*types.OAuthAuthorizationCode, error) {
+	if d.code == nil || codeHash != utils.HashAndEncode("code") {
+		return nil, dataaccess.ErrNotFound
+	}
+	code := d.code
+	d.code = nil
+	return code, nil
+}
+
+func (d *oauthTestDataAccessor) AddOAuthToken(ctx context.Context, appId, userId uint64, accessTokenHash, refreshTokenHash string, scopes []string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	d.refreshTokenHash = refreshTokenHash
+	return nil
+}
+
+func (d *oauthTestDataAccessor) RotateOAuthToken(ctx context.Context, appId uint64, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) (*types.OAuthToken, error) {
+	if oldRefreshTokenHash != d.refreshTokenHash {
+		return nil, dataaccess.ErrNotFound
+	}
+	d.refreshTokenHash = refreshTokenHash
+	d.rotations = append(d.rotations, accessTokenHash, refreshTokenHash)
+	return &types.OAuthToken{AppId: appId, UserId: 1, Scopes: []string{oauthScopeValidatorDashboardsRead}, ExpiresAt: accessExpiresAt}, nil
+}
+
+func postOauthToken(h *HandlerService, form url.Values) *httptest.ResponseRecorder {
+	form.Set("client_id", testOauthClientId)
+	req := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.InternalPostOauthToken(rec, req)
+	return rec
+}
+
+func TestGetPkceCodeChallenge(t *testing.T) {
+	verifier := strings.Repeat("a", 43)
+	challenge := getPkceCodeChallenge(verifier)
+	assert.Regexp(t, reOauthCodeChallenge, challenge, "S256 challenges are unpadded base64url encoded sha256 hashes")
+	assert.Equal(t, challenge, getPkceCodeChallenge(verifier))
+	assert.NotEqual(t, challenge, getPkceCodeChallenge(strings.Repeat("a", 44)))
+}
+
+func TestOauthTokenPkce(t *testing.T) {
+	verifier := "dBjftJeZ4CVP-mJ0y5-1GbP9q1P3T9fAYj5Iq1aGzm8"
+	for _, tc := range []struct {
+		verifier   string
+		statusCode int
+	}{
+		{verifier, http.StatusOK},
+		{"wrong-verifier-wrong-verifier-wrong-verifier", http.StatusBadRequest},
+		{"", http.StatusBadRequest},
+	} {
+		dai := &oauthTestDataAccessor{code: &types.OAuthAuthorizationCode{
+			AppId:               1,
+			UserId:              1,
+			ClientId:            testOauthClientId,
+			RedirectUri:         testOauthRedirectUri,
+			Scopes:              []string{oauthScopeValidatorDashboardsRead},
+			CodeChallenge:       getPkceCodeChallenge(verifier),
+			CodeChallengeMethod: oauthCodeChallengeS256,
+		}}
+		h := &HandlerService{dai: dai}
+		rec := postOauthToken(h, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"code"},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {tc.verifier},
+		})
+		assert.Equal(t, tc.statusCode, rec.Code, fmt.Sprintf("verifier %q", tc.verifier))
+	}
+}
+
+func TestOauthTokenRefreshRotation(t *testing.T) {
+	dai := &oauthTestDataAccessor{refreshTokenHash: utils.HashAndEncode("bcrt_initial")}
+	h := &HandlerService{dai: dai}
+
+	refreshToken := "bcrt_initial"
+	for i := 0; i < 2; i++ {
+		rec := postOauthToken(h, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response types.OAuthTokenResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+		assert.True(t, strings.HasPrefix(response.AccessToken, oauthAccessTokenPrefix))
+		assert.True(t, strings.HasPrefix(response.RefreshToken, oauthRefreshTokenPrefix))
+		assert.NotEqual(t, refreshToken, response.RefreshToken, "refresh tokens must be rotated")
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+		// only hashes are persisted
+		assert.Equal(t, []string{utils.HashAndEncode(response.AccessToken), utils.HashAndEncode(response.RefreshToken)}, dai.rotations[2*i:])
+
+		// the rotated refresh token can't be used again
+		rec = postOauthToken(h, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		refreshToken = response.RefreshToken
+	}
+}
+
+func TestGetUserIdByContextOauth(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := context.WithValue(req.Context(), ctxUserIdKey, uint64(1))
+	ctx = context.WithValue(ctx, ctxOauthScopesKey, []string{oauthScopeValidatorDashboardsRead})
+	req = req.WithContext(ctx)
+
+	// oauth authenticated requests are denied unless a scope middleware has granted access
+	_, err := GetUserIdByContext(req)
+	assert.ErrorIs(t, err, errForbidden)
+
+	var userId uint64
+	handler := oauthScopeMiddleware(oauthScopeValidatorDashboardsRead, oauthScopeValidatorDashboardsWrite)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, err = GetUserIdByContext(r)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), userId)
+
+	// writing requires the write scope
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestIsIpAllowed(t *testing.T) {
+	allowedIps := []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32", "2a01:4f8::1"}
+	for ip, allowed := range map[string]bool{
+		"10.1.2.3":            true,
+		"11.0.0.1":            false,
+		"192.168.1.10":        true,
+		"192.168.1.11":        false,
+		"::ffff:192.168.1.10": true,
+		"2001:db8:1::5":       true,
+		"2001:db9::1":         false,
+		"2a01:4f8::1":         true,
+		"2a01:4f8::2":         false,
+		"not an ip":           false,
+		"":                    false,
+	} {
+		assert.Equal(t, allowed, isIpAllowed(ip, allowedIps), ip)
+	}
+	assert.False(t, isIpAllowed("10.1.2.3", nil))
+	assert.False(t, isIpAllowed("10.1.2.3", []string{"invalid"}))
+}
+
+func TestGetClientIp(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.5:1234"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3")
+
+	// forwarding headers of untrusted peers are ignored
+	assert.Equal(t, "203.0.113.5", getClientIp(req, nil))
+	assert.Equal(t, "203.0.113.5", getClientIp(req, []string{"198.51.100.0/24"}))
+	// and honoured for trusted proxies
+	assert.Equal(t, "10.1.2.3", getClientIp(req, []string{"203.0.113.0/24"}))
+}
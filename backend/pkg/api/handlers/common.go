@@ -9,15 +9,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gorilla/mux"
 	"github.com/invopop/jsonschema"
+	"github.com/shopspring/decimal"
 	"github.com/xeipuuv/gojsonschema"
 
 	"github.com/alexedwards/scs/v2"
@@ -57,17 +62,27 @@ var (
 	reName                         = regexp.MustCompile(`^[a-zA-Z0-9_\-.\ ]*$`)
 	reInteger                      = regexp.MustCompile(`^[0-9]+$`)
 	reValidatorDashboardPublicId   = regexp.MustCompile(`^v-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	reAccountDashboardPublicId     = regexp.MustCompile(`^a-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
 	reValidatorPublicKeyWithPrefix = regexp.MustCompile(`^0x[0-9a-fA-F]{96}$`)
 	reValidatorPublicKey           = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{96}$`)
 	reEthereumAddress              = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+	reBlockHash                    = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+	reHash                         = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
+	reEthereumAddressPrefix        = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{2,40}$`)
 	reWithdrawalCredential         = regexp.MustCompile(`^(0x0[01])?[0-9a-fA-F]{62}$`)
+	reWithdrawalCredentialPrefixed = regexp.MustCompile(`^0x0[0-2][0-9a-fA-F]{62}$`)
 	reEnsName                      = regexp.MustCompile(`^.+\.eth$`)
+	reEnsNamePrefix                = regexp.MustCompile(`^[^\s.][^\s]{2,}$`)
 	reNonEmpty                     = regexp.MustCompile(`^\s*\S.*$`)
 	reCursor                       = regexp.MustCompile(`^[A-Za-z0-9-_]+$`) // has to be base64
 	reEmail                        = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 	rePassword                     = regexp.MustCompile(`^.{5,}$`)
 	reEmailUserToken               = regexp.MustCompile(`^[a-z0-9]{40}$`)
 	reJsonContentType              = regexp.MustCompile(`^application\/json(;.*)?$`)
+	reOauthClientId                = regexp.MustCompile(`^[a-z0-9]{32}$`)
+	reOauthCodeChallenge           = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+	reOauthCodeVerifier            = regexp.MustCompile(`^[A-Za-z0-9._~-]{43,128}$`)
+	reBroadcastId                  = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
 )
 
 const (
@@ -83,6 +98,13 @@ const (
 	allowEmpty                        = true
 	forbidEmpty                       = false
 	MaxArchivedDashboardsCount        = 10
+	maxValidatorHistoryEpochs         = 100
+	maxValidatorHistoryDays           = 7
+	maxAddressHistoryDays             = 90
+	maxGasChartHours                  = 48
+	maxGasChartDays                   = 365
+	maxEnsAddresses                   = 100
+	maxEthStoreDays                   = 365
 )
 
 var (
@@ -96,6 +118,12 @@ var (
 	errGone            = errors.New("gone")
 )
 
+var (
+	// lower case minipool states and deposit types as exported by the rocketpool exporter
+	rocketPoolMinipoolStatuses = []string{"initialized", "prelaunch", "staking", "withdrawable", "dissolved"}
+	rocketPoolDepositTypes     = []string{"full", "half", "empty", "variable"}
+)
+
 type Paging struct {
 	cursor string
 	limit  uint64
@@ -181,6 +209,62 @@ func (v *validationError) checkUserEmailToken(token string) string {
 	return v.checkRegex(reEmailUserToken, token, "token")
 }
 
+func (v *validationError) checkOauthRedirectUri(redirectUri string) string {
+	uri, err := url.Parse(redirectUri)
+	if err != nil || !uri.IsAbs() || uri.Fragment != "" || len(redirectUri) > 1024 {
+		v.add("redirect_uri", fmt.Sprintf("given value '%s' is not a valid redirect uri", redirectUri))
+		return redirectUri
+	}
+	// plain http is only allowed for native apps listening on the loopback interface
+	if uri.Scheme == "http" && uri.Hostname() != "localhost" && uri.Hostname() != "127.0.0.1" {
+		v.add("redirect_uri", "redirect uri must use https")
+	}
+	return redirectUri
+}
+
+func (v *validationError) checkWebhookUrl(webhookUrl string) string {
+	uri, err := url.Parse(webhookUrl)
+	if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" || len(webhookUrl) > 1024 {
+		v.add("webhook_url", fmt.Sprintf("given value '%s' is not a valid webhook url", webhookUrl))
+	}
+	return webhookUrl
+}
+
+func (v *validationError) checkOauthScopes(scopes []string) []string {
+	for _, scope := range scopes {
+		if !slices.Contains(oauthScopes, scope) {
+			v.add("scopes", fmt.Sprintf("given value '%s' is not a valid scope", scope))
+		}
+	}
+	return slices.Compact(slices.Sorted(slices.Values(scopes)))
+}
+
+func (v *validationError) checkApiKeyScope(scope string) string {
+	if scope != apiKeyScopeRead && scope != apiKeyScopeWrite {
+		v.add("scope", fmt.Sprintf("given value '%s' is not a valid scope, must be either '%s' or '%s'", scope, apiKeyScopeRead, apiKeyScopeWrite))
+	}
+	return scope
+}
+
+// allowed ips may be single addresses or CIDR ranges, they are returned in their canonical form
+func (v *validationError) checkAllowedIps(allowedIps []string) []string {
+	if len(allowedIps) > maxApiKeyAllowedIps {
+		v.add("allowed_ips", fmt.Sprintf("too many entries, maximum is %d", maxApiKeyAllowedIps))
+		return nil
+	}
+	result := make([]string, 0, len(allowedIps))
+	for _, allowed := range allowedIps {
+		if prefix, err := netip.ParsePrefix(allowed); err == nil {
+			result = append(result, prefix.Masked().String())
+		} else if addr, err := netip.ParseAddr(allowed); err == nil {
+			result = append(result, addr.Unmap().String())
+		} else {
+			v.add("allowed_ips", fmt.Sprintf("given value '%s' is not a valid ip address or CIDR range", allowed))
+		}
+	}
+	return result
+}
+
 // check request structure (body contains valid json and all required parameters are present)
 // return error only if internal error occurs, otherwise add error to validationError and/or return nil
 func (v *validationError) checkBody(data interface{}, r *http.Request) error {
@@ -348,6 +432,24 @@ func (h *HandlerService) handleDashboardId(ctx context.Context, param string) (*
 	return dashboardId, nil
 }
 
+// handleAccountDashboardId validates the account dashboard id param and converts it to an ADBId.
+// account dashboards can only be accessed by primary or public id.
+func (h *HandlerService) handleAccountDashboardId(ctx context.Context, param string) (*types.ADBId, error) {
+	if reAccountDashboardPublicId.MatchString(param) {
+		publicId, err := h.dai.GetAccountDashboardPublicId(ctx, types.ADBIdPublic(param))
+		if err != nil {
+			return nil, err
+		}
+		return &types.ADBId{Id: types.ADBIdPrimary(publicId.DashboardId), AggregateGroups: !publicId.ShareSettings.ShareGroups}, nil
+	}
+	var v validationError
+	id := v.checkUint(param, "dashboard_id")
+	if v.hasErrors() {
+		return nil, v
+	}
+	return &types.ADBId{Id: types.ADBIdPrimary(id)}, nil
+}
+
 const chartDatapointLimit uint64 = 200
 
 type ChartTimeDashboardLimits struct {
@@ -411,7 +513,7 @@ func (h *HandlerService) getDashboardPremiumPerks(ctx context.Context, id types.
 func (h *HandlerService) validateBlockRequest(r *http.Request, paramName string) (uint64, uint64, error) {
 	var v validationError
 	var err error
-	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
 	var value uint64
 	switch paramValue := mux.Vars(r)[paramName]; paramValue {
 	// possibly add other values like "genesis", "finalized", hardforks etc. later
@@ -425,7 +527,20 @@ func (h *HandlerService) validateBlockRequest(r *http.Request, paramName string)
 			return 0, 0, err
 		}
 	default:
-		value = v.checkUint(paramValue, paramName)
+		if !reBlockHash.MatchString(paramValue) {
+			value = v.checkUint(paramValue, paramName)
+			break
+		}
+		// block hash (execution block hash or beacon block root)
+		hash := hexutil.MustDecode(paramValue)
+		if paramName == "block" {
+			value, err = h.dai.GetBlockHeightByHash(hash)
+		} else if paramName == "slot" {
+			value, err = h.dai.GetSlotByBlockRoot(hash)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
 	}
 	if v.hasErrors() {
 		return 0, 0, v
@@ -433,6 +548,265 @@ func (h *HandlerService) validateBlockRequest(r *http.Request, paramName string)
 	return chainId, value, nil
 }
 
+// helper function to resolve a validator path parameter (index or public key) to the validator index
+func (h *HandlerService) resolveValidatorParam(param string) (types.VDBValidator, error) {
+	var v validationError
+	indexes, publicKeys := v.checkValidatorList(param, forbidEmpty)
+	if len(indexes)+len(publicKeys) > 1 {
+		v.add("validator", "only a single validator may be given")
+	}
+	if v.hasErrors() {
+		return 0, v
+	}
+	validators, err := h.dai.GetValidatorsFromSlices(indexes, publicKeys)
+	if err != nil {
+		return 0, err
+	}
+	if len(validators) == 0 {
+		return 0, newNotFoundErr("validator %s not found", param)
+	}
+	return validators[0], nil
+}
+
+// resolveEpochParam resolves an epoch number or one of the keywords 'latest' and 'finalized'
+func (h *HandlerService) resolveEpochParam(v *validationError, param string) (uint64, error) {
+	switch param {
+	case "latest":
+		slot, err := h.dai.GetLatestSlot()
+		return slot / utils.Config.Chain.ClConfig.SlotsPerEpoch, err
+	case "finalized":
+		return h.dai.GetLatestFinalizedEpoch()
+	default:
+		return v.checkUint(param, "epoch"), nil
+	}
+}
+
+// resolveSyncPeriodParam resolves a sync committee period number or one of the keywords 'latest' and 'next'
+func (h *HandlerService) resolveSyncPeriodParam(v *validationError, param string) (uint64, error) {
+	switch param {
+	case "latest", "next":
+		slot, err := h.dai.GetLatestSlot()
+		if err != nil {
+			return 0, err
+		}
+		period := utils.SyncPeriodOfEpoch(slot / utils.Config.Chain.ClConfig.SlotsPerEpoch)
+		if param == "next" {
+			period++
+		}
+		return period, nil
+	default:
+		return v.checkUint(param, "period"), nil
+	}
+}
+
+// resolveEthStoreDayParam resolves a day number or the keyword 'latest' (the latest exported ETH.STORE day)
+func (h *HandlerService) resolveEthStoreDayParam(ctx context.Context, v *validationError, param string) (uint64, error) {
+	if param == "latest" {
+		return h.dai.GetLatestEthStoreDay(ctx)
+	}
+	return v.checkUint(param, "day"), nil
+}
+
+// checkEthStoreRange validates the start_day and end_day query parameters.
+// Missing bounds default to the most recent range ending at the latest exported ETH.STORE day.
+func (h *HandlerService) checkEthStoreRange(ctx context.Context, v *validationError, q url.Values) (uint64, uint64, error) {
+	latestDay, err := h.dai.GetLatestEthStoreDay(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	startDay, endDay := v.checkHistoryRange(q, "start_day", "end_day", maxEthStoreDays, latestDay)
+	return startDay, endDay, nil
+}
+
+// resolveValidatorsParam is like resolveValidatorParam, but accepts a comma separated list of up to maxValidatorsInList validators
+func (h *HandlerService) resolveValidatorsParam(param string) ([]types.VDBValidator, error) {
+	var v validationError
+	indexes, publicKeys := v.checkValidatorList(param, forbidEmpty)
+	if len(indexes)+len(publicKeys) > maxValidatorsInList {
+		v.add("validator", fmt.Sprintf("at most %d validators may be given", maxValidatorsInList))
+	}
+	if v.hasErrors() {
+		return nil, v
+	}
+	validators, err := h.dai.GetValidatorsFromSlices(indexes, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, newNotFoundErr("validators %s not found", param)
+	}
+	return validators, nil
+}
+
+// helper function to resolve an epoch path parameter to the inclusive slot range of the epoch
+func (h *HandlerService) resolveEpochSlotRange(v *validationError, param string) (*uint64, *uint64, error) {
+	epoch, err := h.resolveEpochParam(v, param)
+	if err != nil {
+		return nil, nil, err
+	}
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+	startSlot, endSlot := epoch*slotsPerEpoch, (epoch+1)*slotsPerEpoch-1
+	return &startSlot, &endSlot, nil
+}
+
+// checkOperationsFilter validates the optional address and credential query parameters used to filter the operation feeds
+func (v *validationError) checkOperationsFilter(q url.Values) types.NetworkOperationsFilter {
+	var filter types.NetworkOperationsFilter
+	if address := q.Get("address"); address != "" {
+		filter.Address = decodeHexParam(v.checkAddress(address))
+	}
+	if credential := q.Get("credential"); credential != "" {
+		filter.Credential = decodeHexParam(v.checkWithdrawalCredential(credential))
+	}
+	return filter
+}
+
+// checkRocketPoolNodesFilter validates the smoothing_pool, min_rpl_stake (wei) and min_collateral / max_collateral (percent) query parameters
+func (v *validationError) checkRocketPoolNodesFilter(q url.Values) types.RocketPoolNodesFilter {
+	var filter types.RocketPoolNodesFilter
+	if param := q.Get("smoothing_pool"); param != "" {
+		optIn := v.checkBool(param, "smoothing_pool")
+		filter.SmoothingPool = &optIn
+	}
+	if param := q.Get("min_rpl_stake"); param != "" {
+		stake, err := decimal.NewFromString(param)
+		if err != nil || stake.IsNegative() {
+			v.add("min_rpl_stake", fmt.Sprintf("given value '%s' is not a valid amount", param))
+		}
+		filter.MinRplStake = &stake
+	}
+	checkPercentage := func(paramName string) *float64 {
+		param := q.Get(paramName)
+		if param == "" {
+			return nil
+		}
+		percentage, err := strconv.ParseFloat(param, 64)
+		if err != nil || percentage < 0 {
+			v.add(paramName, fmt.Sprintf("given value '%s' is not a valid percentage", param))
+		}
+		return &percentage
+	}
+	filter.MinCollateral = checkPercentage("min_collateral")
+	filter.MaxCollateral = checkPercentage("max_collateral")
+	return filter
+}
+
+// checkRocketPoolMinipoolsFilter validates the node, status and deposit_type query parameters
+func (v *validationError) checkRocketPoolMinipoolsFilter(q url.Values) types.RocketPoolMinipoolsFilter {
+	var filter types.RocketPoolMinipoolsFilter
+	if node := q.Get("node"); node != "" {
+		filter.Node = decodeHexParam(v.checkRegex(reEthereumAddress, node, "node"))
+	}
+	if status := q.Get("status"); status != "" {
+		if !slices.Contains(rocketPoolMinipoolStatuses, status) {
+			v.add("status", fmt.Sprintf("given value '%s' is not a valid minipool status, allowed values are: %s", status, strings.Join(rocketPoolMinipoolStatuses, ", ")))
+		}
+		filter.Status = status
+	}
+	if depositType := q.Get("deposit_type"); depositType != "" {
+		if !slices.Contains(rocketPoolDepositTypes, depositType) {
+			v.add("deposit_type", fmt.Sprintf("given value '%s' is not a valid deposit type, allowed values are: %s", depositType, strings.Join(rocketPoolDepositTypes, ", ")))
+		}
+		filter.DepositType = depositType
+	}
+	return filter
+}
+
+// decodeHexParam decodes a parameter which has already been validated to be hex, the 0x prefix is optional
+func decodeHexParam(param string) []byte {
+	decoded, _ := hexutil.Decode("0x" + strings.TrimPrefix(param, "0x"))
+	return decoded
+}
+
+// checkGasChartRange validates the aggregation (hourly or daily, defaults to daily) and the after_ts and before_ts query parameters of the gas charts
+func (v *validationError) checkGasChartRange(r *http.Request) (enums.ChartAggregation, uint64, uint64) {
+	aggregation := enums.IntervalDaily
+	if param := r.URL.Query().Get("aggregation"); param != "" {
+		aggregation = checkEnum[enums.ChartAggregation](v, param, "aggregation")
+		checkEnumIsAllowed(v, aggregation, []enums.ChartAggregation{enums.IntervalHourly, enums.IntervalDaily}, "aggregation")
+	}
+	limits := ChartTimeDashboardLimits{
+		LatestExportedTs:   uint64(time.Now().Unix()),
+		MaxAllowedInterval: maxGasChartDays * uint64(utils.Day.Seconds()),
+	}
+	if aggregation == enums.IntervalHourly {
+		limits.MaxAllowedInterval = maxGasChartHours * uint64(time.Hour.Seconds())
+	}
+	afterTs, beforeTs := v.checkTimestamps(r, limits)
+	return aggregation, afterTs, beforeTs
+}
+
+// getLatestDay returns the day of the latest slot, used as the default end of day ranges
+func (h *HandlerService) getLatestDay() (uint64, error) {
+	latestSlot, err := h.dai.GetLatestSlot()
+	if err != nil {
+		return 0, err
+	}
+	return latestSlot / utils.Config.Chain.ClConfig.SlotsPerEpoch / utils.EpochsPerDay(), nil
+}
+
+type validatorHistoryRange struct {
+	aggregation enums.ChartAggregation
+	startEpoch  uint64 // inclusive
+	endEpoch    uint64 // inclusive
+}
+
+// checkHistoryRange validates an inclusive range of at most maxRange values given by the startParam and endParam query parameters.
+// Missing bounds default to the most recent range ending at latest.
+func (v *validationError) checkHistoryRange(q url.Values, startParam, endParam string, maxRange, latest uint64) (uint64, uint64) {
+	start, end := uint64(0), latest
+	hasStart, hasEnd := q.Get(startParam) != "", q.Get(endParam) != ""
+	if hasEnd {
+		end = v.checkUint(q.Get(endParam), endParam)
+	}
+	if hasStart {
+		start = v.checkUint(q.Get(startParam), startParam)
+	}
+	switch {
+	case hasStart && !hasEnd:
+		end = min(start+maxRange-1, latest)
+	case !hasStart && end+1 > maxRange:
+		start = end + 1 - maxRange
+	}
+	if v.hasErrors() {
+		return start, end
+	}
+	if start > end {
+		v.add(startParam, fmt.Sprintf("given value '%d' must not be greater than %s '%d'", start, endParam, end))
+	} else if end-start+1 > maxRange {
+		v.add(endParam, fmt.Sprintf("the requested range must not span more than %d values", maxRange))
+	}
+	return start, end
+}
+
+// checkValidatorHistoryRange validates the aggregation (epoch or daily) and the matching range parameters.
+// Missing bounds default to the most recent range ending at the latest finalized epoch.
+func (v *validationError) checkValidatorHistoryRange(q url.Values, latestFinalizedEpoch uint64) validatorHistoryRange {
+	result := validatorHistoryRange{aggregation: enums.IntervalEpoch}
+	if aggregation := q.Get("aggregation"); aggregation != "" {
+		result.aggregation = checkEnum[enums.ChartAggregation](v, aggregation, "aggregation")
+		checkEnumIsAllowed(v, result.aggregation, []enums.ChartAggregation{enums.IntervalEpoch, enums.IntervalDaily}, "aggregation")
+	}
+
+	startParam, endParam, maxRange, latest := "start_epoch", "end_epoch", uint64(maxValidatorHistoryEpochs), latestFinalizedEpoch
+	if result.aggregation == enums.IntervalDaily {
+		startParam, endParam, maxRange, latest = "start_day", "end_day", maxValidatorHistoryDays, latestFinalizedEpoch/utils.EpochsPerDay()
+	}
+	start, end := v.checkHistoryRange(q, startParam, endParam, maxRange, latest)
+	result.startEpoch, result.endEpoch = start, end
+	if result.aggregation == enums.IntervalDaily {
+		result.startEpoch, _ = utils.GetFirstAndLastEpochForDay(start)
+		_, result.endEpoch = utils.GetFirstAndLastEpochForDay(end)
+	}
+	// epochs that haven't been finalized yet are not exported
+	result.endEpoch = min(result.endEpoch, latestFinalizedEpoch)
+	return result
+}
+
+func (v *validationError) checkWithdrawalCredential(param string) string {
+	return v.checkRegex(reWithdrawalCredentialPrefixed, param, "credential")
+}
+
 // checkGroupId validates the given group id and returns it as an int64.
 // If the given group id is empty and allowEmpty is true, it returns -1 (all groups).
 func (v *validationError) checkGroupId(param string, allowEmpty bool) int64 {
@@ -476,6 +850,10 @@ func (v *validationError) checkValidatorDashboardPublicId(publicId string) types
 	return types.VDBIdPublic(v.checkRegex(reValidatorDashboardPublicId, publicId, "public_dashboard_id"))
 }
 
+func (v *validationError) checkAccountDashboardPublicId(publicId string) types.ADBIdPublic {
+	return types.ADBIdPublic(v.checkRegex(reAccountDashboardPublicId, publicId, "public_dashboard_id"))
+}
+
 type number interface {
 	uint64 | int64 | float64
 }
@@ -658,6 +1036,20 @@ func (v *validationError) checkNetworkParameter(param string) uint64 {
 	return v.checkNetwork(intOrString{strValue: &param})
 }
 
+// checkServedNetworkParameter additionally rejects networks whose chain data isn't available to this instance,
+// each instance only connects to the databases of the network it is configured for
+func (v *validationError) checkServedNetworkParameter(param string) uint64 {
+	chainId := v.checkNetworkParameter(param)
+	if chainId != 0 && !isServedNetwork(chainId) {
+		v.add("network", fmt.Sprintf("network '%s' is not served by this instance", param))
+	}
+	return chainId
+}
+
+func isServedNetwork(chainId uint64) bool {
+	return chainId == utils.Config.Chain.ClConfig.DepositChainID
+}
+
 // isValidNetwork checks if the given network is a valid network.
 // It returns the chain id of the network and true if it is valid, otherwise 0 and false.
 func isValidNetwork(network intOrString) (uint64, bool) {
@@ -718,6 +1110,8 @@ func getMaxChartAge(aggregation enums.ChartAggregation, perkSeconds types.ChartH
 		return perkSeconds.Daily
 	case aggregations.Weekly:
 		return perkSeconds.Weekly
+	case aggregations.Monthly:
+		return perkSeconds.Monthly
 	default:
 		return 0
 	}
@@ -836,7 +1230,7 @@ func handleErr(w http.ResponseWriter, r *http.Request, err error) {
 		returnForbidden(w, r, err)
 	case errors.Is(err, errConflict):
 		returnConflict(w, r, err)
-	case errors.Is(err, services.ErrWaiting):
+	case errors.Is(err, services.ErrWaiting), errors.Is(err, dataaccess.ErrUnavailable):
 		returnError(w, r, http.StatusServiceUnavailable, err)
 	case errors.Is(err, errTooManyRequests):
 		returnTooManyRequests(w, r, err)
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"testing"
+
+	types "github.com/gobitfly/beaconchain/pkg/api/types"
+	commontypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckServedNetworkParameter(t *testing.T) {
+	prevConfig, prevNetworks := utils.Config, allNetworks
+	defer func() { utils.Config, allNetworks = prevConfig, prevNetworks }()
+	utils.Config = &commontypes.Config{}
+	utils.Config.Chain.ClConfig.DepositChainID = 1
+	allNetworks = []types.NetworkInfo{{ChainId: 1, Name: "ethereum"}, {ChainId: 100, Name: "gnosis"}}
+
+	for _, param := range []string{"ethereum", "1"} {
+		var v validationError
+		assert.Equal(t, uint64(1), v.checkServedNetworkParameter(param))
+		assert.False(t, v.hasErrors(), param)
+	}
+	for _, param := range []string{"gnosis", "100", "unknown"} {
+		var v validationError
+		v.checkServedNetworkParameter(param)
+		assert.True(t, v.hasErrors(), param)
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"errors"
 	"math"
 	"net/http"
+	"time"
 
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	types "github.com/gobitfly/beaconchain/pkg/api/types"
+	commonTypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/notification"
 
 	"github.com/gorilla/mux"
 )
@@ -274,59 +278,59 @@ func (h *HandlerService) InternalGetUserDashboards(w http.ResponseWriter, r *htt
 // Account Dashboards
 
 func (h *HandlerService) InternalPostAccountDashboards(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
+	h.PublicPostAccountDashboards(w, r)
 }
 
 func (h *HandlerService) InternalGetAccountDashboard(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicGetAccountDashboard(w, r)
 }
 
 func (h *HandlerService) InternalDeleteAccountDashboard(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
+	h.PublicDeleteAccountDashboard(w, r)
 }
 
 func (h *HandlerService) InternalPostAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
+	h.PublicPostAccountDashboardGroups(w, r)
 }
 
 func (h *HandlerService) InternalDeleteAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
+	h.PublicDeleteAccountDashboardGroups(w, r)
 }
 
 func (h *HandlerService) InternalPostAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
+	h.PublicPostAccountDashboardAccounts(w, r)
 }
 
 func (h *HandlerService) InternalGetAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicGetAccountDashboardAccounts(w, r)
 }
 
 func (h *HandlerService) InternalDeleteAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
+	h.PublicDeleteAccountDashboardAccounts(w, r)
 }
 
 func (h *HandlerService) InternalPutAccountDashboardAccount(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicPutAccountDashboardAccount(w, r)
 }
 
 func (h *HandlerService) InternalPostAccountDashboardPublicIds(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
+	h.PublicPostAccountDashboardPublicIds(w, r)
 }
 
 func (h *HandlerService) InternalPutAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicPutAccountDashboardPublicId(w, r)
 }
 
 func (h *HandlerService) InternalDeleteAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
+	h.PublicDeleteAccountDashboardPublicId(w, r)
 }
 
 func (h *HandlerService) InternalGetAccountDashboardTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicGetAccountDashboardTransactions(w, r)
 }
 
 func (h *HandlerService) InternalPutAccountDashboardTransactionsSettings(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	h.PublicPutAccountDashboardTransactionsSettings(w, r)
 }
 
 // --------------------------------------
@@ -476,6 +480,10 @@ func (h *HandlerService) InternalGetValidatorDashboardRocketPoolMinipools(w http
 	h.PublicGetValidatorDashboardRocketPoolMinipools(w, r)
 }
 
+func (h *HandlerService) InternalGetValidatorDashboardEthStore(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardEthStore(w, r)
+}
+
 // --------------------------------------
 // Mobile
 
@@ -571,12 +579,17 @@ func (h *HandlerService) InternalGetUserNotificationDashboards(w http.ResponseWr
 
 func (h *HandlerService) InternalGetUserNotificationsValidatorDashboard(w http.ResponseWriter, r *http.Request) {
 	var v validationError
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 	notificationId := v.checkRegex(reNonEmpty, mux.Vars(r)["notification_id"], "notification_id")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	data, err := h.dai.GetValidatorDashboardNotificationDetails(r.Context(), notificationId)
+	data, err := h.dai.GetValidatorDashboardNotificationDetails(r.Context(), userId, notificationId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -589,12 +602,17 @@ func (h *HandlerService) InternalGetUserNotificationsValidatorDashboard(w http.R
 
 func (h *HandlerService) InternalGetUserNotificationsAccountDashboard(w http.ResponseWriter, r *http.Request) {
 	var v validationError
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 	notificationId := v.checkRegex(reNonEmpty, mux.Vars(r)["notification_id"], "notification_id")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	data, err := h.dai.GetAccountDashboardNotificationDetails(r.Context(), notificationId)
+	data, err := h.dai.GetAccountDashboardNotificationDetails(r.Context(), userId, notificationId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -709,6 +727,30 @@ func (h *HandlerService) InternalGetUserNotificationNetworks(w http.ResponseWrit
 	returnOk(w, r, response)
 }
 
+func (h *HandlerService) InternalGetUserNotificationWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	data, paging, err := h.dai.GetWebhookDeliveries(r.Context(), userId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetUserNotificationWebhookDeliveriesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
 func (h *HandlerService) InternalGetUserNotificationSettings(w http.ResponseWriter, r *http.Request) {
 	userId, err := GetUserIdByContext(r)
 	if err != nil {
@@ -794,6 +836,11 @@ func (h *HandlerService) InternalPutUserNotificationSettingsNetworks(w http.Resp
 
 func (h *HandlerService) InternalPutUserNotificationSettingsPairedDevices(w http.ResponseWriter, r *http.Request) {
 	var v validationError
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 	req := struct {
 		Name                   string `json:"name,omitempty"`
 		IsNotificationsEnabled bool   `json:"is_notifications_enabled"`
@@ -809,7 +856,7 @@ func (h *HandlerService) InternalPutUserNotificationSettingsPairedDevices(w http
 		handleErr(w, r, v)
 		return
 	}
-	err := h.dai.UpdateNotificationSettingsPairedDevice(r.Context(), pairedDeviceId, name, req.IsNotificationsEnabled)
+	err = h.dai.UpdateNotificationSettingsPairedDevice(r.Context(), userId, pairedDeviceId, name, req.IsNotificationsEnabled)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -828,13 +875,18 @@ func (h *HandlerService) InternalPutUserNotificationSettingsPairedDevices(w http
 
 func (h *HandlerService) InternalDeleteUserNotificationSettingsPairedDevices(w http.ResponseWriter, r *http.Request) {
 	var v validationError
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 	// TODO use a better way to validate the paired device id
 	pairedDeviceId := v.checkRegex(reNonEmpty, mux.Vars(r)["paired_device_id"], "paired_device_id")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	err := h.dai.DeleteNotificationSettingsPairedDevice(r.Context(), pairedDeviceId)
+	err = h.dai.DeleteNotificationSettingsPairedDevice(r.Context(), userId, pairedDeviceId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -876,6 +928,10 @@ func (h *HandlerService) InternalPutUserNotificationSettingsValidatorDashboard(w
 		return
 	}
 	checkMinMax(&v, req.GroupOfflineThreshold, 0, 1, "group_offline_threshold")
+	// an empty url removes the webhook of the group
+	if req.WebhookUrl != "" {
+		v.checkWebhookUrl(req.WebhookUrl)
+	}
 	vars := mux.Vars(r)
 	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
 	groupId := v.checkExistingGroupId(vars["group_id"])
@@ -922,6 +978,10 @@ func (h *HandlerService) InternalPutUserNotificationSettingsAccountDashboard(w h
 		i++
 	}
 	checkMinMax(&v, req.ERC20TokenTransfersValueThreshold, 0, math.MaxFloat64, "group_offline_threshold")
+	// an empty url removes the webhook of the group
+	if req.WebhookUrl != "" {
+		v.checkWebhookUrl(req.WebhookUrl)
+	}
 	vars := mux.Vars(r)
 	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
 	groupId := v.checkExistingGroupId(vars["group_id"])
@@ -953,188 +1013,195 @@ func (h *HandlerService) InternalPutUserNotificationSettingsAccountDashboard(w h
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) InternalPostUserNotificationsTestEmail(w http.ResponseWriter, r *http.Request) {
-	// TODO
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) InternalPostUserNotificationsTestPush(w http.ResponseWriter, r *http.Request) {
-	// TODO
-	returnOk(w, r, nil)
-}
+// test notifications are sent synchronously, so they are limited per user
+const (
+	maxNotificationTestsPerWindow = 10
+	notificationTestWindow        = time.Hour
+)
 
-func (h *HandlerService) InternalPostUserNotificationsTestWebhook(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	req := struct {
-		WebhookUrl              string `json:"webhook_url"`
-		IsDiscordWebhookEnabled bool   `json:"is_discord_webhook_enabled,omitempty"`
-	}{}
-	if err := v.checkBody(&req, r); err != nil {
-		handleErr(w, r, err)
-		return
+// checkNotificationTestRateLimit returns an error if the user has sent too many test notifications recently
+func (h *HandlerService) checkNotificationTestRateLimit(r *http.Request, userId uint64) error {
+	count, timeLeft, err := h.dai.IncrementNotificationTestCount(r.Context(), userId, notificationTestWindow)
+	if err != nil {
+		return err
 	}
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
+	if count > maxNotificationTestsPerWindow {
+		return newTooManyRequestsErr("rate limit reached, try again in %v", timeLeft.Round(time.Second))
 	}
-	// TODO
-	returnOk(w, r, nil)
+	return nil
 }
 
-// --------------------------------------
-// Blocks
+func returnNotificationTestResult(w http.ResponseWriter, r *http.Request, result *notification.TestNotificationResult) {
+	response := types.InternalPostUserNotificationsTestResponse{
+		Data: types.NotificationTestResult{
+			IsDelivered:   result.Delivered,
+			StatusCode:    uint64(result.StatusCode),
+			ProviderError: result.ProviderError,
+		},
+	}
+	returnOk(w, r, response)
+}
 
-func (h *HandlerService) InternalGetBlock(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
+func (h *HandlerService) InternalPostUserNotificationsTestEmail(w http.ResponseWriter, r *http.Request) {
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlock(r.Context(), chainId, block)
-	if err != nil {
+	if err := h.checkNotificationTestRateLimit(r, userId); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) InternalGetBlockOverview(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
+	userInfo, err := h.dai.GetUserInfo(r.Context(), userId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlockOverview(r.Context(), chainId, block)
+	result, err := notification.SendTestEmail(userInfo.Email)
 	if err != nil {
+		var rateLimitErr *commonTypes.RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			handleErr(w, r, newTooManyRequestsErr("daily email limit reached, try again in %v", rateLimitErr.TimeLeft.Round(time.Second)))
+			return
+		}
 		handleErr(w, r, err)
 		return
 	}
-	response := types.InternalGetBlockOverviewResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
+	returnNotificationTestResult(w, r, result)
 }
 
-func (h *HandlerService) InternalGetBlockTransactions(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
+func (h *HandlerService) InternalPostUserNotificationsTestPush(w http.ResponseWriter, r *http.Request) {
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlockTransactions(r.Context(), chainId, block)
-	if err != nil {
+	if err := h.checkNotificationTestRateLimit(r, userId); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockTransactionsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) InternalGetBlockVotes(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
+	tokens, err := h.dai.GetNotificationPushTokens(r.Context(), userId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlockVotes(r.Context(), chainId, block)
+	result, err := notification.SendTestPush(r.Context(), tokens)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockVotesResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	returnNotificationTestResult(w, r, result)
 }
 
-func (h *HandlerService) InternalGetBlockAttestations(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
+func (h *HandlerService) InternalPostUserNotificationsTestWebhook(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlockAttestations(r.Context(), chainId, block)
-	if err != nil {
+	req := struct {
+		WebhookUrl              string `json:"webhook_url"`
+		IsDiscordWebhookEnabled bool   `json:"is_discord_webhook_enabled,omitempty"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockAttestationsResponse{
-		Data: data,
+	webhookUrl := v.checkWebhookUrl(req.WebhookUrl)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
 	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) InternalGetBlockWithdrawals(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
-	if err != nil {
+	// test requests are signed like real deliveries, so only webhooks the user has already set up can be tested
+	var secret string
+	if !req.IsDiscordWebhookEnabled {
+		secret, err = h.dai.GetNotificationWebhookSecret(r.Context(), userId, webhookUrl)
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			handleErr(w, r, newBadRequestErr("webhook url must be saved in the dashboard notification settings before it can be tested"))
+			return
+		}
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+	if err := h.checkNotificationTestRateLimit(r, userId); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	data, err := h.dai.GetBlockWithdrawals(r.Context(), chainId, block)
+	result, err := notification.SendTestWebhook(r.Context(), webhookUrl, secret, req.IsDiscordWebhookEnabled)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockWtihdrawalsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	returnNotificationTestResult(w, r, result)
 }
 
-func (h *HandlerService) InternalGetBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
+// --------------------------------------
+// Blocks
+
+func (h *HandlerService) InternalGetBlock(w http.ResponseWriter, r *http.Request) {
 	chainId, block, err := h.validateBlockRequest(r, "block")
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	data, err := h.dai.GetBlockBlsChanges(r.Context(), chainId, block)
+	data, err := h.dai.GetBlock(r.Context(), chainId, block)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	response := types.InternalGetBlockBlsChangesResponse{
-		Data: data,
+	response := types.InternalGetBlockResponse{
+		Data: *data,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) InternalGetBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) InternalGetBlockOverview(w http.ResponseWriter, r *http.Request) {
 	chainId, block, err := h.validateBlockRequest(r, "block")
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	data, err := h.dai.GetBlockVoluntaryExits(r.Context(), chainId, block)
+	data, err := h.dai.GetBlockOverview(r.Context(), chainId, block)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.InternalGetBlockVoluntaryExitsResponse{
-		Data: data,
+	response := types.InternalGetBlockOverviewResponse{
+		Data: *data,
 	}
 	returnOk(w, r, response)
 }
 
+func (h *HandlerService) InternalGetBlockTransactions(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockTransactions(w, r)
+}
+
+func (h *HandlerService) InternalGetBlockVotes(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockVotes(w, r)
+}
+
+func (h *HandlerService) InternalGetBlockAttestations(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockAttestations(w, r)
+}
+
+func (h *HandlerService) InternalGetBlockWithdrawals(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockWithdrawals(w, r)
+}
+
+func (h *HandlerService) InternalGetBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockBlsChanges(w, r)
+}
+
+func (h *HandlerService) InternalGetBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkBlockVoluntaryExits(w, r)
+}
+
 func (h *HandlerService) InternalGetBlockBlobs(w http.ResponseWriter, r *http.Request) {
 	chainId, block, err := h.validateBlockRequest(r, "block")
 	if err != nil {
@@ -1195,117 +1262,27 @@ func (h *HandlerService) InternalGetSlotOverview(w http.ResponseWriter, r *http.
 }
 
 func (h *HandlerService) InternalGetSlotTransactions(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotTransactions(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockTransactionsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotTransactions(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotVotes(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotVotes(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockVotesResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotVotes(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotAttestations(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotAttestations(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockAttestationsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotAttestations(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotWithdrawals(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotWithdrawals(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockWtihdrawalsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotWithdrawals(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotBlsChanges(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotBlsChanges(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockBlsChangesResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotBlsChanges(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.dai.GetSlotVoluntaryExits(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockVoluntaryExitsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotVoluntaryExits(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotBlobs(w http.ResponseWriter, r *http.Request) {
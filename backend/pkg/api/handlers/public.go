@@ -2,14 +2,21 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	"github.com/gobitfly/beaconchain/pkg/api/services"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	commonTypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gorilla/mux"
 )
 
@@ -76,84 +83,14 @@ func (h *HandlerService) PublicGetUserDashboards(w http.ResponseWriter, r *http.
 }
 
 func (h *HandlerService) PublicPostAccountDashboards(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
-}
-
-func (h *HandlerService) PublicGetAccountDashboard(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicDeleteAccountDashboard(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
-}
-
-func (h *HandlerService) PublicPostAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
-}
-
-func (h *HandlerService) PublicDeleteAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
-}
-
-func (h *HandlerService) PublicPostAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
-}
-
-func (h *HandlerService) PublicGetAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicDeleteAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
-}
-
-func (h *HandlerService) PublicPutAccountDashboardAccount(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicPostAccountDashboardPublicIds(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
-}
-
-func (h *HandlerService) PublicPutAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicDeleteAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
-	returnNoContent(w, r)
-}
-
-func (h *HandlerService) PublicGetAccountDashboardTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicPutAccountDashboardTransactionsSettings(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-// PublicPostValidatorDashboards godoc
-//
-//	@Description	Create a new validator dashboard. **Note**: New dashboards will automatically have a default group created.
-//	@Security		ApiKeyInHeader || ApiKeyInQuery
-//	@Tags			Validator Dashboards
-//	@Accept			json
-//	@Produce		json
-//	@Param			request	body		handlers.PublicPostValidatorDashboards.request	true	"`name`: Specify the name of the dashboard.<br>`network`: Specify the network for the dashboard. Possible options are:<ul><li>`ethereum`</li><li>`gnosis`</li></ul>"
-//	@Success		201		{object}	types.ApiDataResponse[types.VDBPostReturnData]
-//	@Failure		400		{object}	types.ApiErrorResponse
-//	@Failure		409		{object}	types.ApiErrorResponse	"Conflict. The request could not be performed by the server because the authenticated user has already reached their dashboard limit."
-//	@Router			/validator-dashboards [post]
-func (h *HandlerService) PublicPostValidatorDashboards(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
 	type request struct {
-		Name    string      `json:"name"`
-		Network intOrString `json:"network" swaggertype:"string" enums:"ethereum,gnosis"`
+		Name string `json:"name"`
 	}
 	var req request
 	if err := v.checkBody(&req, r); err != nil {
@@ -161,7 +98,6 @@ func (h *HandlerService) PublicPostValidatorDashboards(w http.ResponseWriter, r
 		return
 	}
 	name := v.checkNameNotEmpty(req.Name)
-	chainId := v.checkNetwork(req.Network)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
@@ -172,86 +108,65 @@ func (h *HandlerService) PublicPostValidatorDashboards(w http.ResponseWriter, r
 		handleErr(w, r, err)
 		return
 	}
-	dashboardCount, err := h.dai.GetUserValidatorDashboardCount(r.Context(), userId, true)
+	dashboardCount, err := h.dai.GetUserAccountDashboardCount(r.Context(), userId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if dashboardCount >= userInfo.PremiumPerks.ValidatorDashboards && !isUserAdmin(userInfo) {
-		returnConflict(w, r, errors.New("maximum number of validator dashboards reached"))
+	if dashboardCount >= userInfo.PremiumPerks.AccountDashboards && !isUserAdmin(userInfo) {
+		returnConflict(w, r, errors.New("maximum number of account dashboards reached"))
 		return
 	}
 
-	data, err := h.dai.CreateValidatorDashboard(r.Context(), userId, name, chainId)
+	data, err := h.dai.CreateAccountDashboard(r.Context(), userId, name)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.ApiDataResponse[types.VDBPostReturnData]{
+	response := types.ApiDataResponse[types.ADBPostReturnData]{
 		Data: *data,
 	}
 	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboard(w http.ResponseWriter, r *http.Request) {
-	var v validationError
+func (h *HandlerService) PublicGetAccountDashboard(w http.ResponseWriter, r *http.Request) {
 	dashboardIdParam := mux.Vars(r)["dashboard_id"]
-	dashboardId, err := h.handleDashboardId(r.Context(), dashboardIdParam)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	q := r.URL.Query()
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
-
-	// set name depending on dashboard id
-	var name string
-	if reInteger.MatchString(dashboardIdParam) {
-		name, err = h.dai.GetValidatorDashboardName(r.Context(), dashboardId.Id)
-	} else if reValidatorDashboardPublicId.MatchString(dashboardIdParam) {
-		var publicIdInfo *types.VDBPublicId
-		publicIdInfo, err = h.dai.GetValidatorDashboardPublicId(r.Context(), types.VDBIdPublic(dashboardIdParam))
-		name = publicIdInfo.Name
-	}
+	dashboardId, err := h.handleAccountDashboardId(r.Context(), dashboardIdParam)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	// add premium chart perk info for shared dashboards
-	premiumPerks, err := h.getDashboardPremiumPerks(r.Context(), *dashboardId)
+	data, err := h.dai.GetAccountDashboardOverview(r.Context(), *dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	data, err := h.dai.GetValidatorDashboardOverview(r.Context(), *dashboardId, protocolModes)
-	if err != nil {
-		handleErr(w, r, err)
-		return
+	// shared dashboards show the name of the public id and don't reveal other public ids
+	if reAccountDashboardPublicId.MatchString(dashboardIdParam) {
+		publicIdInfo, err := h.dai.GetAccountDashboardPublicId(r.Context(), types.ADBIdPublic(dashboardIdParam))
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		data.Name = publicIdInfo.Name
+		data.PublicIds = nil
 	}
-	data.ChartHistorySeconds = premiumPerks.ChartHistorySeconds
-	data.Name = name
 
-	response := types.GetValidatorDashboardResponse{
+	response := types.GetAccountDashboardResponse{
 		Data: *data,
 	}
-
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicDeleteValidatorDashboard(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteAccountDashboard(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	err := h.dai.RemoveValidatorDashboard(r.Context(), dashboardId)
+	err := h.dai.RemoveAccountDashboard(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -259,36 +174,9 @@ func (h *HandlerService) PublicDeleteValidatorDashboard(w http.ResponseWriter, r
 	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicPutValidatorDashboardName(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
-	type request struct {
-		Name string `json:"name"`
-	}
-	var req request
-	if err := v.checkBody(&req, r); err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	name := v.checkNameNotEmpty(req.Name)
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
-	data, err := h.dai.UpdateValidatorDashboardName(r.Context(), dashboardId, name)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	response := types.ApiDataResponse[types.VDBPostReturnData]{
-		Data: *data,
-	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPostAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
 	type request struct {
 		Name string `json:"name"`
 	}
@@ -314,73 +202,33 @@ func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWrite
 		handleErr(w, r, err)
 		return
 	}
-	groupCount, err := h.dai.GetValidatorDashboardGroupCount(ctx, dashboardId)
+	groupCount, err := h.dai.GetAccountDashboardGroupCount(ctx, dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if groupCount >= userInfo.PremiumPerks.ValidatorGroupsPerDashboard && !isUserAdmin(userInfo) {
-		returnConflict(w, r, errors.New("maximum number of validator dashboard groups reached"))
+	if groupCount >= userInfo.PremiumPerks.AccountGroupsPerDashboard && !isUserAdmin(userInfo) {
+		returnConflict(w, r, errors.New("maximum number of account dashboard groups reached"))
 		return
 	}
 
-	data, err := h.dai.CreateValidatorDashboardGroup(ctx, dashboardId, name)
+	data, err := h.dai.CreateAccountDashboardGroup(ctx, dashboardId, name)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	response := types.ApiDataResponse[types.VDBPostCreateGroupData]{
+	response := types.ApiDataResponse[types.ADBPostCreateGroupData]{
 		Data: *data,
 	}
 
 	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicPutValidatorDashboardGroups(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	vars := mux.Vars(r)
-	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
-	groupId := v.checkExistingGroupId(vars["group_id"])
-	type request struct {
-		Name string `json:"name"`
-	}
-	var req request
-	if err := v.checkBody(&req, r); err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	name := v.checkNameNotEmpty(req.Name)
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
-	groupExists, err := h.dai.GetValidatorDashboardGroupExists(r.Context(), dashboardId, groupId)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	if !groupExists {
-		returnNotFound(w, r, errors.New("group not found"))
-		return
-	}
-	data, err := h.dai.UpdateValidatorDashboardGroup(r.Context(), dashboardId, groupId, name)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.ApiDataResponse[types.VDBPostCreateGroupData]{
-		Data: *data,
-	}
-
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteAccountDashboardGroups(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(vars["dashboard_id"]))
 	groupId := v.checkExistingGroupId(vars["group_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
@@ -390,7 +238,7 @@ func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWrit
 		returnBadRequest(w, r, errors.New("cannot delete default group"))
 		return
 	}
-	groupExists, err := h.dai.GetValidatorDashboardGroupExists(r.Context(), dashboardId, groupId)
+	groupExists, err := h.dai.GetAccountDashboardGroupExists(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -399,7 +247,7 @@ func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWrit
 		returnNotFound(w, r, errors.New("group not found"))
 		return
 	}
-	err = h.dai.RemoveValidatorDashboardGroup(r.Context(), dashboardId, groupId)
+	err = h.dai.RemoveAccountDashboardGroup(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -408,44 +256,32 @@ func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWrit
 	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPostAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
 	type request struct {
-		GroupId           uint64        `json:"group_id,omitempty" x-nullable:"true"`
-		Validators        []intOrString `json:"validators,omitempty"`
-		DepositAddress    string        `json:"deposit_address,omitempty"`
-		WithdrawalAddress string        `json:"withdrawal_address,omitempty"`
-		Graffiti          string        `json:"graffiti,omitempty"`
+		GroupId   uint64   `json:"group_id,omitempty" x-nullable:"true"`
+		Addresses []string `json:"addresses"`
 	}
 	var req request
 	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
-	// check if exactly one of validators, deposit_address, withdrawal_address, graffiti is set
-	fields := []interface{}{req.Validators, req.DepositAddress, req.WithdrawalAddress, req.Graffiti}
-	var count int
-	for _, set := range fields {
-		if !reflect.ValueOf(set).IsZero() {
-			count++
-		}
+	if len(req.Addresses) == 0 {
+		v.add("addresses", "list must not be empty")
 	}
-	if count != 1 {
-		v.add("request body", "exactly one of `validators`, `deposit_address`, `withdrawal_address`, `graffiti` must be set. please check the API documentation for more information")
+	addresses := make([]string, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addresses = append(addresses, v.checkAddress(address))
 	}
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	groupId := req.GroupId
 	ctx := r.Context()
-	groupExists, err := h.dai.GetValidatorDashboardGroupExists(ctx, dashboardId, groupId)
+	groupExists, err := h.dai.GetAccountDashboardGroupExists(ctx, dashboardId, req.GroupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -464,76 +300,38 @@ func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseW
 		handleErr(w, r, err)
 		return
 	}
-	limit := userInfo.PremiumPerks.ValidatorsPerDashboard
-	if req.Validators == nil && !userInfo.PremiumPerks.BulkAdding && !isUserAdmin(userInfo) {
-		returnConflict(w, r, errors.New("bulk adding not allowed with current subscription plan"))
+	// check if adding more accounts than allowed, accounts already in the dashboard are only moved
+	accountCount, err := h.dai.GetAccountDashboardAccountCount(ctx, dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	existingAccountCount, err := h.dai.GetAccountDashboardExistingAccountCount(ctx, dashboardId, addresses)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	limit := userInfo.PremiumPerks.AccountsPerDashboard
+	if accountCount+uint64(len(addresses))-existingAccountCount > limit && !isUserAdmin(userInfo) {
+		returnConflict(w, r, fmt.Errorf("adding more accounts than allowed, limit is %v accounts", limit))
 		return
 	}
-	var data []types.VDBPostValidatorsData
-	var dataErr error
-	switch {
-	case req.Validators != nil:
-		indices, pubkeys := v.checkValidators(req.Validators, forbidEmpty)
-		if v.hasErrors() {
-			handleErr(w, r, v)
-			return
-		}
-		validators, err := h.dai.GetValidatorsFromSlices(indices, pubkeys)
-		if err != nil {
-			handleErr(w, r, err)
-			return
-		}
-		// check if adding more validators than allowed
-		existingValidatorCount, err := h.dai.GetValidatorDashboardExistingValidatorCount(ctx, dashboardId, validators)
-		if err != nil {
-			handleErr(w, r, err)
-			return
-		}
-		if uint64(len(validators)) > existingValidatorCount+limit {
-			returnConflict(w, r, fmt.Errorf("adding more validators than allowed, limit is %v new validators", limit))
-			return
-		}
-		data, dataErr = h.dai.AddValidatorDashboardValidators(ctx, dashboardId, groupId, validators)
 
-	case req.DepositAddress != "":
-		depositAddress := v.checkRegex(reEthereumAddress, req.DepositAddress, "deposit_address")
-		if v.hasErrors() {
-			handleErr(w, r, v)
-			return
-		}
-		data, dataErr = h.dai.AddValidatorDashboardValidatorsByDepositAddress(ctx, dashboardId, groupId, depositAddress, limit)
-
-	case req.WithdrawalAddress != "":
-		withdrawalAddress := v.checkRegex(reWithdrawalCredential, req.WithdrawalAddress, "withdrawal_address")
-		if v.hasErrors() {
-			handleErr(w, r, v)
-			return
-		}
-		data, dataErr = h.dai.AddValidatorDashboardValidatorsByWithdrawalAddress(ctx, dashboardId, groupId, withdrawalAddress, limit)
-
-	case req.Graffiti != "":
-		graffiti := v.checkRegex(reNonEmpty, req.Graffiti, "graffiti")
-		if v.hasErrors() {
-			handleErr(w, r, v)
-			return
-		}
-		data, dataErr = h.dai.AddValidatorDashboardValidatorsByGraffiti(ctx, dashboardId, groupId, graffiti, limit)
-	}
-
-	if dataErr != nil {
-		handleErr(w, r, dataErr)
+	data, err := h.dai.AddAccountDashboardAccounts(ctx, dashboardId, req.GroupId, addresses)
+	if err != nil {
+		handleErr(w, r, err)
 		return
 	}
-	response := types.ApiDataResponse[[]types.VDBPostValidatorsData]{
+	response := types.ApiDataResponse[[]types.ADBPostAccountsData]{
 		Data: data,
 	}
 
 	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	dashboardId, err := h.handleAccountDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -541,57 +339,89 @@ func (h *HandlerService) PublicGetValidatorDashboardValidators(w http.ResponseWr
 	q := r.URL.Query()
 	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
 	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBManageValidatorsColumn](&v, q.Get("sort"))
+	sort := checkSort[enums.ADBManageAccountsColumn](&v, q.Get("sort"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	data, paging, err := h.dai.GetValidatorDashboardValidators(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	data, paging, err := h.dai.GetAccountDashboardAccounts(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardValidatorsResponse{
+	response := types.GetAccountDashboardAccountsResponse{
 		Data:   data,
 		Paging: *paging,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicDeleteValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteAccountDashboardAccounts(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
-	var indices []uint64
-	var publicKeys []string
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
 	req := struct {
-		Validators []intOrString `json:"validators"`
+		Addresses []string `json:"addresses"`
 	}{}
 	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	indices, publicKeys = v.checkValidators(req.Validators, false)
+	addresses := make([]string, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addresses = append(addresses, v.checkAddress(address))
+	}
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	validators, err := h.dai.GetValidatorsFromSlices(indices, publicKeys)
+	err := h.dai.RemoveAccountDashboardAccounts(r.Context(), dashboardId, addresses)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	err = h.dai.RemoveValidatorDashboardValidators(r.Context(), dashboardId, validators)
+
+	returnNoContent(w, r)
+}
+
+func (h *HandlerService) PublicPutAccountDashboardAccount(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(vars["dashboard_id"]))
+	address := v.checkAddress(vars["address"])
+	req := struct {
+		GroupId uint64 `json:"group_id"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	groupExists, err := h.dai.GetAccountDashboardGroupExists(r.Context(), dashboardId, req.GroupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	returnNoContent(w, r)
+	if !groupExists {
+		returnNotFound(w, r, errors.New("group not found"))
+		return
+	}
+	data, err := h.dai.UpdateAccountDashboardAccount(r.Context(), dashboardId, address, req.GroupId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.ApiDataResponse[types.ADBManageAccountsTableRow]{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicPostValidatorDashboardPublicIds(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPostAccountDashboardPublicIds(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
 	type request struct {
 		Name          string `json:"name,omitempty"`
 		ShareSettings struct {
@@ -608,7 +438,7 @@ func (h *HandlerService) PublicPostValidatorDashboardPublicIds(w http.ResponseWr
 		handleErr(w, r, v)
 		return
 	}
-	publicIdCount, err := h.dai.GetValidatorDashboardPublicIdCount(r.Context(), dashboardId)
+	publicIdCount, err := h.dai.GetAccountDashboardPublicIdCount(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -618,7 +448,7 @@ func (h *HandlerService) PublicPostValidatorDashboardPublicIds(w http.ResponseWr
 		return
 	}
 
-	data, err := h.dai.CreateValidatorDashboardPublicId(r.Context(), dashboardId, name, req.ShareSettings.ShareGroups)
+	data, err := h.dai.CreateAccountDashboardPublicId(r.Context(), dashboardId, name, req.ShareSettings.ShareGroups)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -630,10 +460,10 @@ func (h *HandlerService) PublicPostValidatorDashboardPublicIds(w http.ResponseWr
 	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicPutValidatorDashboardPublicId(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPutAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(vars["dashboard_id"]))
 	type request struct {
 		Name          string `json:"name,omitempty"`
 		ShareSettings struct {
@@ -646,12 +476,12 @@ func (h *HandlerService) PublicPutValidatorDashboardPublicId(w http.ResponseWrit
 		return
 	}
 	name := v.checkName(req.Name, 0)
-	publicDashboardId := v.checkValidatorDashboardPublicId(vars["public_id"])
+	publicDashboardId := v.checkAccountDashboardPublicId(vars["public_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	fetchedId, err := h.dai.GetValidatorDashboardIdByPublicId(r.Context(), publicDashboardId)
+	fetchedId, err := h.dai.GetAccountDashboardIdByPublicId(r.Context(), publicDashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -661,7 +491,7 @@ func (h *HandlerService) PublicPutValidatorDashboardPublicId(w http.ResponseWrit
 		return
 	}
 
-	data, err := h.dai.UpdateValidatorDashboardPublicId(r.Context(), publicDashboardId, name, req.ShareSettings.ShareGroups)
+	data, err := h.dai.UpdateAccountDashboardPublicId(r.Context(), publicDashboardId, name, req.ShareSettings.ShareGroups)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -673,16 +503,16 @@ func (h *HandlerService) PublicPutValidatorDashboardPublicId(w http.ResponseWrit
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicDeleteValidatorDashboardPublicId(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteAccountDashboardPublicId(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
-	publicDashboardId := v.checkValidatorDashboardPublicId(vars["public_id"])
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(vars["dashboard_id"]))
+	publicDashboardId := v.checkAccountDashboardPublicId(vars["public_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	fetchedId, err := h.dai.GetValidatorDashboardIdByPublicId(r.Context(), publicDashboardId)
+	fetchedId, err := h.dai.GetAccountDashboardIdByPublicId(r.Context(), publicDashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -692,7 +522,7 @@ func (h *HandlerService) PublicDeleteValidatorDashboardPublicId(w http.ResponseW
 		return
 	}
 
-	err = h.dai.RemoveValidatorDashboardPublicId(r.Context(), publicDashboardId)
+	err = h.dai.RemoveAccountDashboardPublicId(r.Context(), publicDashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -701,1040 +531,3126 @@ func (h *HandlerService) PublicDeleteValidatorDashboardPublicId(w http.ResponseW
 	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicPutValidatorDashboardArchiving(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetAccountDashboardTransactions(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
-	req := struct {
-		IsArchived bool `json:"is_archived"`
-	}{}
-	if err := v.checkBody(&req, r); err != nil {
+	dashboardId, err := h.handleAccountDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
+	q := r.URL.Query()
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
+	pagingParams := v.checkPagingParams(q)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	// check conditions for changing archival status
-	dashboardInfo, err := h.dai.GetValidatorDashboardInfo(r.Context(), dashboardId)
+	data, paging, err := h.dai.GetAccountDashboardTransactions(r.Context(), *dashboardId, groupId, pagingParams.cursor, pagingParams.limit)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if dashboardInfo.IsArchived == req.IsArchived {
-		// nothing to do
-		returnOk(w, r, types.ApiDataResponse[types.VDBPostArchivingReturnData]{
-			Data: types.VDBPostArchivingReturnData{Id: uint64(dashboardId), IsArchived: req.IsArchived},
-		})
-		return
+	response := types.GetAccountDashboardTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
 	}
+	returnOk(w, r, response)
+}
 
-	userId, err := GetUserIdByContext(r)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	dashboardCount, err := h.dai.GetUserValidatorDashboardCount(r.Context(), userId, !req.IsArchived)
-	if err != nil {
+func (h *HandlerService) PublicPutAccountDashboardTransactionsSettings(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := types.ADBIdPrimary(v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"]))
+	var req types.ADBTransactionsSettings
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	userInfo, err := h.dai.GetUserInfo(r.Context(), userId)
-	if err != nil {
-		handleErr(w, r, err)
+	if v.hasErrors() {
+		handleErr(w, r, v)
 		return
 	}
-	if !isUserAdmin(userInfo) {
-		if req.IsArchived {
-			if dashboardCount >= MaxArchivedDashboardsCount {
-				returnConflict(w, r, errors.New("maximum number of archived validator dashboards reached"))
-				return
-			}
-		} else {
-			if dashboardCount >= userInfo.PremiumPerks.ValidatorDashboards {
-				returnConflict(w, r, errors.New("maximum number of active validator dashboards reached"))
-				return
-			}
-			if dashboardInfo.GroupCount >= userInfo.PremiumPerks.ValidatorGroupsPerDashboard {
-				returnConflict(w, r, errors.New("maximum number of groups in dashboards reached"))
-				return
-			}
-			if dashboardInfo.ValidatorCount >= userInfo.PremiumPerks.ValidatorsPerDashboard {
-				returnConflict(w, r, errors.New("maximum number of validators in dashboards reached"))
-				return
-			}
-		}
-	}
-
-	var archivedReason *enums.VDBArchivedReason
-	if req.IsArchived {
-		archivedReason = &enums.VDBArchivedReasons.User
-	}
-
-	data, err := h.dai.UpdateValidatorDashboardArchiving(r.Context(), dashboardId, archivedReason)
+	data, err := h.dai.UpdateAccountDashboardTransactionsSettings(r.Context(), dashboardId, req)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.ApiDataResponse[types.VDBPostArchivingReturnData]{
+	response := types.PutAccountDashboardTransactionsSettingsResponse{
 		Data: *data,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardSlotViz(w http.ResponseWriter, r *http.Request) {
+// PublicPostValidatorDashboards godoc
+//
+//	@Description	Create a new validator dashboard. **Note**: New dashboards will automatically have a default group created.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboards
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		handlers.PublicPostValidatorDashboards.request	true	"`name`: Specify the name of the dashboard.<br>`network`: Specify the network for the dashboard. Possible options are:<ul><li>`ethereum`</li><li>`gnosis`</li></ul>"
+//	@Success		201		{object}	types.ApiDataResponse[types.VDBPostReturnData]
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		409		{object}	types.ApiErrorResponse	"Conflict. The request could not be performed by the server because the authenticated user has already reached their dashboard limit."
+//	@Router			/validator-dashboards [post]
+func (h *HandlerService) PublicPostValidatorDashboards(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	groupIds := v.checkExistingGroupIdList(r.URL.Query().Get("group_ids"))
+	type request struct {
+		Name    string      `json:"name"`
+		Network intOrString `json:"network" swaggertype:"string" enums:"ethereum,gnosis"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	name := v.checkNameNotEmpty(req.Name)
+	chainId := v.checkNetwork(req.Network)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	data, err := h.dai.GetValidatorDashboardSlotViz(r.Context(), *dashboardId, groupIds)
+
+	userInfo, err := h.dai.GetUserInfo(r.Context(), userId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardSlotVizResponse{
-		Data: data,
-	}
-
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicGetValidatorDashboardSummary(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	dashboardCount, err := h.dai.GetUserValidatorDashboardCount(r.Context(), userId, true)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	q := r.URL.Query()
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBSummaryColumn](&v, q.Get("sort"))
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-
-	period := checkEnum[enums.TimePeriod](&v, q.Get("period"), "period")
-	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
-	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
-	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
-	if v.hasErrors() {
-		handleErr(w, r, v)
+	if dashboardCount >= userInfo.PremiumPerks.ValidatorDashboards && !isUserAdmin(userInfo) {
+		returnConflict(w, r, errors.New("maximum number of validator dashboards reached"))
 		return
 	}
 
-	data, paging, err := h.dai.GetValidatorDashboardSummary(r.Context(), *dashboardId, period, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	data, err := h.dai.CreateValidatorDashboard(r.Context(), userId, name, chainId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardSummaryResponse{
-		Data:   data,
-		Paging: *paging,
+	response := types.ApiDataResponse[types.VDBPostReturnData]{
+		Data: *data,
 	}
-	returnOk(w, r, response)
+	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardGroupSummary(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboard(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	vars := mux.Vars(r)
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
-	q := r.URL.Query()
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
+	dashboardIdParam := mux.Vars(r)["dashboard_id"]
+	dashboardId, err := h.handleDashboardId(r.Context(), dashboardIdParam)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	groupId := v.checkGroupId(vars["group_id"], forbidEmpty)
-	period := checkEnum[enums.TimePeriod](&v, r.URL.Query().Get("period"), "period")
-	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
-	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
-	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
+
+	q := r.URL.Query()
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, err := h.dai.GetValidatorDashboardGroupSummary(r.Context(), *dashboardId, groupId, period, protocolModes)
+	// set name depending on dashboard id
+	var name string
+	if reInteger.MatchString(dashboardIdParam) {
+		name, err = h.dai.GetValidatorDashboardName(r.Context(), dashboardId.Id)
+	} else if reValidatorDashboardPublicId.MatchString(dashboardIdParam) {
+		var publicIdInfo *types.VDBPublicId
+		publicIdInfo, err = h.dai.GetValidatorDashboardPublicId(r.Context(), types.VDBIdPublic(dashboardIdParam))
+		name = publicIdInfo.Name
+	}
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardGroupSummaryResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
-}
 
-func (h *HandlerService) PublicGetValidatorDashboardSummaryChart(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	ctx := r.Context()
-	dashboardId, err := h.handleDashboardId(ctx, mux.Vars(r)["dashboard_id"])
+	// add premium chart perk info for shared dashboards
+	premiumPerks, err := h.getDashboardPremiumPerks(r.Context(), *dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	q := r.URL.Query()
-	groupIds := v.checkGroupIdList(q.Get("group_ids"))
-	efficiencyType := checkEnum[enums.VDBSummaryChartEfficiencyType](&v, q.Get("efficiency_type"), "efficiency_type")
-
-	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
-	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(ctx, dashboardId, aggregation)
+	data, err := h.dai.GetValidatorDashboardOverview(r.Context(), *dashboardId, protocolModes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	afterTs, beforeTs := v.checkTimestamps(r, chartLimits)
+	data.ChartHistorySeconds = premiumPerks.ChartHistorySeconds
+	data.Name = name
+
+	response := types.GetValidatorDashboardResponse{
+		Data: *data,
+	}
+
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicDeleteValidatorDashboard(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	if afterTs < chartLimits.MinAllowedTs || beforeTs < chartLimits.MinAllowedTs {
-		returnConflict(w, r, fmt.Errorf("requested time range is too old, minimum timestamp for dashboard owner's premium subscription for this aggregation is %v", chartLimits.MinAllowedTs))
-		return
-	}
-
-	data, err := h.dai.GetValidatorDashboardSummaryChart(ctx, *dashboardId, groupIds, efficiencyType, aggregation, afterTs, beforeTs)
+	err := h.dai.RemoveValidatorDashboard(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardSummaryChartResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
+	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardSummaryValidators(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPutValidatorDashboardName(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Name string `json:"name"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	groupId := v.checkGroupId(r.URL.Query().Get("group_id"), allowEmpty)
-	q := r.URL.Query()
-	duty := checkEnum[enums.ValidatorDuty](&v, q.Get("duty"), "duty")
-	period := checkEnum[enums.TimePeriod](&v, q.Get("period"), "period")
-	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
-	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
-	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
+	name := v.checkNameNotEmpty(req.Name)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	// get indices based on duty
-	var indices interface{}
-	duties := enums.ValidatorDuties
-	switch duty {
-	case duties.None:
-		indices, err = h.dai.GetValidatorDashboardSummaryValidators(r.Context(), *dashboardId, groupId)
-	case duties.Sync:
-		indices, err = h.dai.GetValidatorDashboardSyncSummaryValidators(r.Context(), *dashboardId, groupId, period)
-	case duties.Slashed:
-		indices, err = h.dai.GetValidatorDashboardSlashingsSummaryValidators(r.Context(), *dashboardId, groupId, period)
-	case duties.Proposal:
-		indices, err = h.dai.GetValidatorDashboardProposalSummaryValidators(r.Context(), *dashboardId, groupId, period)
-	}
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	// map indices to response format
-	data, err := mapVDBIndices(indices)
+	data, err := h.dai.UpdateValidatorDashboardName(r.Context(), dashboardId, name)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.GetValidatorDashboardSummaryValidatorsResponse{
-		Data: data,
+	response := types.ApiDataResponse[types.VDBPostReturnData]{
+		Data: *data,
 	}
-
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardRewards(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Name string `json:"name"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	q := r.URL.Query()
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBRewardsColumn](&v, q.Get("sort"))
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	name := v.checkNameNotEmpty(req.Name)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, paging, err := h.dai.GetValidatorDashboardRewards(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	ctx := r.Context()
+	// check if user has reached the maximum number of groups
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardRewardsResponse{
-		Data:   data,
-		Paging: *paging,
+	userInfo, err := h.dai.GetUserInfo(ctx, userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
 	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicGetValidatorDashboardGroupRewards(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	vars := mux.Vars(r)
-	q := r.URL.Query()
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	groupCount, err := h.dai.GetValidatorDashboardGroupCount(ctx, dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	groupId := v.checkGroupId(vars["group_id"], forbidEmpty)
-	epoch := v.checkUint(vars["epoch"], "epoch")
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	if v.hasErrors() {
-		handleErr(w, r, v)
+	if groupCount >= userInfo.PremiumPerks.ValidatorGroupsPerDashboard && !isUserAdmin(userInfo) {
+		returnConflict(w, r, errors.New("maximum number of validator dashboard groups reached"))
 		return
 	}
 
-	data, err := h.dai.GetValidatorDashboardGroupRewards(r.Context(), *dashboardId, groupId, epoch, protocolModes)
+	data, err := h.dai.CreateValidatorDashboardGroup(ctx, dashboardId, name)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardGroupRewardsResponse{
+
+	response := types.ApiDataResponse[types.VDBPostCreateGroupData]{
 		Data: *data,
 	}
-	returnOk(w, r, response)
+
+	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardRewardsChart(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPutValidatorDashboardGroups(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	q := r.URL.Query()
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
-	if err != nil {
+	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
+	groupId := v.checkExistingGroupId(vars["group_id"])
+	type request struct {
+		Name string `json:"name"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	name := v.checkNameNotEmpty(req.Name)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, err := h.dai.GetValidatorDashboardRewardsChart(r.Context(), *dashboardId, protocolModes)
+	groupExists, err := h.dai.GetValidatorDashboardGroupExists(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardRewardsChartResponse{
+	if !groupExists {
+		returnNotFound(w, r, errors.New("group not found"))
+		return
+	}
+	data, err := h.dai.UpdateValidatorDashboardGroup(r.Context(), dashboardId, groupId, name)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.ApiDataResponse[types.VDBPostCreateGroupData]{
 		Data: *data,
 	}
+
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardDuties(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	q := r.URL.Query()
-	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
-	epoch := v.checkUint(vars["epoch"], "epoch")
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBDutiesColumn](&v, q.Get("sort"))
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	groupId := v.checkExistingGroupId(vars["group_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, paging, err := h.dai.GetValidatorDashboardDuties(r.Context(), *dashboardId, epoch, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
-	if err != nil {
-		handleErr(w, r, err)
+	if groupId == types.DefaultGroupId {
+		returnBadRequest(w, r, errors.New("cannot delete default group"))
 		return
 	}
-	response := types.GetValidatorDashboardDutiesResponse{
-		Data:   data,
-		Paging: *paging,
-	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicGetValidatorDashboardBlocks(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	groupExists, err := h.dai.GetValidatorDashboardGroupExists(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	q := r.URL.Query()
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBBlocksColumn](&v, q.Get("sort"))
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	if v.hasErrors() {
-		handleErr(w, r, v)
+	if !groupExists {
+		returnNotFound(w, r, errors.New("group not found"))
 		return
 	}
-
-	data, paging, err := h.dai.GetValidatorDashboardBlocks(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	err = h.dai.RemoveValidatorDashboardGroup(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardBlocksResponse{
-		Data:   data,
-		Paging: *paging,
-	}
-	returnOk(w, r, response)
+
+	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardHeatmap(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
-		handleErr(w, r, err)
-		return
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		GroupId           uint64        `json:"group_id,omitempty" x-nullable:"true"`
+		Validators        []intOrString `json:"validators,omitempty"`
+		DepositAddress    string        `json:"deposit_address,omitempty"`
+		WithdrawalAddress string        `json:"withdrawal_address,omitempty"`
+		Graffiti          string        `json:"graffiti,omitempty"`
 	}
-	q := r.URL.Query()
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
-	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(r.Context(), dashboardId, aggregation)
-	if err != nil {
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	afterTs, beforeTs := v.checkTimestamps(r, chartLimits)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	if afterTs < chartLimits.MinAllowedTs || beforeTs < chartLimits.MinAllowedTs {
-		returnConflict(w, r, fmt.Errorf("requested time range is too old, minimum timestamp for dashboard owner's premium subscription for this aggregation is %v", chartLimits.MinAllowedTs))
+	// check if exactly one of validators, deposit_address, withdrawal_address, graffiti is set
+	fields := []interface{}{req.Validators, req.DepositAddress, req.WithdrawalAddress, req.Graffiti}
+	var count int
+	for _, set := range fields {
+		if !reflect.ValueOf(set).IsZero() {
+			count++
+		}
+	}
+	if count != 1 {
+		v.add("request body", "exactly one of `validators`, `deposit_address`, `withdrawal_address`, `graffiti` must be set. please check the API documentation for more information")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
 		return
 	}
 
-	data, err := h.dai.GetValidatorDashboardHeatmap(r.Context(), *dashboardId, protocolModes, aggregation, afterTs, beforeTs)
+	groupId := req.GroupId
+	ctx := r.Context()
+	groupExists, err := h.dai.GetValidatorDashboardGroupExists(ctx, dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardHeatmapResponse{
-		Data: *data,
+	if !groupExists {
+		returnNotFound(w, r, errors.New("group not found"))
+		return
 	}
-	returnOk(w, r, response)
-}
-
-func (h *HandlerService) PublicGetValidatorDashboardGroupHeatmap(w http.ResponseWriter, r *http.Request) {
-	var v validationError
-	vars := mux.Vars(r)
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	userId, err := GetUserIdByContext(r)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	groupId := v.checkExistingGroupId(vars["group_id"])
-	requestedTimestamp := v.checkUint(vars["timestamp"], "timestamp")
-	protocolModes := v.checkProtocolModes(r.URL.Query().Get("modes"))
-	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
-	if v.hasErrors() {
-		handleErr(w, r, v)
-		return
-	}
-	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(r.Context(), dashboardId, aggregation)
+	userInfo, err := h.dai.GetUserInfo(ctx, userId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if requestedTimestamp < chartLimits.MinAllowedTs || requestedTimestamp > chartLimits.LatestExportedTs {
-		handleErr(w, r, newConflictErr("requested timestamp is outside of allowed chart history for dashboard owner's premium subscription"))
+	limit := userInfo.PremiumPerks.ValidatorsPerDashboard
+	if req.Validators == nil && !userInfo.PremiumPerks.BulkAdding && !isUserAdmin(userInfo) {
+		returnConflict(w, r, errors.New("bulk adding not allowed with current subscription plan"))
 		return
 	}
+	var data []types.VDBPostValidatorsData
+	var dataErr error
+	switch {
+	case req.Validators != nil:
+		indices, pubkeys := v.checkValidators(req.Validators, forbidEmpty)
+		if v.hasErrors() {
+			handleErr(w, r, v)
+			return
+		}
+		validators, err := h.dai.GetValidatorsFromSlices(indices, pubkeys)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		// check if adding more validators than allowed
+		existingValidatorCount, err := h.dai.GetValidatorDashboardExistingValidatorCount(ctx, dashboardId, validators)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		if uint64(len(validators)) > existingValidatorCount+limit {
+			returnConflict(w, r, fmt.Errorf("adding more validators than allowed, limit is %v new validators", limit))
+			return
+		}
+		data, dataErr = h.dai.AddValidatorDashboardValidators(ctx, dashboardId, groupId, validators)
 
-	data, err := h.dai.GetValidatorDashboardGroupHeatmap(r.Context(), *dashboardId, groupId, protocolModes, aggregation, requestedTimestamp)
-	if err != nil {
-		handleErr(w, r, err)
+	case req.DepositAddress != "":
+		depositAddress := v.checkRegex(reEthereumAddress, req.DepositAddress, "deposit_address")
+		if v.hasErrors() {
+			handleErr(w, r, v)
+			return
+		}
+		data, dataErr = h.dai.AddValidatorDashboardValidatorsByDepositAddress(ctx, dashboardId, groupId, depositAddress, limit)
+
+	case req.WithdrawalAddress != "":
+		withdrawalAddress := v.checkRegex(reWithdrawalCredential, req.WithdrawalAddress, "withdrawal_address")
+		if v.hasErrors() {
+			handleErr(w, r, v)
+			return
+		}
+		data, dataErr = h.dai.AddValidatorDashboardValidatorsByWithdrawalAddress(ctx, dashboardId, groupId, withdrawalAddress, limit)
+
+	case req.Graffiti != "":
+		graffiti := v.checkRegex(reNonEmpty, req.Graffiti, "graffiti")
+		if v.hasErrors() {
+			handleErr(w, r, v)
+			return
+		}
+		data, dataErr = h.dai.AddValidatorDashboardValidatorsByGraffiti(ctx, dashboardId, groupId, graffiti, limit)
+	}
+
+	if dataErr != nil {
+		handleErr(w, r, dataErr)
 		return
 	}
-	response := types.GetValidatorDashboardGroupHeatmapResponse{
-		Data: *data,
+	response := types.ApiDataResponse[[]types.VDBPostValidatorsData]{
+		Data: data,
 	}
-	returnOk(w, r, response)
+
+	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardExecutionLayerDeposits(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(r.URL.Query())
+	q := r.URL.Query()
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBManageValidatorsColumn](&v, q.Get("sort"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, paging, err := h.dai.GetValidatorDashboardElDeposits(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	data, paging, err := h.dai.GetValidatorDashboardValidators(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardExecutionLayerDepositsResponse{
+	response := types.GetValidatorDashboardValidatorsResponse{
 		Data:   data,
 		Paging: *paging,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardConsensusLayerDeposits(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteValidatorDashboardValidators(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	var indices []uint64
+	var publicKeys []string
+	req := struct {
+		Validators []intOrString `json:"validators"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(r.URL.Query())
+	indices, publicKeys = v.checkValidators(req.Validators, false)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, paging, err := h.dai.GetValidatorDashboardClDeposits(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	validators, err := h.dai.GetValidatorsFromSlices(indices, publicKeys)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.GetValidatorDashboardConsensusLayerDepositsResponse{
-		Data:   data,
-		Paging: *paging,
+	err = h.dai.RemoveValidatorDashboardValidators(r.Context(), dashboardId, validators)
+	if err != nil {
+		handleErr(w, r, err)
+		return
 	}
-	returnOk(w, r, response)
+
+	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardTotalConsensusLayerDeposits(w http.ResponseWriter, r *http.Request) {
-	var err error
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+func (h *HandlerService) PublicPostValidatorDashboardPublicIds(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Name          string `json:"name,omitempty"`
+		ShareSettings struct {
+			ShareGroups bool `json:"share_groups"`
+		} `json:"share_settings"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	data, err := h.dai.GetValidatorDashboardTotalClDeposits(r.Context(), *dashboardId)
+	name := v.checkName(req.Name, 0)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	publicIdCount, err := h.dai.GetValidatorDashboardPublicIdCount(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	response := types.GetValidatorDashboardTotalConsensusDepositsResponse{
-		Data: *data,
+	if publicIdCount >= 1 {
+		returnConflict(w, r, errors.New("cannot create more than one public id"))
+		return
 	}
-	returnOk(w, r, response)
-}
 
-func (h *HandlerService) PublicGetValidatorDashboardTotalExecutionLayerDeposits(w http.ResponseWriter, r *http.Request) {
-	var err error
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	data, err := h.dai.CreateValidatorDashboardPublicId(r.Context(), dashboardId, name, req.ShareSettings.ShareGroups)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	data, err := h.dai.GetValidatorDashboardTotalElDeposits(r.Context(), *dashboardId)
-	if err != nil {
-		handleErr(w, r, err)
-		return
+	response := types.ApiResponse{
+		Data: data,
 	}
 
-	response := types.GetValidatorDashboardTotalExecutionDepositsResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
+	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardWithdrawals(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPutValidatorDashboardPublicId(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	q := r.URL.Query()
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+	vars := mux.Vars(r)
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Name          string `json:"name,omitempty"`
+		ShareSettings struct {
+			ShareGroups bool `json:"share_groups"`
+		} `json:"share_settings"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBWithdrawalsColumn](&v, q.Get("sort"))
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	name := v.checkName(req.Name, 0)
+	publicDashboardId := v.checkValidatorDashboardPublicId(vars["public_id"])
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
+	fetchedId, err := h.dai.GetValidatorDashboardIdByPublicId(r.Context(), publicDashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if *fetchedId != dashboardId {
+		handleErr(w, r, newNotFoundErr("public id %v not found", publicDashboardId))
+		return
+	}
 
-	data, paging, err := h.dai.GetValidatorDashboardWithdrawals(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	data, err := h.dai.UpdateValidatorDashboardPublicId(r.Context(), publicDashboardId, name, req.ShareSettings.ShareGroups)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardWithdrawalsResponse{
-		Data:   data,
-		Paging: *paging,
+	response := types.ApiResponse{
+		Data: data,
 	}
+
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardTotalWithdrawals(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicDeleteValidatorDashboardPublicId(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	q := r.URL.Query()
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	vars := mux.Vars(r)
+	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
+	publicDashboardId := v.checkValidatorDashboardPublicId(vars["public_id"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	fetchedId, err := h.dai.GetValidatorDashboardIdByPublicId(r.Context(), publicDashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(q)
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	if v.hasErrors() {
-		handleErr(w, r, v)
+	if *fetchedId != dashboardId {
+		handleErr(w, r, newNotFoundErr("public id %v not found", publicDashboardId))
 		return
 	}
 
-	data, err := h.dai.GetValidatorDashboardTotalWithdrawals(r.Context(), *dashboardId, pagingParams.search, protocolModes)
+	err = h.dai.RemoveValidatorDashboardPublicId(r.Context(), publicDashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 
-	response := types.GetValidatorDashboardTotalWithdrawalsResponse{
-		Data: *data,
-	}
-	returnOk(w, r, response)
+	returnNoContent(w, r)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardRocketPool(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicPutValidatorDashboardArchiving(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	q := r.URL.Query()
-	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
-	if err != nil {
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	req := struct {
+		IsArchived bool `json:"is_archived"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBRocketPoolColumn](&v, q.Get("sort"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, paging, err := h.dai.GetValidatorDashboardRocketPool(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	// check conditions for changing archival status
+	dashboardInfo, err := h.dai.GetValidatorDashboardInfo(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardRocketPoolResponse{
-		Data:   data,
-		Paging: *paging,
+	if dashboardInfo.IsArchived == req.IsArchived {
+		// nothing to do
+		returnOk(w, r, types.ApiDataResponse[types.VDBPostArchivingReturnData]{
+			Data: types.VDBPostArchivingReturnData{Id: uint64(dashboardId), IsArchived: req.IsArchived},
+		})
+		return
+	}
+
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	dashboardCount, err := h.dai.GetUserValidatorDashboardCount(r.Context(), userId, !req.IsArchived)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	userInfo, err := h.dai.GetUserInfo(r.Context(), userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !isUserAdmin(userInfo) {
+		if req.IsArchived {
+			if dashboardCount >= MaxArchivedDashboardsCount {
+				returnConflict(w, r, errors.New("maximum number of archived validator dashboards reached"))
+				return
+			}
+		} else {
+			if dashboardCount >= userInfo.PremiumPerks.ValidatorDashboards {
+				returnConflict(w, r, errors.New("maximum number of active validator dashboards reached"))
+				return
+			}
+			if dashboardInfo.GroupCount >= userInfo.PremiumPerks.ValidatorGroupsPerDashboard {
+				returnConflict(w, r, errors.New("maximum number of groups in dashboards reached"))
+				return
+			}
+			if dashboardInfo.ValidatorCount >= userInfo.PremiumPerks.ValidatorsPerDashboard {
+				returnConflict(w, r, errors.New("maximum number of validators in dashboards reached"))
+				return
+			}
+		}
+	}
+
+	var archivedReason *enums.VDBArchivedReason
+	if req.IsArchived {
+		archivedReason = &enums.VDBArchivedReasons.User
+	}
+
+	data, err := h.dai.UpdateValidatorDashboardArchiving(r.Context(), dashboardId, archivedReason)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.ApiDataResponse[types.VDBPostArchivingReturnData]{
+		Data: *data,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardTotalRocketPool(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboardSlotViz(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	q := r.URL.Query()
 	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	pagingParams := v.checkPagingParams(q)
+
+	groupIds := v.checkExistingGroupIdList(r.URL.Query().Get("group_ids"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-
-	data, err := h.dai.GetValidatorDashboardTotalRocketPool(r.Context(), *dashboardId, pagingParams.search)
+	data, err := h.dai.GetValidatorDashboardSlotViz(r.Context(), *dashboardId, groupIds)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardTotalRocketPoolResponse{
-		Data: *data,
+	response := types.GetValidatorDashboardSlotVizResponse{
+		Data: data,
 	}
+
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardNodeRocketPool(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboardSummary(w http.ResponseWriter, r *http.Request) {
 	var v validationError
-	vars := mux.Vars(r)
-	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	// support ENS names ?
-	nodeAddress := v.checkAddress(vars["node_address"])
+	q := r.URL.Query()
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBSummaryColumn](&v, q.Get("sort"))
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+
+	period := checkEnum[enums.TimePeriod](&v, q.Get("period"), "period")
+	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
+	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
+	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, err := h.dai.GetValidatorDashboardNodeRocketPool(r.Context(), *dashboardId, nodeAddress)
+	data, paging, err := h.dai.GetValidatorDashboardSummary(r.Context(), *dashboardId, period, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardNodeRocketPoolResponse{
-		Data: *data,
+	response := types.GetValidatorDashboardSummaryResponse{
+		Data:   data,
+		Paging: *paging,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetValidatorDashboardRocketPoolMinipools(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboardGroupSummary(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	vars := mux.Vars(r)
-	q := r.URL.Query()
 	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	q := r.URL.Query()
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	// support ENS names ?
-	nodeAddress := v.checkAddress(vars["node_address"])
-	pagingParams := v.checkPagingParams(q)
-	sort := checkSort[enums.VDBRocketPoolMinipoolsColumn](&v, q.Get("sort"))
+	groupId := v.checkGroupId(vars["group_id"], forbidEmpty)
+	period := checkEnum[enums.TimePeriod](&v, r.URL.Query().Get("period"), "period")
+	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
+	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
+	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, paging, err := h.dai.GetValidatorDashboardRocketPoolMinipools(r.Context(), *dashboardId, nodeAddress, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	data, err := h.dai.GetValidatorDashboardGroupSummary(r.Context(), *dashboardId, groupId, period, protocolModes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardRocketPoolMinipoolsResponse{
-		Data:   data,
-		Paging: *paging,
+	response := types.GetValidatorDashboardGroupSummaryResponse{
+		Data: *data,
 	}
 	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidators(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicGetNetworkValidator(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetValidatorDashboardSummaryChart(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	ctx := r.Context()
+	dashboardId, err := h.handleDashboardId(ctx, mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	groupIds := v.checkGroupIdList(q.Get("group_ids"))
+	efficiencyType := checkEnum[enums.VDBSummaryChartEfficiencyType](&v, q.Get("efficiency_type"), "efficiency_type")
 
-func (h *HandlerService) PublicGetNetworkValidatorDuties(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
+	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(ctx, dashboardId, aggregation)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	afterTs, beforeTs := v.checkTimestamps(r, chartLimits)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if afterTs < chartLimits.MinAllowedTs || beforeTs < chartLimits.MinAllowedTs {
+		returnConflict(w, r, fmt.Errorf("requested time range is too old, minimum timestamp for dashboard owner's premium subscription for this aggregation is %v", chartLimits.MinAllowedTs))
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkAddressValidators(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetValidatorDashboardSummaryChart(ctx, *dashboardId, groupIds, efficiencyType, aggregation, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardSummaryChartResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkWithdrawalCredentialValidators(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetValidatorDashboardSummaryValidators(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	groupId := v.checkGroupId(r.URL.Query().Get("group_id"), allowEmpty)
+	q := r.URL.Query()
+	duty := checkEnum[enums.ValidatorDuty](&v, q.Get("duty"), "duty")
+	period := checkEnum[enums.TimePeriod](&v, q.Get("period"), "period")
+	// allowed periods are: all_time, last_30d, last_7d, last_24h, last_1h
+	allowedPeriods := []enums.TimePeriod{enums.TimePeriods.AllTime, enums.TimePeriods.Last30d, enums.TimePeriods.Last7d, enums.TimePeriods.Last24h, enums.TimePeriods.Last1h}
+	checkEnumIsAllowed(&v, period, allowedPeriods, "period")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkValidatorStatuses(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+	// get indices based on duty
+	var indices interface{}
+	duties := enums.ValidatorDuties
+	switch duty {
+	case duties.None:
+		indices, err = h.dai.GetValidatorDashboardSummaryValidators(r.Context(), *dashboardId, groupId)
+	case duties.Sync:
+		indices, err = h.dai.GetValidatorDashboardSyncSummaryValidators(r.Context(), *dashboardId, groupId, period)
+	case duties.Slashed:
+		indices, err = h.dai.GetValidatorDashboardSlashingsSummaryValidators(r.Context(), *dashboardId, groupId, period)
+	case duties.Proposal:
+		indices, err = h.dai.GetValidatorDashboardProposalSummaryValidators(r.Context(), *dashboardId, groupId, period)
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// map indices to response format
+	data, err := mapVDBIndices(indices)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkValidatorLeaderboard(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+	response := types.GetValidatorDashboardSummaryValidatorsResponse{
+		Data: data,
+	}
 
-func (h *HandlerService) PublicGetNetworkValidatorQueue(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkEpochs(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetValidatorDashboardRewards(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBRewardsColumn](&v, q.Get("sort"))
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkEpoch(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.dai.GetValidatorDashboardRewards(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardRewardsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlocks(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetValidatorDashboardGroupRewards(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	groupId := v.checkGroupId(vars["group_id"], forbidEmpty)
+	epoch := v.checkUint(vars["epoch"], "epoch")
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardGroupRewards(r.Context(), *dashboardId, groupId, epoch, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardGroupRewardsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardRewardsChart(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardRewardsChart(r.Context(), *dashboardId, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardRewardsChartResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardDuties(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
+	epoch := v.checkUint(vars["epoch"], "epoch")
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBDutiesColumn](&v, q.Get("sort"))
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardDuties(r.Context(), *dashboardId, epoch, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardDutiesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardBlocks(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBBlocksColumn](&v, q.Get("sort"))
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardBlocks(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardBlocksResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardHeatmap(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
+	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(r.Context(), dashboardId, aggregation)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	afterTs, beforeTs := v.checkTimestamps(r, chartLimits)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if afterTs < chartLimits.MinAllowedTs || beforeTs < chartLimits.MinAllowedTs {
+		returnConflict(w, r, fmt.Errorf("requested time range is too old, minimum timestamp for dashboard owner's premium subscription for this aggregation is %v", chartLimits.MinAllowedTs))
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardHeatmap(r.Context(), *dashboardId, protocolModes, aggregation, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardHeatmapResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardGroupHeatmap(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	groupId := v.checkExistingGroupId(vars["group_id"])
+	requestedTimestamp := v.checkUint(vars["timestamp"], "timestamp")
+	protocolModes := v.checkProtocolModes(r.URL.Query().Get("modes"))
+	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(r.Context(), dashboardId, aggregation)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if requestedTimestamp < chartLimits.MinAllowedTs || requestedTimestamp > chartLimits.LatestExportedTs {
+		handleErr(w, r, newConflictErr("requested timestamp is outside of allowed chart history for dashboard owner's premium subscription"))
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardGroupHeatmap(r.Context(), *dashboardId, groupId, protocolModes, aggregation, requestedTimestamp)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardGroupHeatmapResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardExecutionLayerDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardElDeposits(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardExecutionLayerDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardConsensusLayerDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardClDeposits(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.GetValidatorDashboardConsensusLayerDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardTotalConsensusLayerDeposits(w http.ResponseWriter, r *http.Request) {
+	var err error
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	data, err := h.dai.GetValidatorDashboardTotalClDeposits(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.GetValidatorDashboardTotalConsensusDepositsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardTotalExecutionLayerDeposits(w http.ResponseWriter, r *http.Request) {
+	var err error
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	data, err := h.dai.GetValidatorDashboardTotalElDeposits(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.GetValidatorDashboardTotalExecutionDepositsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBWithdrawalsColumn](&v, q.Get("sort"))
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardWithdrawals(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardWithdrawalsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardTotalWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(q)
+	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardTotalWithdrawals(r.Context(), *dashboardId, pagingParams.search, protocolModes)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.GetValidatorDashboardTotalWithdrawalsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardRocketPool(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBRocketPoolColumn](&v, q.Get("sort"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardRocketPool(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardRocketPoolResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardTotalRocketPool(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardTotalRocketPool(r.Context(), *dashboardId, pagingParams.search)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardTotalRocketPoolResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardNodeRocketPool(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// support ENS names ?
+	nodeAddress := v.checkAddress(vars["node_address"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardNodeRocketPool(r.Context(), *dashboardId, nodeAddress)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardNodeRocketPoolResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardRocketPoolMinipools(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// support ENS names ?
+	nodeAddress := v.checkAddress(vars["node_address"])
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.VDBRocketPoolMinipoolsColumn](&v, q.Get("sort"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetValidatorDashboardRocketPoolMinipools(r.Context(), *dashboardId, nodeAddress, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardRocketPoolMinipoolsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetValidatorDashboardEthStore(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
+	startDay, endDay, err := h.checkEthStoreRange(r.Context(), &v, q)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetValidatorDashboardEthStoreComparison(r.Context(), *dashboardId, groupId, startDay, endDay)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardEthStoreResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidators(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.NetworkValidatorsColumn](&v, q.Get("sort"))
+	var indexes []types.VDBValidator
+	var publicKeys []string
+	validatorsParam := q.Get("validators")
+	if validatorsParam != "" {
+		indexes, publicKeys = v.checkValidatorList(validatorsParam, forbidEmpty)
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	// nil means all validators of the network
+	var validators []types.VDBValidator
+	if validatorsParam != "" {
+		var err error
+		validators, err = h.dai.GetValidatorsFromSlices(indexes, publicKeys)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+
+	data, paging, err := h.dai.GetNetworkValidators(r.Context(), chainId, validators, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidator(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidator(r.Context(), chainId, validator)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorDuties(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidatorDuties(r.Context(), chainId, validator)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorDutiesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkAddressValidators(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	address := v.checkAddress(vars["address"])
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.NetworkValidatorsColumn](&v, q.Get("sort"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if !strings.HasPrefix(address, "0x") {
+		address = "0x" + address
+	}
+
+	data, paging, err := h.dai.GetNetworkAddressValidators(r.Context(), chainId, address, pagingParams.cursor, *sort, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkWithdrawalCredentialValidators(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	credential := v.checkWithdrawalCredential(vars["credential"])
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.NetworkValidatorsColumn](&v, q.Get("sort"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkWithdrawalCredentialValidators(r.Context(), chainId, credential, pagingParams.cursor, *sort, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorStatuses(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorLeaderboard(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorQueue(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkEpochs(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetEpochs(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEpochsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkEpoch(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	epoch, err := h.resolveEpochParam(&v, vars["epoch"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetEpoch(r.Context(), chainId, epoch)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEpochResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkBlocks(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetBlocks(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBlocksResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkBlock(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetBlockOverview(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockOverviewResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkSlots(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetSlots(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkSlotsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkSlot(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetSlotOverview(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockOverviewResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorBlocks(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkAddressPriorityFeeBlocks(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkAddressProposerRewardBlocks(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkForkedBlocks(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkForkedBlock(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkForkedSlot(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkBlockSizes(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkBlock(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkValidatorAttestations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validators, err := h.resolveValidatorsParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	latestEpoch, err := h.dai.GetLatestFinalizedEpoch()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	startEpoch, endEpoch := v.checkHistoryRange(r.URL.Query(), "start_epoch", "end_epoch", maxValidatorHistoryEpochs, latestEpoch)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidatorsAttestations(r.Context(), chainId, validators, startEpoch, endEpoch)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorAttestationsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkEpochAttestations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	startSlot, endSlot, err := h.resolveEpochSlotRange(&v, vars["epoch"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkAggregatedAttestations(r.Context(), chainId, startSlot, endSlot, nil, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkAggregatedAttestationsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkSlotAttestations(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetSlotAttestations(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockAttestationsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkSlotVotes(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetSlotVotes(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockVotesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkBlockAttestations(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetBlockAttestations(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockAttestationsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkBlockVotes(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetBlockVotes(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockVotesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkAggregatedAttestations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	// optional filters, slot takes precedence over epoch
+	var startSlot, endSlot, committeeIndex *uint64
+	if epochParam := q.Get("epoch"); epochParam != "" {
+		epoch := v.checkUint(epochParam, "epoch")
+		slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+		start, end := epoch*slotsPerEpoch, (epoch+1)*slotsPerEpoch-1
+		startSlot, endSlot = &start, &end
+	}
+	if slotParam := q.Get("slot"); slotParam != "" {
+		slot := v.checkUint(slotParam, "slot")
+		startSlot, endSlot = &slot, &slot
+	}
+	if committeeParam := q.Get("committee_index"); committeeParam != "" {
+		committee := v.checkUint(committeeParam, "committee_index")
+		committeeIndex = &committee
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkAggregatedAttestations(r.Context(), chainId, startSlot, endSlot, committeeIndex, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkAggregatedAttestationsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkEthStore(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	day, err := h.resolveEthStoreDayParam(r.Context(), &v, vars["day"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetEthStoreDay(r.Context(), chainId, day)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEthStoreResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkEthStoreDays(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	startDay, endDay, err := h.checkEthStoreRange(r.Context(), &v, r.URL.Query())
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetEthStoreDays(r.Context(), chainId, startDay, endDay)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEthStoreDaysResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorEthStore(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validators, err := h.resolveValidatorsParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	startDay, endDay, err := h.checkEthStoreRange(r.Context(), &v, r.URL.Query())
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetEthStoreComparison(r.Context(), chainId, validators, startDay, endDay)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorEthStoreResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorRewardHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validators, err := h.resolveValidatorsParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	latestEpoch, err := h.dai.GetLatestFinalizedEpoch()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	historyRange := v.checkValidatorHistoryRange(r.URL.Query(), latestEpoch)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidatorsRewardHistory(r.Context(), chainId, validators, historyRange.aggregation, historyRange.startEpoch, historyRange.endEpoch)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorRewardHistoryResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validators, err := h.resolveValidatorsParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	latestEpoch, err := h.dai.GetLatestFinalizedEpoch()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	historyRange := v.checkValidatorHistoryRange(r.URL.Query(), latestEpoch)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidatorsBalanceHistory(r.Context(), chainId, validators, historyRange.aggregation, historyRange.startEpoch, historyRange.endEpoch)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorBalanceHistoryResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorPerformanceHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validators, err := h.resolveValidatorsParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	latestEpoch, err := h.dai.GetLatestFinalizedEpoch()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	historyRange := v.checkValidatorHistoryRange(r.URL.Query(), latestEpoch)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkValidatorsPerformanceHistory(r.Context(), chainId, validators, historyRange.aggregation, historyRange.startEpoch, historyRange.endEpoch)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorPerformanceHistoryResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkSlashings(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	var filter types.NetworkOperationsFilter
+	data, paging, err := h.dai.GetNetworkSlashings(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkSlashingsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkValidatorSlashings returns the slashings in which the validator was either slashed or the slasher
+func (h *HandlerService) PublicGetNetworkValidatorSlashings(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{Validator: &validator}
+	data, paging, err := h.dai.GetNetworkSlashings(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkSlashingsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// the optional address filter matches both the sender of the deposit transaction and the depositor
+func (h *HandlerService) PublicGetNetworkDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	filter := v.checkOperationsFilter(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkDeposits(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// deposits are identified by the public key, as they may precede the assignment of the validator index
+	validatorData, err := h.dai.GetNetworkValidator(r.Context(), chainId, validator)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{PublicKey: decodeHexParam(string(validatorData.PublicKey))}
+	data, paging, err := h.dai.GetNetworkDeposits(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkTransactionDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	txHash := v.checkRegex(reHash, vars["hash"], "hash")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{TxHash: decodeHexParam(txHash)}
+	data, paging, err := h.dai.GetNetworkDeposits(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkSlots(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	filter := v.checkOperationsFilter(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkWithdrawals(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkWithdrawalsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkSlot(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkSlotWithdrawals(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetSlotWithdrawals(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockWtihdrawalsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorBlocks(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkBlockWithdrawals(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetBlockWithdrawals(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockWtihdrawalsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressPriorityFeeBlocks(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkValidatorWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{Validator: &validator}
+	data, paging, err := h.dai.GetNetworkWithdrawals(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkWithdrawalsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressProposerRewardBlocks(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkWithdrawalCredentialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	credential := v.checkWithdrawalCredential(vars["credential"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{Credential: decodeHexParam(credential)}
+	data, paging, err := h.dai.GetNetworkWithdrawals(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkWithdrawalsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkForkedBlocks(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	var filter types.NetworkOperationsFilter
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetNetworkVoluntaryExits(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkVoluntaryExitsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkForkedBlock(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkEpochVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	startSlot, endSlot, err := h.resolveEpochSlotRange(&v, vars["epoch"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	filter := types.NetworkOperationsFilter{StartSlot: startSlot, EndSlot: endSlot}
+	data, paging, err := h.dai.GetNetworkVoluntaryExits(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkVoluntaryExitsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkForkedSlot(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkSlotVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetSlotVoluntaryExits(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockVoluntaryExitsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockSizes(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.dai.GetBlockVoluntaryExits(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockVoluntaryExitsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorAttestations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkAddressBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	address := decodeHexParam(v.checkAddress(vars["address"]))
+	var token []byte
+	if tokenParam := q.Get("token"); tokenParam != "" {
+		token = decodeHexParam(v.checkRegex(reEthereumAddress, tokenParam, "token"))
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	latestDay, err := h.getLatestDay()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	startDay, endDay := v.checkHistoryRange(q, "start_day", "end_day", maxAddressHistoryDays, latestDay)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkAddressBalanceHistory(r.Context(), chainId, address, token, startDay, endDay)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkAddressBalanceHistoryResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkEpochAttestations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkAddressTokenSupplyHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	token := decodeHexParam(v.checkAddress(vars["address"]))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	latestDay, err := h.getLatestDay()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	startDay, endDay := v.checkHistoryRange(q, "start_day", "end_day", maxAddressHistoryDays, latestDay)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkTokenSupplyHistory(r.Context(), chainId, token, startDay, endDay)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkTokenSupplyHistoryResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkSlotAttestations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkAddressEventLogs(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	address := decodeHexParam(v.checkAddress(vars["address"]))
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSlotVotes(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.dai.GetNetworkAddressEventLogs(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEventLogsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockAttestations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkTransactions(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlockVotes(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.dai.GetNetworkTransactions(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAggregatedAttestations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkTransaction(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	hash := decodeHexParam(v.checkRegex(reHash, vars["hash"], "hash"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkEthStore(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetNetworkTransaction(r.Context(), chainId, hash)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkTransactionResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorRewardHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkAddressTransactions(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	address := decodeHexParam(v.checkAddress(vars["address"]))
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkValidatorBalanceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.dai.GetNetworkAddressTransactions(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorPerformanceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkSlotTransactions(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSlashings(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetSlotTransactions(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockTransactionsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorSlashings(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkBlockTransactions(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkDeposits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetBlockTransactions(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockTransactionsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorDeposits(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkBlockBlobs(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkTransactionDeposits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// the optional address filter matches the new withdrawal address
+func (h *HandlerService) PublicGetNetworkBlsChanges(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(q)
+	filter := v.checkOperationsFilter(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkWithdrawals(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.dai.GetNetworkBlsChanges(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBlsChangesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkSlotWithdrawals(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-func (h *HandlerService) PublicGetNetworkBlockWithdrawals(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkEpochBlsChanges(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	startSlot, endSlot, err := h.resolveEpochSlotRange(&v, vars["epoch"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkValidatorWithdrawals(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	filter := types.NetworkOperationsFilter{StartSlot: startSlot, EndSlot: endSlot}
+	data, paging, err := h.dai.GetNetworkBlsChanges(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBlsChangesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkWithdrawalCredentialWithdrawals(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkSlotBlsChanges(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetSlotBlsChanges(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockBlsChangesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkEpochVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSlotVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetBlockBlsChanges(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetBlockBlsChangesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkValidatorBlsChanges(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	validator, err := h.resolveValidatorParam(vars["validator"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkAddressBalanceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	filter := types.NetworkOperationsFilter{Validator: &validator}
+	data, paging, err := h.dai.GetNetworkBlsChanges(r.Context(), chainId, filter, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBlsChangesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressTokenSupplyHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+// the address parameter accepts a comma separated list of addresses to resolve them at once
+func (h *HandlerService) PublicGetNetworkAddressEns(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(ethereum)
+	addressList := splitParameters(mux.Vars(r)["address"], ',')
+	if len(addressList) > maxEnsAddresses {
+		v.add("address", fmt.Sprintf("at most %d addresses can be resolved at once", maxEnsAddresses))
+	}
+	addresses := make([][]byte, 0, len(addressList))
+	for _, address := range addressList {
+		addresses = append(addresses, decodeHexParam(v.checkAddress(address)))
+	}
+	if len(addresses) == 0 {
+		v.add("address", "must not be empty")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkAddressesEns(r.Context(), chainId, addresses)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkAddressEnsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressEventLogs(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (h *HandlerService) PublicGetNetworkEns(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(ethereum)
+	name := strings.ToLower(v.checkRegex(reEnsName, mux.Vars(r)["ens_name"], "ens_name"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkEnsName(r.Context(), chainId, name)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkEnsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkTransactions(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkBatches(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkTransaction(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkLayer2ToLayer1Transactions(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressTransactions(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkLayer1ToLayer2Transactions(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkSlotTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// the data is validated and stored, forwarding it to the nodes happens asynchronously and can be polled via the returned id
+func (h *HandlerService) PublicPostNetworkBroadcasts(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	type request struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	switch req.Type {
+	case "transaction", "voluntary_exit", "bls_changes", "attestations":
+	default:
+		v.add("type", fmt.Sprintf("given value '%s' is not a valid broadcast type", req.Type))
+	}
+	if len(req.Data) == 0 {
+		v.add("data", "must not be empty")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlockTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.CreateNetworkBroadcast(r.Context(), chainId, req.Type, req.Data)
+	var userErr commonTypes.CreateNodeJobUserError
+	if errors.As(err, &userErr) {
+		handleErr(w, r, newBadRequestErr("%s", userErr.Message))
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.PostNetworkBroadcastsResponse{
+		Data: *data,
+	}
+	returnCreated(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockBlobs(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkBroadcast(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	id := v.checkRegex(reBroadcastId, vars["broadcast_id"], "broadcast_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetNetworkBroadcast(r.Context(), chainId, id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBroadcastResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkEpochBlsChanges(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetEthPriceHistory(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkSlotBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkGasNow(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetNetworkGasNow(r.Context(), chainId, true)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkGasNowResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkGasNowStream sends the gas price prediction as server-sent events, a new event is sent for every new block.
+// Streams end after gasNowStreamMaxDuration and the number of concurrent streams per client is limited.
+const (
+	// streams are closed after this duration, clients are expected to reconnect
+	gasNowStreamMaxDuration = time.Hour
+	gasNowStreamsPerClient  = 5
+)
 
-func (h *HandlerService) PublicGetNetworkAddressEns(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+var gasNowStreams = newStreamLimiter(gasNowStreamsPerClient)
 
-func (h *HandlerService) PublicGetNetworkEns(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+// streamLimiter limits the number of concurrent streams per client
+type streamLimiter struct {
+	mu     sync.Mutex
+	active map[string]int
+	max    int
 }
 
-func (h *HandlerService) PublicGetNetworkBatches(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func newStreamLimiter(max int) *streamLimiter {
+	return &streamLimiter{active: make(map[string]int), max: max}
 }
 
-func (h *HandlerService) PublicGetNetworkLayer2ToLayer1Transactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+// acquire returns false if the client already has the maximum number of open streams
+func (l *streamLimiter) acquire(client string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.active[client] >= l.max {
+		return false
+	}
+	l.active[client]++
+	return true
 }
 
-func (h *HandlerService) PublicGetNetworkLayer1ToLayer2Transactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+func (l *streamLimiter) release(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active[client]--
+	if l.active[client] <= 0 {
+		delete(l.active, client)
+	}
 }
 
-func (h *HandlerService) PublicPostNetworkBroadcasts(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
-}
+func (h *HandlerService) PublicGetNetworkGasNowStream(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	client := getClientIp(r, utils.Config.TrustedProxies)
+	if !gasNowStreams.acquire(client) {
+		handleErr(w, r, newTooManyRequestsErr("at most %d concurrent gas now streams are allowed", gasNowStreamsPerClient))
+		return
+	}
+	defer gasNowStreams.release(client)
 
-func (h *HandlerService) PublicGetEthPriceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+	rc := http.NewResponseController(w)
+	// the server write timeout would otherwise end the stream
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
 
-func (h *HandlerService) PublicGetNetworkGasNow(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	ctx, cancel := context.WithTimeout(r.Context(), gasNowStreamMaxDuration)
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	lastBlock := uint64(0)
+	for {
+		data, err := h.dai.GetNetworkGasNow(ctx, chainId, false)
+		switch {
+		case errors.Is(err, services.ErrWaiting):
+			// no prediction available yet, keep waiting
+		case err != nil:
+			log.Error(err, "error retrieving gas now data for stream", 0)
+			return
+		case data.Block != lastBlock:
+			payload, err := json.Marshal(data)
+			if err != nil {
+				log.Error(err, "error encoding gas now data for stream", 0)
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: gasnow\ndata: %s\n\n", payload); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			lastBlock = data.Block
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 func (h *HandlerService) PublicGetNetworkAverageGasLimitHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	aggregation, afterTs, beforeTs := v.checkGasChartRange(r)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkGasLimitHistory(r.Context(), chainId, aggregation, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkGasLimitHistoryResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetNetworkGasUsedHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	chainId := v.checkServedNetworkParameter(mux.Vars(r)["network"])
+	aggregation, afterTs, beforeTs := v.checkGasChartRange(r)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetNetworkGasUsedHistory(r.Context(), chainId, aggregation, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkGasUsedHistoryResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetRocketPool(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.dai.GetRocketPoolNetwork(r.Context())
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetRocketPoolResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetRocketPoolNodes(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	q := r.URL.Query()
+	pagingParams := v.checkPagingParams(q)
+	sort := checkSort[enums.RocketPoolNodesColumn](&v, q.Get("sort"))
+	filter := v.checkRocketPoolNodesFilter(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetRocketPoolNodes(r.Context(), pagingParams.cursor, *sort, filter, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetRocketPoolNodesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetRocketPoolMinipools(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	q := r.URL.Query()
+	pagingParams := v.checkPagingParams(q)
+	filter := v.checkRocketPoolMinipoolsFilter(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetRocketPoolMinipools(r.Context(), pagingParams.cursor, filter, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetRocketPoolMinipoolsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetNetworkSyncCommittee(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkServedNetworkParameter(vars["network"])
+	period, err := h.resolveSyncPeriodParam(&v, vars["period"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetSyncCommittee(r.Context(), chainId, period)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkSyncCommitteeResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetMultisigSafe(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	address := decodeHexParam(v.checkAddress(mux.Vars(r)["address"]))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetMultisigSafe(r.Context(), address)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigSafeResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetMultisigSafeTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	address := decodeHexParam(v.checkAddress(mux.Vars(r)["address"]))
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.dai.GetMultisigSafeTransactions(r.Context(), address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigSafeTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
+// the hash is the safe tx hash, not the hash of the execution transaction.
+// Only the safe that approved the hash can be asked for its confirmations, so it is required as the `safe` query parameter
 func (h *HandlerService) PublicGetMultisigTransactionConfirmations(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	address := decodeHexParam(v.checkRegex(reEthereumAddress, r.URL.Query().Get("safe"), "safe"))
+	safeTxHash := decodeHexParam(v.checkRegex(reHash, mux.Vars(r)["hash"], "hash"))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.dai.GetMultisigTransactionConfirmations(r.Context(), address, safeTxHash)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigTransactionConfirmationsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
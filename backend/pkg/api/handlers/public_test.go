@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamLimiter(t *testing.T) {
+	l := newStreamLimiter(2)
+	assert.True(t, l.acquire("1.1.1.1"))
+	assert.True(t, l.acquire("1.1.1.1"))
+	assert.False(t, l.acquire("1.1.1.1"), "third concurrent stream of the same client")
+	assert.True(t, l.acquire("2.2.2.2"), "other clients are not affected")
+
+	l.release("1.1.1.1")
+	assert.True(t, l.acquire("1.1.1.1"), "closed streams free a slot")
+
+	l.release("1.1.1.1")
+	l.release("1.1.1.1")
+	l.release("2.2.2.2")
+	assert.Empty(t, l.active)
+}
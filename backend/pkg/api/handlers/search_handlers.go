@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,22 @@ const (
 	validatorsByWithdrawalAddress    searchTypeKey = "validators_by_withdrawal_address"
 	validatorsByWithdrawalEns        searchTypeKey = "validators_by_withdrawal_ens_name"
 	validatorsByGraffiti             searchTypeKey = "validators_by_graffiti"
+	slotByNumber                     searchTypeKey = "slot"
+	slotByBlockRoot                  searchTypeKey = "slot_by_block_root"
+	epochByNumber                    searchTypeKey = "epoch"
+	blockByNumber                    searchTypeKey = "block"
+	blockByHash                      searchTypeKey = "block_by_hash"
+	transactionByHash                searchTypeKey = "transaction"
+	ethereumAddress                  searchTypeKey = "address"
+	tokenAddress                     searchTypeKey = "token"
+	ensNameResolution                searchTypeKey = "ens_name"
+)
+
+const (
+	searchModeExact  = "exact"
+	searchModePrefix = "prefix"
+
+	maxSearchResultsPerType = 10
 )
 
 // source of truth for all possible search types and their regex
@@ -39,6 +56,43 @@ var searchTypeToRegex = map[searchTypeKey]*regexp.Regexp{
 	validatorsByWithdrawalAddress:    reEthereumAddress,
 	validatorsByWithdrawalEns:        reEnsName,
 	validatorsByGraffiti:             reNonEmpty,
+	slotByNumber:                     reInteger,
+	slotByBlockRoot:                  reHash,
+	epochByNumber:                    reInteger,
+	blockByNumber:                    reInteger,
+	blockByHash:                      reHash,
+	transactionByHash:                reHash,
+	ethereumAddress:                  reEthereumAddress,
+	tokenAddress:                     reEthereumAddress,
+	ensNameResolution:                reEnsName,
+}
+
+// search types that support autocompletion, the regex replaces the one from searchTypeToRegex in prefix mode
+var searchTypeToPrefixRegex = map[searchTypeKey]*regexp.Regexp{
+	ethereumAddress:   reEthereumAddressPrefix,
+	tokenAddress:      reEthereumAddressPrefix,
+	ensNameResolution: reEnsNamePrefix,
+}
+
+// results are ranked by exact match first, then by the position of their type in this list
+var searchTypeRanking = []searchTypeKey{
+	validatorByIndex,
+	validatorByPublicKey,
+	transactionByHash,
+	blockByNumber,
+	blockByHash,
+	slotByNumber,
+	slotByBlockRoot,
+	epochByNumber,
+	ethereumAddress,
+	tokenAddress,
+	ensNameResolution,
+	validatorsByDepositAddress,
+	validatorsByDepositEnsName,
+	validatorsByWithdrawalCredential,
+	validatorsByWithdrawalAddress,
+	validatorsByWithdrawalEns,
+	validatorsByGraffiti,
 }
 
 // --------------------------------------
@@ -50,6 +104,7 @@ func (h *HandlerService) InternalPostSearch(w http.ResponseWriter, r *http.Reque
 		Input    string          `json:"input"`
 		Networks []intOrString   `json:"networks,omitempty"`
 		Types    []searchTypeKey `json:"types,omitempty"`
+		Mode     string          `json:"mode,omitempty"`
 	}{}
 	if err := v.checkBody(&req, r); err != nil {
 		handleErr(w, r, err)
@@ -58,10 +113,17 @@ func (h *HandlerService) InternalPostSearch(w http.ResponseWriter, r *http.Reque
 	// if the input slices are empty, the sets will contain all possible values
 	chainIdSet := v.checkNetworkSlice(req.Networks)
 	searchTypeSet := v.checkSearchTypes(req.Types)
+	isPrefixSearch := v.checkSearchMode(req.Mode)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
+	// networks not served by this instance have no data to search
+	for chainId := range chainIdSet {
+		if !isServedNetwork(chainId) {
+			delete(chainIdSet, chainId)
+		}
+	}
 
 	g, ctx := errgroup.WithContext(r.Context())
 	g.SetLimit(20)
@@ -70,22 +132,29 @@ func (h *HandlerService) InternalPostSearch(w http.ResponseWriter, r *http.Reque
 	// iterate over all combinations of search types and networks
 	for searchType := range searchTypeSet {
 		// check if input matches the regex for the search type
-		if !searchTypeToRegex[searchType].MatchString(req.Input) {
+		re := searchTypeToRegex[searchType]
+		prefixRe, supportsPrefix := searchTypeToPrefixRegex[searchType]
+		isPrefix := isPrefixSearch && supportsPrefix
+		if isPrefix {
+			re = prefixRe
+		}
+		if !re.MatchString(req.Input) {
 			continue
 		}
 		for chainId := range chainIdSet {
 			chainId := chainId
 			searchType := searchType
 			g.Go(func() error {
-				searchResult, err := h.handleSearch(ctx, req.Input, searchType, chainId)
+				searchResults, err := h.handleSearch(ctx, req.Input, searchType, chainId, isPrefix)
 				if err != nil {
 					if errors.Is(err, dataaccess.ErrNotFound) {
 						return nil
 					}
 					return err
 				}
-				if searchResult != nil { // if the search result is nil, the input didn't match the search type
-					searchResultChan <- *searchResult
+				// if the search results are empty, the input didn't match the search type
+				for _, searchResult := range searchResults {
+					searchResultChan <- searchResult
 				}
 				return nil
 			})
@@ -107,6 +176,7 @@ func (h *HandlerService) InternalPostSearch(w http.ResponseWriter, r *http.Reque
 		handleErr(w, r, err)
 		return
 	}
+	rankSearchResults(req.Input, data)
 
 	response := types.InternalPostSearchResponse{
 		Data: data,
@@ -117,7 +187,22 @@ func (h *HandlerService) InternalPostSearch(w http.ResponseWriter, r *http.Reque
 // --------------------------------------
 //	 Search Helper Functions
 
-func (h *HandlerService) handleSearch(ctx context.Context, input string, searchType searchTypeKey, chainId uint64) (*types.SearchResult, error) {
+func (h *HandlerService) handleSearch(ctx context.Context, input string, searchType searchTypeKey, chainId uint64, isPrefix bool) ([]types.SearchResult, error) {
+	// types that can return multiple results
+	switch searchType {
+	case ethereumAddress, tokenAddress:
+		return h.handleSearchAddresses(ctx, input, searchType, chainId, isPrefix)
+	case ensNameResolution:
+		return h.handleSearchEnsNames(ctx, input, chainId, isPrefix)
+	}
+	result, err := h.handleSearchSingle(ctx, input, searchType, chainId)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return []types.SearchResult{*result}, nil
+}
+
+func (h *HandlerService) handleSearchSingle(ctx context.Context, input string, searchType searchTypeKey, chainId uint64) (*types.SearchResult, error) {
 	select {
 	case <-ctx.Done():
 		return nil, nil
@@ -139,6 +224,18 @@ func (h *HandlerService) handleSearch(ctx context.Context, input string, searchT
 			return h.handleSearchValidatorsByWithdrawalEnsName(ctx, input, chainId)
 		case validatorsByGraffiti:
 			return h.handleSearchValidatorsByGraffiti(ctx, input, chainId)
+		case slotByNumber:
+			return h.handleSearchSlot(ctx, input, chainId)
+		case slotByBlockRoot:
+			return h.handleSearchSlotByBlockRoot(ctx, input, chainId)
+		case epochByNumber:
+			return h.handleSearchEpoch(ctx, input, chainId)
+		case blockByNumber:
+			return h.handleSearchBlock(ctx, input, chainId)
+		case blockByHash:
+			return h.handleSearchBlockByHash(ctx, input, chainId)
+		case transactionByHash:
+			return h.handleSearchTransaction(ctx, input, chainId)
 		default:
 			return nil, errors.New("invalid search type")
 		}
@@ -322,6 +419,242 @@ func (h *HandlerService) handleSearchValidatorsByGraffiti(ctx context.Context, i
 	}
 }
 
+func (h *HandlerService) handleSearchSlot(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		slot, err := strconv.ParseUint(input, 10, 64)
+		if err != nil {
+			// input should've been checked by the regex before, this should never happen
+			return nil, err
+		}
+		result, err := h.dai.GetSearchSlot(ctx, chainId, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:      string(slotByNumber),
+			ChainId:   chainId,
+			HashValue: "0x" + hex.EncodeToString(result.BlockRoot),
+			NumValue:  &result.Slot,
+		}, nil
+	}
+}
+
+func (h *HandlerService) handleSearchSlotByBlockRoot(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		blockRoot, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		result, err := h.dai.GetSearchSlotByBlockRoot(ctx, chainId, blockRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:      string(slotByBlockRoot),
+			ChainId:   chainId,
+			HashValue: "0x" + hex.EncodeToString(result.BlockRoot),
+			NumValue:  &result.Slot,
+		}, nil
+	}
+}
+
+func (h *HandlerService) handleSearchEpoch(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		epoch, err := strconv.ParseUint(input, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		result, err := h.dai.GetSearchEpoch(ctx, chainId, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:     string(epochByNumber),
+			ChainId:  chainId,
+			NumValue: &result.Epoch,
+		}, nil
+	}
+}
+
+func (h *HandlerService) handleSearchBlock(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		number, err := strconv.ParseUint(input, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		result, err := h.dai.GetSearchBlock(ctx, chainId, number)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:      string(blockByNumber),
+			ChainId:   chainId,
+			HashValue: "0x" + hex.EncodeToString(result.Hash),
+			NumValue:  &result.Number,
+		}, nil
+	}
+}
+
+func (h *HandlerService) handleSearchBlockByHash(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		hash, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		result, err := h.dai.GetSearchBlockByHash(ctx, chainId, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:      string(blockByHash),
+			ChainId:   chainId,
+			HashValue: "0x" + hex.EncodeToString(result.Hash),
+			NumValue:  &result.Number,
+		}, nil
+	}
+}
+
+func (h *HandlerService) handleSearchTransaction(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		hash, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		result, err := h.dai.GetSearchTransaction(ctx, chainId, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SearchResult{
+			Type:      string(transactionByHash),
+			ChainId:   chainId,
+			HashValue: "0x" + hex.EncodeToString(result.Hash),
+			NumValue:  &result.BlockNumber,
+		}, nil
+	}
+}
+
+// address and token results are served by the same lookup, tokens are addresses with token metadata
+func (h *HandlerService) handleSearchAddresses(ctx context.Context, input string, searchType searchTypeKey, chainId uint64, isPrefix bool) ([]types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		limit := uint64(1)
+		if isPrefix {
+			limit = maxSearchResultsPerType
+		}
+		addresses, err := h.dai.GetSearchAddresses(ctx, chainId, strings.TrimPrefix(input, "0x"), limit)
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]types.SearchResult, 0, len(addresses))
+		for _, a := range addresses {
+			if a.IsToken != (searchType == tokenAddress) {
+				continue
+			}
+			results = append(results, types.SearchResult{
+				Type:      string(searchType),
+				ChainId:   chainId,
+				HashValue: "0x" + hex.EncodeToString(a.Address),
+				StrValue:  a.Name,
+			})
+		}
+		return results, nil
+	}
+}
+
+func (h *HandlerService) handleSearchEnsNames(ctx context.Context, input string, chainId uint64, isPrefix bool) ([]types.SearchResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	default:
+		// exact matches are always returned first, so a single result is enough in exact mode
+		limit := uint64(1)
+		if isPrefix {
+			limit = maxSearchResultsPerType
+		}
+		ensNames, err := h.dai.GetSearchEnsNames(ctx, chainId, input, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]types.SearchResult, 0, len(ensNames))
+		for _, e := range ensNames {
+			if !isPrefix && !strings.EqualFold(e.EnsName, input) {
+				continue
+			}
+			results = append(results, types.SearchResult{
+				Type:      string(ensNameResolution),
+				ChainId:   chainId,
+				StrValue:  e.EnsName,
+				HashValue: "0x" + hex.EncodeToString(e.Address),
+			})
+		}
+		return results, nil
+	}
+}
+
+// sorts results so that exact matches come first, followed by the type ranking and the chain id
+func rankSearchResults(input string, results []types.SearchResult) {
+	typeRank := make(map[string]int, len(searchTypeRanking))
+	for i, t := range searchTypeRanking {
+		typeRank[string(t)] = i
+	}
+	slices.SortStableFunc(results, func(a, b types.SearchResult) int {
+		aExact, bExact := isExactSearchMatch(input, a), isExactSearchMatch(input, b)
+		if aExact != bExact {
+			if aExact {
+				return -1
+			}
+			return 1
+		}
+		if typeRank[a.Type] != typeRank[b.Type] {
+			return typeRank[a.Type] - typeRank[b.Type]
+		}
+		if a.ChainId != b.ChainId {
+			if a.ChainId < b.ChainId {
+				return -1
+			}
+			return 1
+		}
+		// keep prefix results deterministic, shorter values are closer to the input
+		return len(a.StrValue+a.HashValue) - len(b.StrValue+b.HashValue)
+	})
+}
+
+func isExactSearchMatch(input string, result types.SearchResult) bool {
+	input = strings.TrimPrefix(strings.ToLower(input), "0x")
+	if strings.TrimPrefix(strings.ToLower(result.HashValue), "0x") == input || strings.ToLower(result.StrValue) == input {
+		return true
+	}
+	return result.NumValue != nil && strconv.FormatUint(*result.NumValue, 10) == input
+}
+
 // --------------------------------------
 //   Input Validation
 
@@ -367,3 +700,16 @@ func (v *validationError) checkSearchTypes(types []searchTypeKey) map[searchType
 	}
 	return typeSet
 }
+
+// returns true if the prefix mode was requested, exact mode is the default
+func (v *validationError) checkSearchMode(mode string) bool {
+	switch mode {
+	case "", searchModeExact:
+		return false
+	case searchModePrefix:
+		return true
+	default:
+		v.add("mode", fmt.Sprintf("invalid search mode '%s', allowed values are '%s' and '%s'", mode, searchModeExact, searchModePrefix))
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsExactSearchMatch(t *testing.T) {
+	num := uint64(1234)
+	assert.True(t, isExactSearchMatch("1234", types.SearchResult{NumValue: &num}))
+	assert.False(t, isExactSearchMatch("123", types.SearchResult{NumValue: &num}))
+	assert.True(t, isExactSearchMatch("0xABCD", types.SearchResult{HashValue: "0xabcd"}), "hashes match case insensitive and with or without 0x")
+	assert.True(t, isExactSearchMatch("abcd", types.SearchResult{HashValue: "0xabcd"}))
+	assert.False(t, isExactSearchMatch("abc", types.SearchResult{HashValue: "0xabcd"}))
+	assert.True(t, isExactSearchMatch("Vitalik.eth", types.SearchResult{StrValue: "vitalik.eth"}))
+	assert.False(t, isExactSearchMatch("vitalik", types.SearchResult{StrValue: "vitalik.eth"}))
+}
+
+func TestRankSearchResults(t *testing.T) {
+	num := uint64(1234)
+	results := []types.SearchResult{
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234abc.eth"},
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234a.eth"},
+		{Type: string(epochByNumber), ChainId: 17000, NumValue: &num},
+		{Type: string(epochByNumber), ChainId: 1, NumValue: &num},
+		{Type: string(validatorByIndex), ChainId: 1, NumValue: &num},
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234"},
+	}
+	rankSearchResults("1234", results)
+
+	assert.Equal(t, []types.SearchResult{
+		// exact matches first, ordered by type ranking and chain id
+		{Type: string(validatorByIndex), ChainId: 1, NumValue: &num},
+		{Type: string(epochByNumber), ChainId: 1, NumValue: &num},
+		{Type: string(epochByNumber), ChainId: 17000, NumValue: &num},
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234"},
+		// prefix matches, shorter values first
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234a.eth"},
+		{Type: string(ensNameResolution), ChainId: 1, StrValue: "1234abc.eth"},
+	}, results)
+}
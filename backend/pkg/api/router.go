@@ -35,8 +35,11 @@ func NewApiRouter(dataAccessor dataaccess.DataAccessor, cfg *types.Config) *mux.
 	handlerService := handlers.NewHandlerService(dataAccessor, sessionManager)
 
 	// store user id in context, if available
-	publicRouter.Use(handlers.GetUserIdStoreMiddleware(handlerService.GetUserIdByApiKey))
-	internalRouter.Use(handlers.GetUserIdStoreMiddleware(handlerService.GetUserIdBySession))
+	publicRouter.Use(handlerService.GetUserIdStoreMiddleware(handlerService.GetUserIdByApiKey))
+	internalRouter.Use(handlerService.GetUserIdStoreMiddleware(handlerService.GetUserIdBySession))
+
+	// the oauth token endpoint is called by third party servers, so it can't be part of the csrf protected internal router
+	apiRouter.HandleFunc("/oauth/token", handlerService.InternalPostOauthToken).Methods(http.MethodPost, http.MethodOptions)
 
 	addRoutes(handlerService, publicRouter, internalRouter, cfg)
 
@@ -86,6 +89,7 @@ func GetCorsMiddleware(allowedHosts []string) func(http.Handler) http.Handler {
 
 func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Router, cfg *types.Config) {
 	addValidatorDashboardRoutes(hs, publicRouter, internalRouter, cfg)
+	addAccountDashboardRoutes(hs, publicRouter, internalRouter, cfg)
 	addNotificationRoutes(hs, publicRouter, internalRouter, cfg.Frontend.Debug)
 	endpoints := []endpoint{
 		{http.MethodGet, "/healthz", hs.PublicGetHealthz, nil},
@@ -95,6 +99,13 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 
 		{http.MethodPost, "/login", nil, hs.InternalPostLogin},
 
+		{http.MethodPost, "/oauth/authorize", nil, hs.InternalPostOauthAuthorize},
+		{http.MethodPost, "/oauth/apps", nil, hs.InternalPostOauthApps},
+		{http.MethodGet, "/oauth/apps", nil, hs.InternalGetOauthApps},
+		{http.MethodDelete, "/oauth/apps/{app_id}", nil, hs.InternalDeleteOauthApp},
+		{http.MethodGet, "/oauth/grants", nil, hs.InternalGetOauthGrants},
+		{http.MethodDelete, "/oauth/grants/{app_id}", nil, hs.InternalDeleteOauthGrant},
+
 		{http.MethodGet, "/mobile/authorize", nil, hs.InternalPostMobileAuthorize},
 		{http.MethodPost, "/mobile/equivalent-exchange", nil, hs.InternalPostMobileEquivalentExchange},
 		{http.MethodPost, "/mobile/purchase", nil, hs.InternalHandleMobilePurchase},
@@ -122,24 +133,14 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodPut, "/users/me/password", nil, hs.InternalPutUserPassword},
 		{http.MethodGet, "/users/me/dashboards", hs.PublicGetUserDashboards, hs.InternalGetUserDashboards},
 		{http.MethodPut, "/users/me/notifications/settings/paired-devices/{client_id}/token", nil, hs.InternalPostUsersMeNotificationSettingsPairedDevicesToken},
+		{http.MethodGet, "/users/me/api-keys", nil, hs.InternalGetApiKeys},
+		{http.MethodPost, "/users/me/api-keys", nil, hs.InternalPostApiKeys},
+		{http.MethodDelete, "/users/me/api-keys/{key_id}", nil, hs.InternalDeleteApiKey},
+		{http.MethodPost, "/users/me/api-keys/{key_id}/rotation", nil, hs.InternalPostApiKeyRotation},
+		{http.MethodGet, "/users/me/api-keys/{key_id}/usage", nil, hs.InternalGetApiKeyUsage},
 
 		{http.MethodPost, "/search", nil, hs.InternalPostSearch},
 
-		{http.MethodPost, "/account-dashboards", hs.PublicPostAccountDashboards, hs.InternalPostAccountDashboards},
-		{http.MethodGet, "/account-dashboards/{dashboard_id}", hs.PublicGetAccountDashboard, hs.InternalGetAccountDashboard},
-		{http.MethodDelete, "/account-dashboards/{dashboard_id}", hs.PublicDeleteAccountDashboard, hs.InternalDeleteAccountDashboard},
-		{http.MethodPost, "/account-dashboards/{dashboard_id}/groups", hs.PublicPostAccountDashboardGroups, hs.InternalPostAccountDashboardGroups},
-		{http.MethodDelete, "/account-dashboards/{dashboard_id}/groups/{group_id}", hs.PublicDeleteAccountDashboardGroups, hs.InternalDeleteAccountDashboardGroups},
-		{http.MethodPost, "/account-dashboards/{dashboard_id}/accounts", hs.PublicPostAccountDashboardAccounts, hs.InternalPostAccountDashboardAccounts},
-		{http.MethodGet, "/account-dashboards/{dashboard_id}/accounts", hs.PublicGetAccountDashboardAccounts, hs.InternalGetAccountDashboardAccounts},
-		{http.MethodDelete, "/account-dashboards/{dashboard_id}/accounts", hs.PublicDeleteAccountDashboardAccounts, hs.InternalDeleteAccountDashboardAccounts},
-		{http.MethodPut, "/account-dashboards/{dashboard_id}/accounts/{address}", hs.PublicPutAccountDashboardAccount, hs.InternalPutAccountDashboardAccount},
-		{http.MethodPost, "/account-dashboards/{dashboard_id}/public-ids", hs.PublicPostAccountDashboardPublicIds, hs.InternalPostAccountDashboardPublicIds},
-		{http.MethodPut, "/account-dashboards/{dashboard_id}/public-ids/{public_id}", hs.PublicPutAccountDashboardPublicId, hs.InternalPutAccountDashboardPublicId},
-		{http.MethodDelete, "/account-dashboards/{dashboard_id}/public-ids/{public_id}", hs.PublicDeleteAccountDashboardPublicId, hs.InternalDeleteAccountDashboardPublicId},
-		{http.MethodGet, "/account-dashboards/{dashboard_id}/transactions", hs.PublicGetAccountDashboardTransactions, hs.InternalGetAccountDashboardTransactions},
-		{http.MethodPut, "/account-dashboards/{dashboard_id}/transactions/settings", hs.PublicPutAccountDashboardTransactionsSettings, hs.InternalPutAccountDashboardTransactionsSettings},
-
 		{http.MethodGet, "/networks/{network}/validators", hs.PublicGetNetworkValidators, nil},
 		{http.MethodGet, "/networks/{network}/validators/{validator}", hs.PublicGetNetworkValidator, nil},
 		{http.MethodGet, "/networks/{network}/validators/{validator}/duties", hs.PublicGetNetworkValidatorDuties, nil},
@@ -174,7 +175,9 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/networks/{network}/blocks/{block}/attestations", hs.PublicGetNetworkBlockAttestations, hs.InternalGetBlockAttestations},
 		{http.MethodGet, "/networks/{network}/aggregated-attestations", hs.PublicGetNetworkAggregatedAttestations, nil},
 
+		{http.MethodGet, "/networks/{network}/ethstore", hs.PublicGetNetworkEthStoreDays, nil},
 		{http.MethodGet, "/networks/{network}/ethstore/{day}", hs.PublicGetNetworkEthStore, nil},
+		{http.MethodGet, "/networks/{network}/validators/{validator}/ethstore", hs.PublicGetNetworkValidatorEthStore, nil},
 		{http.MethodGet, "/networks/{network}/validators/{validator}/reward-history", hs.PublicGetNetworkValidatorRewardHistory, nil},
 		{http.MethodGet, "/networks/{network}/validators/{validator}/balance-history", hs.PublicGetNetworkValidatorBalanceHistory, nil},
 		{http.MethodGet, "/networks/{network}/validators/{validator}/performance-history", hs.PublicGetNetworkValidatorPerformanceHistory, nil},
@@ -208,11 +211,11 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/networks/{network}/blocks/{block}/transactions", hs.PublicGetNetworkBlockTransactions, hs.InternalGetBlockTransactions},
 		{http.MethodGet, "/networks/{network}/blocks/{block}/blobs", hs.PublicGetNetworkBlockBlobs, hs.InternalGetBlockBlobs},
 
-		{http.MethodGet, "/networks/{network}/handlerService-changes", hs.PublicGetNetworkBlsChanges, nil},
-		{http.MethodGet, "/networks/{network}/epochs/{epoch}/handlerService-changes", hs.PublicGetNetworkEpochBlsChanges, nil},
-		{http.MethodGet, "/networks/{network}/slots/{slot}/handlerService-changes", hs.PublicGetNetworkSlotBlsChanges, hs.InternalGetSlotBlsChanges},
-		{http.MethodGet, "/networks/{network}/blocks/{block}/handlerService-changes", hs.PublicGetNetworkBlockBlsChanges, hs.InternalGetBlockBlsChanges},
-		{http.MethodGet, "/networks/{network}/validators/{validator}/handlerService-changes", hs.PublicGetNetworkValidatorBlsChanges, nil},
+		{http.MethodGet, "/networks/{network}/bls-changes", hs.PublicGetNetworkBlsChanges, nil},
+		{http.MethodGet, "/networks/{network}/epochs/{epoch}/bls-changes", hs.PublicGetNetworkEpochBlsChanges, nil},
+		{http.MethodGet, "/networks/{network}/slots/{slot}/bls-changes", hs.PublicGetNetworkSlotBlsChanges, hs.InternalGetSlotBlsChanges},
+		{http.MethodGet, "/networks/{network}/blocks/{block}/bls-changes", hs.PublicGetNetworkBlockBlsChanges, hs.InternalGetBlockBlsChanges},
+		{http.MethodGet, "/networks/{network}/validators/{validator}/bls-changes", hs.PublicGetNetworkValidatorBlsChanges, nil},
 
 		{http.MethodGet, "/networks/ethereum/addresses/{address}/ens", hs.PublicGetNetworkAddressEns, nil},
 		{http.MethodGet, "/networks/ethereum/ens/{ens_name}", hs.PublicGetNetworkEns, nil},
@@ -222,9 +225,11 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/networks/{layer_2_network}/layer2-to-layer1-transactions", hs.PublicGetNetworkLayer2ToLayer1Transactions, nil},
 
 		{http.MethodPost, "/networks/{network}/broadcasts", hs.PublicPostNetworkBroadcasts, nil},
+		{http.MethodGet, "/networks/{network}/broadcasts/{broadcast_id}", hs.PublicGetNetworkBroadcast, nil},
 		{http.MethodGet, "/eth-price-history", hs.PublicGetEthPriceHistory, nil},
 
 		{http.MethodGet, "/networks/{network}/gasnow", hs.PublicGetNetworkGasNow, nil},
+		{http.MethodGet, "/networks/{network}/gasnow/stream", hs.PublicGetNetworkGasNowStream, nil},
 		{http.MethodGet, "/networks/{network}/average-gas-limit-history", hs.PublicGetNetworkAverageGasLimitHistory, nil},
 		{http.MethodGet, "/networks/{network}/gas-used-history", hs.PublicGetNetworkGasUsedHistory, nil},
 
@@ -243,12 +248,16 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 
 func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Router, cfg *types.Config) {
 	vdbPath := "/validator-dashboards"
-	publicRouter.HandleFunc(vdbPath, hs.PublicPostValidatorDashboards).Methods(http.MethodPost, http.MethodOptions)
-	internalRouter.HandleFunc(vdbPath, hs.InternalPostValidatorDashboards).Methods(http.MethodPost, http.MethodOptions)
+	publicRouter.Handle(vdbPath, hs.VDBOauthScopeMiddleware(http.HandlerFunc(hs.PublicPostValidatorDashboards))).Methods(http.MethodPost, http.MethodOptions)
+	internalRouter.Handle(vdbPath, hs.VDBOauthScopeMiddleware(http.HandlerFunc(hs.InternalPostValidatorDashboards))).Methods(http.MethodPost, http.MethodOptions)
 
 	publicDashboardRouter := publicRouter.PathPrefix(vdbPath).Subrouter()
 	internalDashboardRouter := internalRouter.PathPrefix(vdbPath).Subrouter()
 
+	// add middleware to check if oauth access tokens have been granted the required scope
+	publicDashboardRouter.Use(hs.VDBOauthScopeMiddleware)
+	internalDashboardRouter.Use(hs.VDBOauthScopeMiddleware)
+
 	// add middleware to check if user has access to dashboard
 	if !cfg.Frontend.Debug {
 		publicDashboardRouter.Use(hs.VDBAuthMiddleware, hs.ManageViaApiCheckMiddleware)
@@ -304,6 +313,43 @@ func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, inte
 		{http.MethodGet, "/{dashboard_id}/total-rocket-pool", hs.PublicGetValidatorDashboardTotalRocketPool, hs.InternalGetValidatorDashboardTotalRocketPool},
 		{http.MethodGet, "/{dashboard_id}/rocket-pool/{node_address}", hs.PublicGetValidatorDashboardNodeRocketPool, hs.InternalGetValidatorDashboardNodeRocketPool},
 		{http.MethodGet, "/{dashboard_id}/rocket-pool/{node_address}/minipools", hs.PublicGetValidatorDashboardRocketPoolMinipools, hs.InternalGetValidatorDashboardRocketPoolMinipools},
+		{http.MethodGet, "/{dashboard_id}/ethstore", hs.PublicGetValidatorDashboardEthStore, hs.InternalGetValidatorDashboardEthStore},
+	}
+	addEndpointsToRouters(endpoints, publicDashboardRouter, internalDashboardRouter)
+}
+
+func addAccountDashboardRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Router, cfg *types.Config) {
+	adbPath := "/account-dashboards"
+	publicRouter.Handle(adbPath, hs.ADBOauthScopeMiddleware(http.HandlerFunc(hs.PublicPostAccountDashboards))).Methods(http.MethodPost, http.MethodOptions)
+	internalRouter.Handle(adbPath, hs.ADBOauthScopeMiddleware(http.HandlerFunc(hs.InternalPostAccountDashboards))).Methods(http.MethodPost, http.MethodOptions)
+
+	publicDashboardRouter := publicRouter.PathPrefix(adbPath).Subrouter()
+	internalDashboardRouter := internalRouter.PathPrefix(adbPath).Subrouter()
+
+	// add middleware to check if oauth access tokens have been granted the required scope
+	publicDashboardRouter.Use(hs.ADBOauthScopeMiddleware)
+	internalDashboardRouter.Use(hs.ADBOauthScopeMiddleware)
+
+	// add middleware to check if user has access to dashboard
+	if !cfg.Frontend.Debug {
+		publicDashboardRouter.Use(hs.ADBAuthMiddleware, hs.ManageViaApiCheckMiddleware)
+		internalDashboardRouter.Use(hs.ADBAuthMiddleware)
+	}
+
+	endpoints := []endpoint{
+		{http.MethodGet, "/{dashboard_id}", hs.PublicGetAccountDashboard, hs.InternalGetAccountDashboard},
+		{http.MethodDelete, "/{dashboard_id}", hs.PublicDeleteAccountDashboard, hs.InternalDeleteAccountDashboard},
+		{http.MethodPost, "/{dashboard_id}/groups", hs.PublicPostAccountDashboardGroups, hs.InternalPostAccountDashboardGroups},
+		{http.MethodDelete, "/{dashboard_id}/groups/{group_id}", hs.PublicDeleteAccountDashboardGroups, hs.InternalDeleteAccountDashboardGroups},
+		{http.MethodPost, "/{dashboard_id}/accounts", hs.PublicPostAccountDashboardAccounts, hs.InternalPostAccountDashboardAccounts},
+		{http.MethodGet, "/{dashboard_id}/accounts", hs.PublicGetAccountDashboardAccounts, hs.InternalGetAccountDashboardAccounts},
+		{http.MethodDelete, "/{dashboard_id}/accounts", hs.PublicDeleteAccountDashboardAccounts, hs.InternalDeleteAccountDashboardAccounts},
+		{http.MethodPut, "/{dashboard_id}/accounts/{address}", hs.PublicPutAccountDashboardAccount, hs.InternalPutAccountDashboardAccount},
+		{http.MethodPost, "/{dashboard_id}/public-ids", hs.PublicPostAccountDashboardPublicIds, hs.InternalPostAccountDashboardPublicIds},
+		{http.MethodPut, "/{dashboard_id}/public-ids/{public_id}", hs.PublicPutAccountDashboardPublicId, hs.InternalPutAccountDashboardPublicId},
+		{http.MethodDelete, "/{dashboard_id}/public-ids/{public_id}", hs.PublicDeleteAccountDashboardPublicId, hs.InternalDeleteAccountDashboardPublicId},
+		{http.MethodGet, "/{dashboard_id}/transactions", hs.PublicGetAccountDashboardTransactions, hs.InternalGetAccountDashboardTransactions},
+		{http.MethodPut, "/{dashboard_id}/transactions/settings", hs.PublicPutAccountDashboardTransactionsSettings, hs.InternalPutAccountDashboardTransactionsSettings},
 	}
 	addEndpointsToRouters(endpoints, publicDashboardRouter, internalDashboardRouter)
 }
@@ -325,6 +371,7 @@ func addNotificationRoutes(hs *handlers.HandlerService, publicRouter, internalRo
 		{http.MethodGet, "/clients", nil, hs.InternalGetUserNotificationClients},
 		{http.MethodGet, "/rocket-pool", nil, hs.InternalGetUserNotificationRocketPool},
 		{http.MethodGet, "/networks", nil, hs.InternalGetUserNotificationNetworks},
+		{http.MethodGet, "/webhooks/deliveries", nil, hs.InternalGetUserNotificationWebhookDeliveries},
 		{http.MethodGet, "/settings", nil, hs.InternalGetUserNotificationSettings},
 		{http.MethodPut, "/settings/general", nil, hs.InternalPutUserNotificationSettingsGeneral},
 		{http.MethodPut, "/settings/networks/{network}", nil, hs.InternalPutUserNotificationSettingsNetworks},
@@ -44,6 +44,7 @@ func (s *Services) InitServices() {
 	go s.startIndexMappingService(wg)
 	go s.startEfficiencyDataService(wg)
 	go s.startEmailSenderService(wg)
+	go s.startGasNowService()
 
 	log.Infof("initializing prices...")
 	price.Init(utils.Config.Chain.ClConfig.DepositChainID, utils.Config.Eth1ErigonEndpoint, utils.Config.Frontend.ClCurrency, utils.Config.Frontend.ElCurrency)
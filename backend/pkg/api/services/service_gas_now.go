@@ -0,0 +1,171 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	gethMath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gobitfly/beaconchain/pkg/monitoring/constants"
+	"github.com/gobitfly/beaconchain/pkg/monitoring/services"
+	"golang.org/x/sync/errgroup"
+)
+
+// TODO: As a service this will not scale well as it is running once on every instance of the api.
+// Instead of service this should be moved to the exporter.
+
+const (
+	// number of recent blocks whose priority fees are used for the prediction
+	gasNowBlocks = 10
+	// EIP-1559 parameters
+	baseFeeChangeDenominator = 8
+	elasticityMultiplier     = 2
+)
+
+// priority fee percentiles of the recent transactions for the slow, standard, fast and rapid prediction
+var gasNowPercentiles = [4]int{25, 50, 75, 90}
+
+type GasNowData struct {
+	Timestamp   time.Time
+	Block       uint64 // latest block the prediction is based on
+	BaseFee     *big.Int
+	NextBaseFee *big.Int
+	// suggested priority fees ordered slow, standard, fast, rapid
+	PriorityFees [4]*big.Int
+}
+
+var currentGasNow atomic.Pointer[GasNowData]
+
+// the gas now service is not part of the initialization wait group as networks without indexed execution blocks would block the startup
+func (s *Services) startGasNowService() {
+	lastSaved := time.Time{}
+	for {
+		startTime := time.Now()
+		delay := time.Duration(utils.Config.Chain.ClConfig.SecondsPerSlot) * time.Second
+		r := services.NewStatusReport("api_service_gas_now", constants.Default, delay)
+		r(constants.Running, nil)
+		err := s.updateGasNow()
+		if err != nil {
+			log.Error(err, "error updating gas now data", 0)
+			r(constants.Failure, map[string]string{"error": err.Error()})
+		} else {
+			r(constants.Success, map[string]string{"took": time.Since(startTime).String()})
+			// the history keeps one prediction per minute
+			if minute := startTime.Truncate(time.Minute); minute.After(lastSaved) {
+				if err := s.saveGasNowHistory(); err != nil {
+					log.Error(err, "error saving gas now history", 0)
+				} else {
+					lastSaved = minute
+				}
+			}
+		}
+		utils.ConstantTimeDelay(startTime, delay)
+	}
+}
+
+func (s *Services) updateGasNow() error {
+	lastBlock, err := s.bigtable.GetLastBlockInBlocksTable()
+	if err != nil {
+		return err
+	}
+	latest := uint64(lastBlock)
+	if current := currentGasNow.Load(); current != nil && current.Block == latest {
+		return nil
+	}
+
+	blocks := make([]*types.Eth1Block, min(gasNowBlocks, latest+1))
+	wg := errgroup.Group{}
+	for i := range blocks {
+		wg.Go(func() error {
+			block, err := s.bigtable.GetBlockFromBlocksTable(latest - uint64(i))
+			if err != nil {
+				return fmt.Errorf("error retrieving block %d: %w", latest-uint64(i), err)
+			}
+			blocks[i] = block
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	priorityFees := make([]*big.Int, 0)
+	for _, block := range blocks {
+		baseFee := new(big.Int).SetBytes(block.BaseFee)
+		for _, tx := range block.Transactions {
+			priorityFees = append(priorityFees, effectivePriorityFee(tx, baseFee))
+		}
+	}
+	slices.SortFunc(priorityFees, func(a, b *big.Int) int { return a.Cmp(b) })
+
+	data := &GasNowData{
+		Timestamp:   blocks[0].Time.AsTime(),
+		Block:       latest,
+		BaseFee:     new(big.Int).SetBytes(blocks[0].BaseFee),
+		NextBaseFee: nextBaseFee(blocks[0]),
+	}
+	for i, percentile := range gasNowPercentiles {
+		data.PriorityFees[i] = big.NewInt(0)
+		if len(priorityFees) > 0 {
+			data.PriorityFees[i] = priorityFees[(len(priorityFees)-1)*percentile/100]
+		}
+	}
+	currentGasNow.Store(data)
+	return nil
+}
+
+// effectivePriorityFee returns the part of the gas price that was paid on top of the base fee
+func effectivePriorityFee(tx *types.Eth1Transaction, baseFee *big.Int) *big.Int {
+	if tx.Type == 0 || tx.Type == 1 {
+		// legacy and access list transactions pay the gas price as is
+		return gethMath.BigMax(new(big.Int).Sub(new(big.Int).SetBytes(tx.GasPrice), baseFee), big.NewInt(0))
+	}
+	maxPriorityFee := new(big.Int).SetBytes(tx.MaxPriorityFeePerGas)
+	available := new(big.Int).Sub(new(big.Int).SetBytes(tx.MaxFeePerGas), baseFee)
+	return gethMath.BigMax(gethMath.BigMin(maxPriorityFee, available), big.NewInt(0))
+}
+
+// nextBaseFee predicts the base fee of the block following the given one as specified by EIP-1559
+func nextBaseFee(block *types.Eth1Block) *big.Int {
+	baseFee := new(big.Int).SetBytes(block.BaseFee)
+	target := block.GasLimit / elasticityMultiplier
+	if target == 0 || block.GasUsed == target {
+		return baseFee
+	}
+	if block.GasUsed > target {
+		delta := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(block.GasUsed-target))
+		delta.Div(delta, new(big.Int).SetUint64(target))
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		return baseFee.Add(baseFee, gethMath.BigMax(delta, big.NewInt(1)))
+	}
+	delta := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(target-block.GasUsed))
+	delta.Div(delta, new(big.Int).SetUint64(target))
+	delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+	return gethMath.BigMax(baseFee.Sub(baseFee, delta), big.NewInt(0))
+}
+
+// saveGasNowHistory stores the current prediction as gas prices, i.e. the predicted base fee plus the priority fees
+func (s *Services) saveGasNowHistory() error {
+	data := currentGasNow.Load()
+	if data == nil {
+		return nil
+	}
+	prices := [4]*big.Int{}
+	for i, priorityFee := range data.PriorityFees {
+		prices[i] = new(big.Int).Add(data.NextBaseFee, priorityFee)
+	}
+	return s.bigtable.SaveGasNowHistory(prices[0], prices[1], prices[3], prices[2])
+}
+
+// GetCurrentGasNow returns the latest gas price prediction
+func (s *Services) GetCurrentGasNow() (*GasNowData, error) {
+	if data := currentGasNow.Load(); data != nil {
+		return data, nil
+	}
+	return nil, fmt.Errorf("%w: gas now", ErrWaiting)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Overview
+type ADBOverviewGroup struct {
+	Id    uint64 `json:"id"`
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+}
+
+type ADBPublicId struct {
+	PublicId      string `json:"public_id"`
+	DashboardId   int    `json:"-"`
+	Name          string `json:"name,omitempty"`
+	ShareSettings struct {
+		ShareGroups bool `json:"share_groups"`
+	} `json:"share_settings"`
+}
+
+type ADBTransactionsSettings struct {
+	ShowTokenTransfers          bool `json:"show_token_transfers"`
+	HideZeroValueTokenTransfers bool `json:"hide_zero_value_token_transfers"`
+}
+
+type ADBOverviewData struct {
+	Name                 string                  `json:"name,omitempty"`
+	Groups               []ADBOverviewGroup      `json:"groups"`
+	AccountCount         uint64                  `json:"account_count"`
+	PublicIds            []ADBPublicId           `json:"public_ids,omitempty"`
+	TransactionsSettings ADBTransactionsSettings `json:"transactions_settings"`
+}
+
+type GetAccountDashboardResponse ApiDataResponse[ADBOverviewData]
+
+// ------------------------------------------------------------
+// Manage Accounts
+type ADBManageAccountsTableRow struct {
+	Address Address `json:"address"`
+	GroupId uint64  `json:"group_id"`
+}
+
+type GetAccountDashboardAccountsResponse ApiPagingResponse[ADBManageAccountsTableRow]
+
+// ------------------------------------------------------------
+// Transactions
+type ADBTransactionTableRow struct {
+	Success   bool            `json:"success"`
+	TxHash    Hash            `json:"tx_hash"`
+	Method    string          `json:"method"`
+	Block     uint64          `json:"block"`
+	Timestamp int64           `json:"timestamp"`
+	GroupId   uint64          `json:"group_id"`
+	Type      string          `json:"type" tstype:"'transaction' | 'erc20'" faker:"oneof: transaction, erc20"`
+	Direction string          `json:"direction" tstype:"'in' | 'out' | 'self'" faker:"oneof: in, out, self"` // relative to the accounts of the dashboard
+	From      Address         `json:"from"`
+	To        Address         `json:"to"`
+	Token     *Address        `json:"token,omitempty"` // only set for erc20 transfers
+	Value     decimal.Decimal `json:"value"`           // in the smallest unit of the token for erc20 transfers
+	TxFee     decimal.Decimal `json:"tx_fee"`
+}
+
+type GetAccountDashboardTransactionsResponse ApiPagingResponse[ADBTransactionTableRow]
+
+type PutAccountDashboardTransactionsSettingsResponse ApiDataResponse[ADBTransactionsSettings]
+
+// ------------------------------------------------------------
+// Misc.
+type ADBPostReturnData struct {
+	Id        uint64 `db:"id" json:"id"`
+	UserID    uint64 `db:"user_id" json:"user_id"`
+	Name      string `db:"name" json:"name"`
+	CreatedAt int64  `db:"created_at" json:"created_at"`
+}
+
+type ADBPostCreateGroupData struct {
+	Id   uint64 `db:"id" json:"id"`
+	Name string `db:"name" json:"name"`
+}
+
+type ADBPostAccountsData struct {
+	Address string `json:"address"`
+	GroupId uint64 `json:"group_id"`
+}
@@ -160,6 +160,13 @@ type BlockAttestationTableRow struct {
 
 type InternalGetBlockAttestationsResponse ApiDataResponse[[]BlockAttestationTableRow]
 
+type NetworkAggregatedAttestationTableRow struct {
+	IncludedInSlot uint64                   `json:"included_in_slot"`
+	Attestation    BlockAttestationTableRow `json:"attestation"`
+}
+
+type GetNetworkAggregatedAttestationsResponse ApiPagingResponse[NetworkAggregatedAttestationTableRow]
+
 type BlockWithdrawalTableRow struct {
 	// no design present yet, TODO confirm this
 	Index     uint64          `json:"index"`
@@ -199,3 +206,41 @@ type BlockBlobTableRow struct {
 }
 
 type InternalGetBlockBlobsResponse ApiDataResponse[[]BlockBlobTableRow]
+
+// ------------------------------------------------------------
+// Slot and block listings
+type SlotTableRow struct {
+	Slot              uint64  `json:"slot"`
+	Epoch             uint64  `json:"epoch"`
+	Time              int64   `json:"time"`
+	Status            string  `json:"status" tstype:"'proposed' | 'orphaned' | 'missed' | 'scheduled'" faker:"oneof: proposed, orphaned, missed, scheduled"`
+	Proposer          uint64  `json:"proposer"`
+	BlockRoot         Hash    `json:"block_root,omitempty"`
+	Block             *uint64 `json:"block,omitempty"`
+	Graffiti          string  `json:"graffiti,omitempty"`
+	Attestations      uint64  `json:"attestations"`
+	Deposits          uint64  `json:"deposits"`
+	Withdrawals       uint64  `json:"withdrawals"`
+	VoluntaryExits    uint64  `json:"voluntary_exits"`
+	ProposerSlashings uint64  `json:"proposer_slashings"`
+	AttesterSlashings uint64  `json:"attester_slashings"`
+	SyncParticipation float64 `json:"sync_participation"`
+}
+
+type GetNetworkSlotsResponse ApiPagingResponse[SlotTableRow]
+
+type BlockTableRow struct {
+	Block         uint64          `json:"block"`
+	Slot          uint64          `json:"slot"`
+	Epoch         uint64          `json:"epoch"`
+	Time          int64           `json:"time"`
+	Proposer      uint64          `json:"proposer"`
+	Hash          Hash            `json:"hash"`
+	FeeRecipient  Address         `json:"fee_recipient"`
+	Transactions  uint64          `json:"transactions"`
+	GasUsed       uint64          `json:"gas_used"`
+	GasLimit      uint64          `json:"gas_limit"`
+	BaseFeePerGas decimal.Decimal `json:"base_fee_per_gas"`
+}
+
+type GetNetworkBlocksResponse ApiPagingResponse[BlockTableRow]
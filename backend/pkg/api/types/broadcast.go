@@ -0,0 +1,16 @@
+package types
+
+// ------------------------------------------------------------
+// Broadcasts
+type NetworkBroadcast struct {
+	Id          string `json:"id"`
+	Type        string `json:"type" tstype:"'transaction' | 'voluntary_exit' | 'bls_changes' | 'attestations'" faker:"oneof: transaction, voluntary_exit, bls_changes, attestations"`
+	Status      string `json:"status" tstype:"'pending' | 'submitted' | 'completed' | 'failed'" faker:"oneof: pending, submitted, completed, failed"`
+	CreatedTs   int64  `json:"created_ts"`
+	SubmittedTs *int64 `json:"submitted_ts,omitempty"` // time the data was forwarded to the node
+	CompletedTs *int64 `json:"completed_ts,omitempty"` // time the result was observed on chain
+}
+
+type PostNetworkBroadcastsResponse ApiDataResponse[NetworkBroadcast]
+
+type GetNetworkBroadcastResponse ApiDataResponse[NetworkBroadcast]
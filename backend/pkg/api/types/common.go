@@ -133,10 +133,11 @@ type VDBPublicId struct {
 }
 
 type ChartHistorySeconds struct {
-	Epoch  uint64 `json:"epoch"`
-	Hourly uint64 `json:"hourly"`
-	Daily  uint64 `json:"daily"`
-	Weekly uint64 `json:"weekly"`
+	Epoch   uint64 `json:"epoch"`
+	Hourly  uint64 `json:"hourly"`
+	Daily   uint64 `json:"daily"`
+	Weekly  uint64 `json:"weekly"`
+	Monthly uint64 `json:"monthly"`
 }
 
 type IndexBlocks struct {
@@ -31,6 +31,13 @@ type VDBId struct {
 	AggregateGroups bool
 }
 
+type ADBIdPrimary int
+type ADBIdPublic string
+type ADBId struct {
+	Id              ADBIdPrimary
+	AggregateGroups bool
+}
+
 // could replace if we want the import in all files
 type VDBValidator = types.ValidatorIndex
 
@@ -125,6 +132,161 @@ type BlocksCursor struct {
 	Reward   decimal.Decimal
 }
 
+type EpochsCursor struct {
+	GenericCursor
+
+	Epoch uint64
+}
+
+type SlotsCursor struct {
+	GenericCursor
+
+	Slot      uint64
+	BlockRoot []byte
+}
+
+type NetworkBlocksCursor struct {
+	GenericCursor
+
+	Block uint64
+}
+
+// for operations identified by their position in a block, e.g. attestations
+type BlockOperationsCursor struct {
+	GenericCursor
+
+	BlockSlot  uint64
+	BlockIndex uint64
+}
+
+type NetworkWithdrawalsCursor struct {
+	GenericCursor
+
+	WithdrawalIndex uint64
+}
+
+// Kind orders the slashings within a block: 0 = proposer slashing, 1 = attester slashing
+type SlashingsCursor struct {
+	GenericCursor
+
+	BlockSlot  uint64
+	Kind       uint64
+	BlockIndex uint64
+	Validator  uint64
+}
+
+type BlsChangesCursor struct {
+	GenericCursor
+
+	BlockSlot uint64
+	Validator uint64
+}
+
+// NetworkOperationsFilter narrows down the network wide operation feeds, unset fields are ignored.
+// Not every feed supports every field, see the respective repository method.
+type NetworkOperationsFilter struct {
+	Address    []byte
+	Credential []byte
+	PublicKey  []byte
+	TxHash     []byte
+	Validator  *uint64
+	StartSlot  *uint64 // inclusive
+	EndSlot    *uint64 // inclusive
+}
+
+// the execution layer feeds can only be paged forward
+type NetworkTransactionsCursor struct {
+	GenericCursor
+
+	Block   uint64
+	TxIndex uint64
+}
+
+// Key is the last bigtable address index row that has been consumed
+type AddressIndexCursor struct {
+	GenericCursor
+
+	Key string
+}
+
+type RocketPoolCursor struct {
+	GenericCursor
+
+	Node Address
+}
+
+type RocketPoolMinipoolsCursor struct {
+	GenericCursor
+
+	ValidatorIndex uint64
+}
+
+type RocketPoolNetworkMinipoolsCursor struct {
+	GenericCursor
+
+	Address []byte
+}
+
+// RocketPoolNodesFilter narrows down the network wide rocket pool nodes listing, unset fields are ignored
+type RocketPoolNodesFilter struct {
+	SmoothingPool *bool
+	MinRplStake   *decimal.Decimal // wei
+	MinCollateral *float64         // percent
+	MaxCollateral *float64         // percent
+}
+
+// RocketPoolMinipoolsFilter narrows down the network wide rocket pool minipools listing, unset fields are ignored
+type RocketPoolMinipoolsFilter struct {
+	Node        []byte
+	Status      string // lower case minipool status, e.g. "staking"
+	DepositType string // lower case deposit type, e.g. "variable"
+}
+
+type ADBAccountsCursor struct {
+	GenericCursor
+
+	Address Address
+}
+
+// the transactions feed can only be paged forward, Key identifies the last row among rows of the same timestamp
+type ADBTransactionsCursor struct {
+	GenericCursor
+
+	Timestamp int64
+	Key       string
+}
+
+type NotificationsDashboardsCursor struct {
+	GenericCursor
+
+	Epoch          uint64
+	DashboardId    uint64
+	NotificationId uint64
+}
+
+type NotificationsCursor struct {
+	GenericCursor
+
+	Id             uint64
+	EventName      string
+	EventFilter    string
+	EventThreshold float64
+}
+
+type NotificationWebhookDeliveriesCursor struct {
+	GenericCursor
+
+	Id uint64
+}
+
+type NotificationSettingsCursor struct {
+	GenericCursor
+
+	IsAccountDashboard bool
+	DashboardId        uint64
+	GroupId            uint64
+}
+
 type NetworkInfo struct {
 	ChainId uint64
 	Name    string
@@ -237,3 +399,40 @@ type MobileAppBundleStats struct {
 	DeliveryCount       uint64
 	MaxNativeVersion    uint64 // the max native version of the whole table for the given environment
 }
+
+// -------------------------
+// OAuth structs
+
+type OAuthAppCredentials struct {
+	AppId            uint64
+	ClientId         string
+	ClientSecretHash string
+	RedirectUri      string
+	Scopes           []string
+}
+
+type OAuthAuthorizationCode struct {
+	AppId               uint64
+	UserId              uint64
+	ClientId            string
+	RedirectUri         string
+	Scopes              []string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+type OAuthToken struct {
+	AppId     uint64
+	UserId    uint64
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// -------------------------
+// API key structs
+
+type ApiKeyInfo struct {
+	UserId     uint64
+	Scope      string
+	AllowedIps []string
+}
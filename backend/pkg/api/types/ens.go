@@ -0,0 +1,29 @@
+package types
+
+// ------------------------------------------------------------
+// Forward Resolution
+type NetworkEnsTextRecord struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type NetworkEnsName struct {
+	Name      string                 `json:"name"`
+	Address   Hash                   `json:"address"`    // address the name resolves to
+	IsPrimary bool                   `json:"is_primary"` // the name is also the reverse record of the address
+	Expires   int64                  `json:"expires"`
+	Owner     *Address               `json:"owner,omitempty"`    // only set once the name has been revalidated by the indexer
+	Resolver  *Hash                  `json:"resolver,omitempty"` // only set once the name has been revalidated by the indexer
+	Records   []NetworkEnsTextRecord `json:"records"`            // common text records, empty if no execution client is configured
+}
+
+type GetNetworkEnsResponse ApiDataResponse[NetworkEnsName]
+
+// ------------------------------------------------------------
+// Reverse Resolution
+type NetworkAddressEns struct {
+	Address Hash   `json:"address"`
+	Name    string `json:"name,omitempty"` // primary name, empty if the address has none
+}
+
+type GetNetworkAddressEnsResponse ApiDataResponse[[]NetworkAddressEns]
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type EpochTableRow struct {
+	Epoch             uint64          `json:"epoch"`
+	Time              int64           `json:"time"`
+	Finalized         bool            `json:"finalized"`
+	ProposedBlocks    uint64          `json:"proposed_blocks"`
+	MissedBlocks      uint64          `json:"missed_blocks"`
+	OrphanedBlocks    uint64          `json:"orphaned_blocks"`
+	ScheduledBlocks   uint64          `json:"scheduled_blocks"`
+	Attestations      uint64          `json:"attestations"`
+	Deposits          uint64          `json:"deposits"`
+	Withdrawals       uint64          `json:"withdrawals"`
+	VoluntaryExits    uint64          `json:"voluntary_exits"`
+	ProposerSlashings uint64          `json:"proposer_slashings"`
+	AttesterSlashings uint64          `json:"attester_slashings"`
+	Validators        uint64          `json:"validators"`
+	AverageBalance    decimal.Decimal `json:"average_balance"`
+	TotalBalance      decimal.Decimal `json:"total_balance"`
+	EligibleEther     decimal.Decimal `json:"eligible_ether"`
+	VotedEther        decimal.Decimal `json:"voted_ether"`
+	Participation     float64         `json:"participation"`
+}
+
+type GetNetworkEpochsResponse ApiPagingResponse[EpochTableRow]
+
+type GetNetworkEpochResponse ApiDataResponse[EpochTableRow]
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ETH.STORE is the daily reference rate of the network, see https://github.com/gobitfly/eth.store
+type EthStoreDay struct {
+	Day              uint64          `json:"day"`
+	Time             int64           `json:"time"` // start of the day
+	Apr              float64         `json:"apr"`
+	ConsensusApr     float64         `json:"consensus_apr"`
+	ExecutionApr     float64         `json:"execution_apr"`
+	EffectiveBalance decimal.Decimal `json:"effective_balance"`
+	StartBalance     decimal.Decimal `json:"start_balance"`
+	EndBalance       decimal.Decimal `json:"end_balance"`
+	Deposits         decimal.Decimal `json:"deposits"`
+	ConsensusRewards decimal.Decimal `json:"consensus_rewards"`
+	ExecutionRewards decimal.Decimal `json:"execution_rewards"`
+	TotalRewards     decimal.Decimal `json:"total_rewards"`
+}
+
+type GetNetworkEthStoreResponse ApiDataResponse[EthStoreDay]
+
+type GetNetworkEthStoreDaysResponse ApiDataResponse[[]EthStoreDay]
+
+type EthStoreComparisonDay struct {
+	Day          uint64          `json:"day"`
+	Validators   uint64          `json:"validators"`
+	Apr          float64         `json:"apr"`
+	NetworkApr   float64         `json:"network_apr"`
+	TotalRewards decimal.Decimal `json:"total_rewards"`
+}
+
+// the aprs of a range are weighted by the effective balances of its days
+type EthStoreComparison struct {
+	StartDay         uint64                  `json:"start_day"`
+	EndDay           uint64                  `json:"end_day"`
+	Validators       uint64                  `json:"validators"` // validators with ETH.STORE data in the range
+	Apr              float64                 `json:"apr"`
+	ConsensusApr     float64                 `json:"consensus_apr"`
+	ExecutionApr     float64                 `json:"execution_apr"`
+	NetworkApr       float64                 `json:"network_apr"`
+	Difference       float64                 `json:"difference"` // apr - network_apr
+	ConsensusRewards decimal.Decimal         `json:"consensus_rewards"`
+	ExecutionRewards decimal.Decimal         `json:"execution_rewards"`
+	TotalRewards     decimal.Decimal         `json:"total_rewards"`
+	Days             []EthStoreComparisonDay `json:"days"`
+}
+
+type GetNetworkValidatorEthStoreResponse ApiDataResponse[EthStoreComparison]
+
+type GetValidatorDashboardEthStoreResponse ApiDataResponse[EthStoreComparison]
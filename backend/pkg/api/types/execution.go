@@ -0,0 +1,103 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Transactions
+type NetworkTransactionTableRow struct {
+	Success            bool            `json:"success"`
+	TxHash             Hash            `json:"tx_hash"`
+	Method             string          `json:"method"`
+	Block              uint64          `json:"block"`
+	Timestamp          int64           `json:"timestamp"`
+	From               Address         `json:"from"`
+	To                 Address         `json:"to"` // address of the created contract for contract creations
+	IsContractCreation bool            `json:"is_contract_creation"`
+	Value              decimal.Decimal `json:"value"`
+	TxFee              decimal.Decimal `json:"tx_fee"`
+}
+
+type GetNetworkTransactionsResponse ApiPagingResponse[NetworkTransactionTableRow]
+
+type NetworkInternalTransaction struct {
+	Type  string          `json:"type"`
+	From  Address         `json:"from"`
+	To    Address         `json:"to"`
+	Value decimal.Decimal `json:"value"`
+	Path  string          `json:"path"`
+	Error string          `json:"error,omitempty"`
+}
+
+type NetworkTokenTransfer struct {
+	Type     string          `json:"type" tstype:"'erc20' | 'erc721'" faker:"oneof: erc20, erc721"`
+	Token    Address         `json:"token"`
+	From     Address         `json:"from"`
+	To       Address         `json:"to"`
+	Value    decimal.Decimal `json:"value"` // token amount for erc20, token id for erc721
+	LogIndex uint64          `json:"log_index"`
+}
+
+type NetworkEventLog struct {
+	Block     uint64  `json:"block"`
+	Timestamp int64   `json:"timestamp"`
+	TxHash    Hash    `json:"tx_hash"`
+	LogIndex  uint64  `json:"log_index"`
+	Address   Address `json:"address"`
+	Event     string  `json:"event,omitempty"` // decoded event signature, empty if unknown
+	Topics    []Hash  `json:"topics"`
+	Data      Hash    `json:"data"`
+	Removed   bool    `json:"removed"`
+}
+
+type NetworkTransactionDetail struct {
+	Success              bool                         `json:"success"`
+	Error                string                       `json:"error,omitempty"`
+	TxHash               Hash                         `json:"tx_hash"`
+	Type                 uint32                       `json:"type"`
+	Block                uint64                       `json:"block"`
+	Timestamp            int64                        `json:"timestamp"`
+	Nonce                uint64                       `json:"nonce"`
+	From                 Address                      `json:"from"`
+	To                   Address                      `json:"to"` // address of the created contract for contract creations
+	IsContractCreation   bool                         `json:"is_contract_creation"`
+	Method               string                       `json:"method"`
+	Input                Hash                         `json:"input"`
+	Value                decimal.Decimal              `json:"value"`
+	GasLimit             uint64                       `json:"gas_limit"`
+	GasUsed              uint64                       `json:"gas_used"`
+	GasPrice             decimal.Decimal              `json:"gas_price"` // effective gas price
+	MaxFeePerGas         decimal.Decimal              `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas decimal.Decimal              `json:"max_priority_fee_per_gas"`
+	BlobGasUsed          uint64                       `json:"blob_gas_used"`
+	BlobGasPrice         decimal.Decimal              `json:"blob_gas_price"`
+	TxFee                decimal.Decimal              `json:"tx_fee"` // including the blob fee
+	InternalTransactions []NetworkInternalTransaction `json:"internal_transactions"`
+	TokenTransfers       []NetworkTokenTransfer       `json:"token_transfers"`
+	Logs                 []NetworkEventLog            `json:"logs"`
+}
+
+type GetNetworkTransactionResponse ApiDataResponse[NetworkTransactionDetail]
+
+// ------------------------------------------------------------
+// Address History
+type GetNetworkEventLogsResponse ApiPagingResponse[NetworkEventLog]
+
+type NetworkAddressBalanceHistoryRow struct {
+	Day       uint64          `json:"day"`
+	Timestamp int64           `json:"timestamp"`
+	Block     uint64          `json:"block"` // last block of the day
+	Balance   decimal.Decimal `json:"balance"`
+}
+
+type GetNetworkAddressBalanceHistoryResponse ApiDataResponse[[]NetworkAddressBalanceHistoryRow]
+
+type NetworkTokenSupplyHistoryRow struct {
+	Day         uint64          `json:"day"`
+	Timestamp   int64           `json:"timestamp"`
+	Block       uint64          `json:"block"` // last block of the day
+	TotalSupply decimal.Decimal `json:"total_supply"`
+}
+
+type GetNetworkTokenSupplyHistoryResponse ApiDataResponse[[]NetworkTokenSupplyHistoryRow]
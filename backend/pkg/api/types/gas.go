@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Gas Now
+type GasNowFee struct {
+	PriorityFee decimal.Decimal `json:"priority_fee"`
+	GasPrice    decimal.Decimal `json:"gas_price"` // predicted base fee plus priority fee
+}
+
+type GasNowHistoryPoint struct {
+	Timestamp int64           `json:"timestamp"`
+	Slow      decimal.Decimal `json:"slow"`
+	Standard  decimal.Decimal `json:"standard"`
+	Fast      decimal.Decimal `json:"fast"`
+	Rapid     decimal.Decimal `json:"rapid"`
+}
+
+type NetworkGasNow struct {
+	Timestamp   int64                `json:"timestamp"`
+	Block       uint64               `json:"block"` // latest block the prediction is based on
+	BaseFee     decimal.Decimal      `json:"base_fee"`
+	NextBaseFee decimal.Decimal      `json:"next_base_fee"`
+	Slow        GasNowFee            `json:"slow"`
+	Standard    GasNowFee            `json:"standard"`
+	Fast        GasNowFee            `json:"fast"`
+	Rapid       GasNowFee            `json:"rapid"`
+	History     []GasNowHistoryPoint `json:"history,omitempty"` // gas prices of the past hour, newest first, not included in the stream
+}
+
+type GetNetworkGasNowResponse ApiDataResponse[NetworkGasNow]
+
+// ------------------------------------------------------------
+// Gas History
+type GetNetworkGasLimitHistoryResponse ApiDataResponse[ChartData[string, decimal.Decimal]] // series id is 'average'
+
+type GetNetworkGasUsedHistoryResponse ApiDataResponse[ChartData[string, decimal.Decimal]] // series ids are 'total' and 'average'
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Safes
+type MultisigSafe struct {
+	Address           Address   `json:"address"`
+	Owners            []Address `json:"owners"`
+	Threshold         uint64    `json:"threshold"`
+	Creator           Address   `json:"creator"` // sender of the creation transaction
+	FallbackHandler   Address   `json:"fallback_handler"`
+	CreationBlock     uint64    `json:"creation_block"`
+	CreationTimestamp int64     `json:"creation_timestamp"`
+	CreationTxHash    Hash      `json:"creation_tx_hash"`
+}
+
+type GetMultisigSafeResponse ApiDataResponse[MultisigSafe]
+
+// ------------------------------------------------------------
+// Transactions
+type MultisigTransactionConfirmation struct {
+	Owner     Address `json:"owner"`
+	Type      string  `json:"type" tstype:"'signature' | 'approved_hash' | 'contract_signature'" faker:"oneof: signature, approved_hash, contract_signature"`
+	Block     uint64  `json:"block"` // block of the approval, or of the execution for signatures submitted with it
+	Timestamp int64   `json:"timestamp"`
+	TxHash    Hash    `json:"tx_hash"`
+}
+
+type MultisigTransaction struct {
+	SafeTxHash    Hash                              `json:"safe_tx_hash"`
+	TxHash        Hash                              `json:"tx_hash"`
+	Block         uint64                            `json:"block"`
+	Timestamp     int64                             `json:"timestamp"`
+	Success       bool                              `json:"success"`
+	Executor      Address                           `json:"executor"`
+	To            *Address                          `json:"to,omitempty"` // decoded call data, only set if the safe transaction was executed by calling the safe directly
+	Value         *decimal.Decimal                  `json:"value,omitempty"`
+	Operation     string                            `json:"operation,omitempty" tstype:"'call' | 'delegate_call'" faker:"oneof: call, delegate_call"`
+	Method        string                            `json:"method,omitempty"`
+	Payment       decimal.Decimal                   `json:"payment"` // refund paid to the executor
+	Confirmations []MultisigTransactionConfirmation `json:"confirmations"`
+}
+
+type GetMultisigSafeTransactionsResponse ApiPagingResponse[MultisigTransaction]
+
+type GetMultisigTransactionConfirmationsResponse ApiDataResponse[[]MultisigTransactionConfirmation]
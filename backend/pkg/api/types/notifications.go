@@ -67,6 +67,7 @@ type NotificationValidatorDashboardDetail struct {
 	ProposalDone             []IndexBlocks                          `json:"proposal_done"`
 	UpcomingProposals        []uint64                               `json:"upcoming_proposals"` // slot numbers
 	Slashed                  []uint64                               `json:"slashed"`            // validator indices
+	HasSlashed               []uint64                               `json:"has_slashed"`        // validator indices
 	SyncCommittee            []uint64                               `json:"sync_committee"`     // validator indices
 	AttestationMissed        []IndexBlocks                          `json:"attestation_missed"`
 	Withdrawal               []IndexBlocks                          `json:"withdrawal"`
@@ -219,9 +220,37 @@ type NotificationSettingsDashboardsTableRow struct {
 	DashboardId        uint64 `json:"dashboard_id"`
 	GroupId            uint64 `json:"group_id"`
 	GroupName          string `json:"group_name"`
+	WebhookSecret      string `json:"webhook_secret,omitempty"` // used to sign the webhook requests of this group, empty until a webhook is set
 	// if it's a validator dashboard, SubscribedEvents is NotificationSettingsAccountDashboard, otherwise NotificationSettingsValidatorDashboard
 	Settings interface{} `json:"settings" tstype:"NotificationSettingsAccountDashboard | NotificationSettingsValidatorDashboard" faker:"-"`
 	ChainIds []uint64    `json:"chain_ids" faker:"chain_ids"`
 }
 
 type InternalGetUserNotificationSettingsDashboardsResponse ApiPagingResponse[NotificationSettingsDashboardsTableRow]
+
+// ------------------------------------------------------------
+// Webhook Deliveries
+type NotificationWebhookDelivery struct {
+	Id              uint64 `json:"id"`
+	Timestamp       int64  `json:"timestamp"`
+	WebhookUrl      string `json:"webhook_url"`
+	EventType       string `json:"event_type"`
+	Attempt         uint64 `json:"attempt"`
+	StatusCode      uint64 `json:"status_code,omitempty"` // not set if no response was received
+	LatencyMs       uint64 `json:"latency_ms"`
+	IsSuccess       bool   `json:"is_success"`
+	ResponseExcerpt string `json:"response_excerpt,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+type InternalGetUserNotificationWebhookDeliveriesResponse ApiPagingResponse[NotificationWebhookDelivery]
+
+// ------------------------------------------------------------
+// Test Notifications
+type NotificationTestResult struct {
+	IsDelivered   bool   `json:"is_delivered"`
+	StatusCode    uint64 `json:"status_code,omitempty"` // only set for webhooks that responded
+	ProviderError string `json:"provider_error,omitempty"`
+}
+
+type InternalPostUserNotificationsTestResponse ApiDataResponse[NotificationTestResult]
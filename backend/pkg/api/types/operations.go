@@ -0,0 +1,82 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Deposits
+type NetworkDepositTableRow struct {
+	PublicKey            PubKey          `json:"public_key"`
+	Index                *uint64         `json:"index,omitempty"` // validator index, only set once the deposit has been processed
+	Block                uint64          `json:"block"`
+	Timestamp            int64           `json:"timestamp"`
+	From                 Address         `json:"from"`
+	Depositor            Address         `json:"depositor"`
+	TxHash               Hash            `json:"tx_hash"`
+	WithdrawalCredential Hash            `json:"withdrawal_credential"`
+	Amount               decimal.Decimal `json:"amount"`
+	Valid                bool            `json:"valid"`
+	Slot                 *uint64         `json:"slot,omitempty"` // slot of the block that included the deposit on the consensus layer
+}
+
+type GetNetworkDepositsResponse ApiPagingResponse[NetworkDepositTableRow]
+
+// ------------------------------------------------------------
+// Withdrawals
+type NetworkWithdrawalTableRow struct {
+	Index     uint64          `json:"index"`
+	Validator uint64          `json:"validator"`
+	Epoch     uint64          `json:"epoch"`
+	Slot      uint64          `json:"slot"`
+	Recipient Address         `json:"recipient"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+type GetNetworkWithdrawalsResponse ApiPagingResponse[NetworkWithdrawalTableRow]
+
+// ------------------------------------------------------------
+// Voluntary Exits
+type NetworkVoluntaryExitTableRow struct {
+	Validator uint64 `json:"validator"`
+	Epoch     uint64 `json:"epoch"` // epoch from which the exit is valid
+	Slot      uint64 `json:"slot"`  // slot of the block that included the exit
+	Signature Hash   `json:"signature"`
+}
+
+type GetNetworkVoluntaryExitsResponse ApiPagingResponse[NetworkVoluntaryExitTableRow]
+
+// ------------------------------------------------------------
+// BLS Changes
+type NetworkBlsChangeTableRow struct {
+	Validator            uint64  `json:"validator"`
+	Epoch                uint64  `json:"epoch"`
+	Slot                 uint64  `json:"slot"`
+	Signature            Hash    `json:"signature"`
+	BlsPubkey            Hash    `json:"bls_pubkey"`
+	NewWithdrawalAddress Address `json:"new_withdrawal_address"`
+}
+
+type GetNetworkBlsChangesResponse ApiPagingResponse[NetworkBlsChangeTableRow]
+
+// ------------------------------------------------------------
+// Slashings
+type NetworkSlashingPenalty struct {
+	Initial           decimal.Decimal `json:"initial"`            // applied in the epoch the slashing was included
+	CorrelationEpoch  uint64          `json:"correlation_epoch"`  // halfway through the withdrawal delay of the slashed validator
+	Correlation       decimal.Decimal `json:"correlation"`        // depends on the balance slashed around the same time
+	IsEstimated       bool            `json:"is_estimated"`       // the correlation penalty is estimated until the correlation epoch has been reached
+	WithdrawableEpoch uint64          `json:"withdrawable_epoch"` // the slashed validator is locked until this epoch
+}
+
+type NetworkSlashingTableRow struct {
+	Slot                uint64                 `json:"slot"` // slot of the block that included the slashing
+	Epoch               uint64                 `json:"epoch"`
+	Type                string                 `json:"type" tstype:"'proposer' | 'attester'" faker:"oneof: proposer, attester"`
+	Slashed             uint64                 `json:"slashed"` // slashed validator
+	Slasher             uint64                 `json:"slasher"` // proposer of the block that included the slashing, receives the whistleblower reward
+	WhistleblowerReward decimal.Decimal        `json:"whistleblower_reward"`
+	Penalty             NetworkSlashingPenalty `json:"penalty"`
+}
+
+type GetNetworkSlashingsResponse ApiPagingResponse[NetworkSlashingTableRow]
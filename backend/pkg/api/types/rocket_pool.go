@@ -0,0 +1,76 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Network
+type RocketPoolClaimInterval struct {
+	StartTs             int64           `json:"start_ts"`
+	EndTs               int64           `json:"end_ts"`
+	Duration            uint64          `json:"duration"`              // seconds
+	NodeOperatorRewards decimal.Decimal `json:"node_operator_rewards"` // rpl distributed to node operators at the end of the interval
+}
+
+type RocketPoolNetwork struct {
+	RethExchangeRate   float64                 `json:"reth_exchange_rate"` // eth per reth
+	RethSupply         decimal.Decimal         `json:"reth_supply"`
+	RplPrice           decimal.Decimal         `json:"rpl_price"` // wei per rpl
+	NodeFee            float64                 `json:"node_fee"`  // commission of new minipools in percent
+	NodeDemand         decimal.Decimal         `json:"node_demand"`
+	NodeCount          uint64                  `json:"node_count"`
+	MinipoolCount      uint64                  `json:"minipool_count"`
+	OdaoMemberCount    uint64                  `json:"odao_member_count"`
+	TotalEthStaking    decimal.Decimal         `json:"total_eth_staking"`
+	TotalEthBalance    decimal.Decimal         `json:"total_eth_balance"`
+	EffectiveRplStaked decimal.Decimal         `json:"effective_rpl_staked"`
+	RplApr             float64                 `json:"rpl_apr"`
+	ClaimInterval      RocketPoolClaimInterval `json:"claim_interval"`
+	UpdateTs           int64                   `json:"update_ts"`
+}
+
+type GetRocketPoolResponse ApiDataResponse[RocketPoolNetwork]
+
+// ------------------------------------------------------------
+// Nodes
+type RocketPoolNode struct {
+	Node              Address                            `json:"node"`
+	Timezone          string                             `json:"timezone"`
+	RplStake          decimal.Decimal                    `json:"rpl_stake"`
+	EffectiveRplStake decimal.Decimal                    `json:"effective_rpl_stake"`
+	Collateral        PercentageDetails[decimal.Decimal] `json:"collateral"` // min and max value are the min and max rpl stake
+	BorrowedEth       decimal.Decimal                    `json:"borrowed_eth"`
+	Minipools         uint64                             `json:"minipools"`
+	Rpl               struct {
+		Claimed   decimal.Decimal `json:"claimed"`
+		Unclaimed decimal.Decimal `json:"unclaimed"`
+	} `json:"rpl"`
+	SmoothingPool struct {
+		IsOptIn   bool            `json:"is_opt_in"`
+		Claimed   decimal.Decimal `json:"claimed"`
+		Unclaimed decimal.Decimal `json:"unclaimed"`
+	} `json:"smoothing_pool"`
+	DepositCredit decimal.Decimal `json:"deposit_credit"`
+}
+
+type GetRocketPoolNodesResponse ApiPagingResponse[RocketPoolNode]
+
+// ------------------------------------------------------------
+// Minipools
+type RocketPoolMinipool struct {
+	Address        Address         `json:"address"`
+	Node           Address         `json:"node"`
+	PublicKey      PubKey          `json:"public_key"`
+	ValidatorIndex *uint64         `json:"validator_index,omitempty"` // not set before the validator has been activated on the beacon chain
+	Status         string          `json:"status" tstype:"'initialized' | 'prelaunch' | 'staking' | 'withdrawable' | 'dissolved'" faker:"oneof: initialized, prelaunch, staking, withdrawable, dissolved"`
+	StatusTs       int64           `json:"status_ts"`
+	DepositType    string          `json:"deposit_type" tstype:"'full' | 'half' | 'empty' | 'variable'" faker:"oneof: full, half, empty, variable"`
+	Deposit        decimal.Decimal `json:"deposit"` // eth provided by the node operator
+	BorrowedEth    decimal.Decimal `json:"borrowed_eth"`
+	Commission     float64         `json:"commission"` // in percent
+	Penalties      uint64          `json:"penalties"`
+	IsVacant       bool            `json:"is_vacant"` // migrated solo validator that has not been promoted yet
+}
+
+type GetRocketPoolMinipoolsResponse ApiPagingResponse[RocketPoolMinipool]
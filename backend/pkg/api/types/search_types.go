@@ -33,3 +33,33 @@ type SearchValidatorsByGraffiti struct {
 	Graffiti string
 	Count    uint64
 }
+
+type SearchSlot struct {
+	Slot      uint64
+	BlockRoot []byte
+}
+
+type SearchEpoch struct {
+	Epoch uint64
+}
+
+type SearchBlock struct {
+	Number uint64
+	Hash   []byte
+}
+
+type SearchTransaction struct {
+	Hash        []byte
+	BlockNumber uint64
+}
+
+type SearchAddress struct {
+	Address []byte
+	Name    string // name of the address or contract if known
+	IsToken bool
+}
+
+type SearchEnsName struct {
+	EnsName string
+	Address []byte
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type SyncCommitteeMember struct {
+	Validator uint64   `json:"validator"`
+	Positions []uint64 `json:"positions"` // indices of the validator in the committee, a validator may hold more than one
+	// counted per position, a validator holding two positions has two duties per slot
+	Participated uint64          `json:"participated"`
+	Missed       uint64          `json:"missed"`
+	MissedSlots  []uint64        `json:"missed_slots"` // slots of missed sync signatures, the member participated in all other proposed slots of the period
+	Rewards      decimal.Decimal `json:"rewards"`
+	Penalties    decimal.Decimal `json:"penalties"`
+}
+
+type SyncCommitteeSlot struct {
+	Slot          uint64  `json:"slot"`
+	Status        string  `json:"status" tstype:"'proposed' | 'missed' | 'scheduled'" faker:"oneof: proposed, missed, scheduled"`
+	Participants  uint64  `json:"participants"`
+	Participation float64 `json:"participation"`
+}
+
+type SyncCommittee struct {
+	Period        uint64                `json:"period"`
+	StartEpoch    uint64                `json:"start_epoch"`
+	EndEpoch      uint64                `json:"end_epoch"`
+	Status        string                `json:"status" tstype:"'upcoming' | 'ongoing' | 'completed'" faker:"oneof: upcoming, ongoing, completed"`
+	Participation float64               `json:"participation"` // average over the proposed slots so far
+	Rewards       decimal.Decimal       `json:"rewards"`
+	Penalties     decimal.Decimal       `json:"penalties"`
+	Members       []SyncCommitteeMember `json:"members"`
+	Slots         []SyncCommitteeSlot   `json:"slots"` // empty for upcoming periods
+}
+
+type GetNetworkSyncCommitteeResponse ApiDataResponse[SyncCommittee]
@@ -120,6 +120,9 @@ type PremiumPerks struct {
 	ValidatorDashboards             uint64              `json:"validator_dashboards"`
 	ValidatorsPerDashboard          uint64              `json:"validators_per_dashboard"`
 	ValidatorGroupsPerDashboard     uint64              `json:"validator_groups_per_dashboard"`
+	AccountDashboards               uint64              `json:"account_dashboards"`
+	AccountsPerDashboard            uint64              `json:"accounts_per_dashboard"`
+	AccountGroupsPerDashboard       uint64              `json:"account_groups_per_dashboard"`
 	ShareCustomDashboards           bool                `json:"share_custom_dashboards"`
 	ManageDashboardViaApi           bool                `json:"manage_dashboard_via_api"`
 	BulkAdding                      bool                `json:"bulk_adding"`
@@ -153,3 +156,85 @@ type OAuthAppData struct {
 	RedirectURI string `db:"redirect_uri"`
 	Active      bool   `db:"active"`
 }
+
+// --------------------------------------
+// API Keys
+
+type ApiKey struct {
+	Id         uint64   `json:"id"`
+	Name       string   `json:"name"`
+	Key        string   `json:"key"`
+	Scope      string   `json:"scope" tstype:"'read' | 'write'" faker:"oneof: read, write"`
+	AllowedIps []string `json:"allowed_ips"` // empty if the key can be used from any ip
+	CreatedAt  int64    `json:"created_at"`
+	ExpiresAt  *int64   `json:"expires_at,omitempty"`
+}
+
+type InternalGetApiKeysResponse ApiDataResponse[[]ApiKey]
+
+type InternalPostApiKeysResponse ApiDataResponse[ApiKey]
+
+type ApiKeyUsageDay struct {
+	Timestamp int64  `json:"timestamp"`
+	Requests  uint64 `json:"requests"`
+}
+
+type ApiKeyEndpointUsage struct {
+	Endpoint string `json:"endpoint"`
+	Requests uint64 `json:"requests"`
+}
+
+type ApiKeyUsage struct {
+	TotalRequests uint64                `json:"total_requests"`
+	Days          []ApiKeyUsageDay      `json:"days"`
+	Endpoints     []ApiKeyEndpointUsage `json:"endpoints"`
+}
+
+type InternalGetApiKeyUsageResponse ApiDataResponse[ApiKeyUsage]
+
+// --------------------------------------
+// OAuth
+
+type OAuthApp struct {
+	Id           uint64   `json:"id"`
+	Name         string   `json:"name"`
+	ClientId     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret,omitempty"` // only returned once, on registration
+	RedirectUri  string   `json:"redirect_uri"`
+	Scopes       []string `json:"scopes"`
+	CreatedAt    int64    `json:"created_at"`
+}
+
+type InternalPostOauthAppsResponse ApiDataResponse[OAuthApp]
+
+type InternalGetOauthAppsResponse ApiDataResponse[[]OAuthApp]
+
+// an app the user has granted access to, it holds tokens until the user revokes them
+type OAuthGrant struct {
+	AppId     uint64   `json:"app_id"`
+	AppName   string   `json:"app_name"`
+	Scopes    []string `json:"scopes"`
+	GrantedAt int64    `json:"granted_at"`
+}
+
+type InternalGetOauthGrantsResponse ApiDataResponse[[]OAuthGrant]
+
+type OAuthAuthorization struct {
+	RedirectUri string `json:"redirect_uri"`
+}
+
+type InternalPostOauthAuthorizeResponse ApiDataResponse[OAuthAuthorization]
+
+// OAuthTokenResponse and OAuthErrorResponse must conform to RFC 6749, so they aren't wrapped in ApiDataResponse
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    uint64 `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+type OAuthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
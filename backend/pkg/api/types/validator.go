@@ -0,0 +1,97 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// ------------------------------------------------------------
+// Network Validators
+type NetworkValidator struct {
+	Index                      uint64          `json:"index"`
+	PublicKey                  PubKey          `json:"public_key"`
+	Status                     string          `json:"status" tstype:"'slashed' | 'exited' | 'deposited' | 'pending' | 'slashing_offline' | 'slashing_online' | 'exiting_offline' | 'exiting_online' | 'active_offline' | 'active_online'" faker:"oneof: slashed, exited, deposited, pending, slashing_offline, slashing_online, exiting_offline, exiting_online, active_offline, active_online"`
+	Balance                    decimal.Decimal `json:"balance"`
+	EffectiveBalance           decimal.Decimal `json:"effective_balance"`
+	WithdrawalCredential       Hash            `json:"withdrawal_credential"`
+	Slashed                    bool            `json:"slashed"`
+	ActivationEligibilityEpoch *uint64         `json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            *uint64         `json:"activation_epoch,omitempty"`
+	ExitEpoch                  *uint64         `json:"exit_epoch,omitempty"`
+	WithdrawableEpoch          *uint64         `json:"withdrawable_epoch,omitempty"`
+	QueuePosition              *uint64         `json:"queue_position,omitempty"`
+}
+
+type GetNetworkValidatorsResponse ApiPagingResponse[NetworkValidator]
+
+type GetNetworkValidatorResponse ApiDataResponse[NetworkValidator]
+
+type NetworkValidatorDuty struct {
+	Type   string  `json:"type" tstype:"'proposal' | 'attestation' | 'sync'" faker:"oneof: proposal, attestation, sync"`
+	Epoch  uint64  `json:"epoch"`
+	Slot   uint64  `json:"slot"`
+	Status string  `json:"status" tstype:"'scheduled' | 'success' | 'failed'" faker:"oneof: scheduled, success, failed"`
+	Block  *uint64 `json:"block,omitempty"` // only set for successful proposals
+}
+
+type GetNetworkValidatorDutiesResponse ApiDataResponse[[]NetworkValidatorDuty]
+
+// ------------------------------------------------------------
+// Validator History
+type NetworkValidatorRewardDetails struct {
+	AttestationSource           decimal.Decimal `json:"attestation_source"`
+	AttestationTarget           decimal.Decimal `json:"attestation_target"`
+	AttestationHead             decimal.Decimal `json:"attestation_head"`
+	Sync                        decimal.Decimal `json:"sync"`
+	Slashing                    decimal.Decimal `json:"slashing"`
+	Inactivity                  decimal.Decimal `json:"inactivity"` // finality delay penalty, always <= 0
+	ProposalClAttIncReward      decimal.Decimal `json:"proposal_cl_att_inc_reward"`
+	ProposalClSyncIncReward     decimal.Decimal `json:"proposal_cl_sync_inc_reward"`
+	ProposalClSlashingIncReward decimal.Decimal `json:"proposal_cl_slashing_inc_reward"`
+}
+
+type NetworkValidatorRewardHistoryRow struct {
+	Validator       uint64                        `json:"validator"`
+	Epoch           uint64                        `json:"epoch"`         // first epoch of the day for daily aggregation
+	Day             *uint64                       `json:"day,omitempty"` // only set for daily aggregation
+	Reward          ClElValue[decimal.Decimal]    `json:"reward"`
+	ClDetails       NetworkValidatorRewardDetails `json:"cl_details"`
+	ProposalsMissed uint64                        `json:"proposals_missed"`
+}
+
+type GetNetworkValidatorRewardHistoryResponse ApiDataResponse[[]NetworkValidatorRewardHistoryRow]
+
+type NetworkValidatorBalanceHistoryRow struct {
+	Validator        uint64          `json:"validator"`
+	Epoch            uint64          `json:"epoch"`         // last epoch of the day for daily aggregation
+	Day              *uint64         `json:"day,omitempty"` // only set for daily aggregation
+	Balance          decimal.Decimal `json:"balance"`
+	EffectiveBalance decimal.Decimal `json:"effective_balance"`
+}
+
+type GetNetworkValidatorBalanceHistoryResponse ApiDataResponse[[]NetworkValidatorBalanceHistoryRow]
+
+type NetworkValidatorPerformanceHistoryRow struct {
+	Validator         uint64      `json:"validator"`
+	Epoch             uint64      `json:"epoch"`         // first epoch of the day for daily aggregation
+	Day               *uint64     `json:"day,omitempty"` // only set for daily aggregation
+	Attestations      StatusCount `json:"attestations"`
+	AvgInclusionDelay float64     `json:"avg_inclusion_delay"` // in slots, only considers included attestations
+}
+
+type GetNetworkValidatorPerformanceHistoryResponse ApiDataResponse[[]NetworkValidatorPerformanceHistoryRow]
+
+type NetworkValidatorAttestation struct {
+	Validator      uint64  `json:"validator"`
+	Epoch          uint64  `json:"epoch"`
+	AttesterSlot   uint64  `json:"attester_slot"`
+	Status         string  `json:"status" tstype:"'success' | 'missed'" faker:"oneof: success, missed"`
+	InclusionSlot  *uint64 `json:"inclusion_slot,omitempty"`
+	InclusionDelay *uint64 `json:"inclusion_delay,omitempty"` // in slots
+	// whether the vote was correct and included in time, false for missed votes. Otherwise only set once the rewards of the epoch have been exported,
+	// unset if it can't be told, e.g. during an inactivity leak no vote is rewarded
+	TimelySource *bool `json:"timely_source,omitempty"`
+	TimelyTarget *bool `json:"timely_target,omitempty"`
+	TimelyHead   *bool `json:"timely_head,omitempty"`
+}
+
+type GetNetworkValidatorAttestationsResponse ApiDataResponse[[]NetworkValidatorAttestation]
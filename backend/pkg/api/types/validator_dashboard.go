@@ -201,14 +201,14 @@ type VDBHeatmapCell struct {
 	X int64  `json:"x"` // Timestamp
 	Y uint64 `json:"y"` // Group ID
 
-	Value  float64           `json:"value"` // Attestaton Rewards
+	Value  float64           `json:"value"` // Efficiency
 	Events *VDBHeatmapEvents `json:"events,omitempty"`
 }
 type VDBHeatmap struct {
 	Timestamps  []int64          `json:"timestamps"` // X-Axis Categories (unix timestamp)
 	GroupIds    []uint64         `json:"group_ids"`  // Y-Axis Categories
 	Data        []VDBHeatmapCell `json:"data"`
-	Aggregation string           `json:"aggregation" tstype:"'epoch' | 'hourly' | 'daily' | 'weekly'" faker:"oneof: epoch, hourly, daily, weekly"`
+	Aggregation string           `json:"aggregation" tstype:"'epoch' | 'hourly' | 'daily' | 'weekly' | 'monthly'" faker:"oneof: epoch, hourly, daily, weekly, monthly"`
 }
 type GetValidatorDashboardHeatmapResponse ApiDataResponse[VDBHeatmap]
 
@@ -355,6 +355,7 @@ type VDBManageValidatorsTableRow struct {
 	Status               string          `json:"status" tstype:"'slashed' | 'exited' | 'deposited' | 'pending' | 'slashing_offline' | 'slashing_online' | 'exiting_offline' | 'exiting_online' | 'active_offline' | 'active_online'" faker:"oneof: slashed, exited, deposited, pending, slashing_offline, slashing_online, exiting_offline, exiting_online, active_offline, active_online"`
 	QueuePosition        *uint64         `json:"queue_position,omitempty"`
 	WithdrawalCredential Hash            `json:"withdrawal_credential"`
+	WithdrawalAddress    *Address        `json:"withdrawal_address,omitempty"` // only set for execution layer withdrawal credentials
 }
 
 type GetValidatorDashboardValidatorsResponse ApiPagingResponse[VDBManageValidatorsTableRow]
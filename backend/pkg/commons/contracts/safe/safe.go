@@ -0,0 +1,63 @@
+package safe
+
+import (
+	"strings"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SafeABI contains the parts of the Safe (formerly Gnosis Safe) singleton abi that are required for indexing.
+// Some event parameters became indexed with v1.4.0, the events therefore have to be decoded depending on their number of topics.
+const SafeABI = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"initiator","type":"address"},{"indexed":false,"name":"owners","type":"address[]"},{"indexed":false,"name":"threshold","type":"uint256"},{"indexed":false,"name":"initializer","type":"address"},{"indexed":false,"name":"fallbackHandler","type":"address"}],"name":"SafeSetup","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"owner","type":"address"}],"name":"AddedOwner","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"owner","type":"address"}],"name":"RemovedOwner","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"threshold","type":"uint256"}],"name":"ChangedThreshold","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"txHash","type":"bytes32"},{"indexed":false,"name":"payment","type":"uint256"}],"name":"ExecutionSuccess","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"txHash","type":"bytes32"},{"indexed":false,"name":"payment","type":"uint256"}],"name":"ExecutionFailure","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"approvedHash","type":"bytes32"},{"indexed":true,"name":"owner","type":"address"}],"name":"ApproveHash","type":"event"},
+	{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],"name":"execTransaction","outputs":[{"name":"success","type":"bool"}],"stateMutability":"payable","type":"function"}
+]`
+
+var SafeParsedABI abi.ABI
+
+var (
+	SafeSetupTopic        = crypto.Keccak256([]byte("SafeSetup(address,address[],uint256,address,address)"))
+	AddedOwnerTopic       = crypto.Keccak256([]byte("AddedOwner(address)"))
+	RemovedOwnerTopic     = crypto.Keccak256([]byte("RemovedOwner(address)"))
+	ChangedThresholdTopic = crypto.Keccak256([]byte("ChangedThreshold(uint256)"))
+	ExecutionSuccessTopic = crypto.Keccak256([]byte("ExecutionSuccess(bytes32,uint256)"))
+	ExecutionFailureTopic = crypto.Keccak256([]byte("ExecutionFailure(bytes32,uint256)"))
+	ApproveHashTopic      = crypto.Keccak256([]byte("ApproveHash(bytes32,address)"))
+	// emitted by the proxy factories, the proxy parameter is indexed since v1.4.0
+	ProxyCreationTopic = crypto.Keccak256([]byte("ProxyCreation(address,address)"))
+)
+
+// ProxyFactories are the official v1.3.0 and v1.4.1 proxy factories, only safes created by them are indexed
+var ProxyFactories = map[common.Address]bool{
+	common.HexToAddress("0xa6B71E26C5e0845f74c812102Ca7114b6a896AB2"): true, // v1.3.0
+	common.HexToAddress("0xC22834581EbC8527d974F8a1c97E1bEA4EF910BC"): true, // v1.3.0 eip155
+	common.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"): true, // v1.4.1
+}
+
+// Singletons are the official v1.3.0 and v1.4.1 singletons, proxies of other implementations are not considered safes
+var Singletons = map[common.Address]bool{
+	common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"): true, // v1.3.0
+	common.HexToAddress("0x3E5c63644E683549055b9Be8653de26E0B4CD36E"): true, // v1.3.0 L2
+	common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"): true, // v1.3.0 eip155
+	common.HexToAddress("0xfb1bffC9d739B8D520DaF37dF666da4C687191EA"): true, // v1.3.0 L2 eip155
+	common.HexToAddress("0x41675C099F32341bf84BFc5382aF534df5C7461a"): true, // v1.4.1
+	common.HexToAddress("0x29fcB43b46531BcA003ddC8FCB67FFE91900C762"): true, // v1.4.1 L2
+}
+
+func init() {
+	var err error
+	SafeParsedABI, err = abi.JSON(strings.NewReader(SafeABI))
+	if err != nil {
+		log.Fatal(err, "error parsing safe-abi", 0)
+	}
+}
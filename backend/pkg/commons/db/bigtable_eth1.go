@@ -2137,6 +2137,15 @@ func skipBlockIfLastTxIndex(key string) string {
 	return key
 }
 
+// GetAddressTimeIndexPrefix returns the prefix to query the time sorted index of an address (e.g. index "TX" or "ERC20"),
+// rows are sorted from new to old, so passing a timestamp skips all rows of newer blocks
+func (bigtable *Bigtable) GetAddressTimeIndexPrefix(index string, address []byte, timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return fmt.Sprintf("%s:I:%s:%x:%s:", bigtable.chainId, index, address, FILTER_TIME)
+	}
+	return fmt.Sprintf("%s:I:%s:%x:%s:%s", bigtable.chainId, index, address, FILTER_TIME, reversePaddedBigtableTimestamp(timestamp))
+}
+
 func (bigtable *Bigtable) GetEth1TxsForAddress(prefix string, limit int64) ([]*types.Eth1TransactionIndexed, []string, error) {
 	tmr := time.AfterFunc(REPORT_TIMEOUT, func() {
 		log.WarnWithFields(log.Fields{
@@ -960,7 +960,7 @@ func GetTotalEligibleEther() (uint64, error) {
 func GetValidatorsGotSlashed(epoch uint64) ([]struct {
 	Epoch                  uint64 `db:"epoch"`
 	SlasherIndex           uint64 `db:"slasher"`
-	SlasherPubkey          string `db:"slasher_pubkey"`
+	SlasherPubkey          []byte `db:"slasher_pubkey"`
 	SlashedValidatorIndex  uint64 `db:"slashedvalidator"`
 	SlashedValidatorPubkey []byte `db:"slashedvalidator_pubkey"`
 	Reason                 string `db:"reason"`
@@ -968,7 +968,7 @@ func GetValidatorsGotSlashed(epoch uint64) ([]struct {
 	var dbResult []struct {
 		Epoch                  uint64 `db:"epoch"`
 		SlasherIndex           uint64 `db:"slasher"`
-		SlasherPubkey          string `db:"slasher_pubkey"`
+		SlasherPubkey          []byte `db:"slasher_pubkey"`
 		SlashedValidatorIndex  uint64 `db:"slashedvalidator"`
 		SlashedValidatorPubkey []byte `db:"slashedvalidator_pubkey"`
 		Reason                 string `db:"reason"`
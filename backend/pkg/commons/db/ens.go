@@ -525,22 +525,39 @@ func validateEnsName(client *ethclient.Client, name string, alreadyChecked *EnsC
 		isPrimary = true
 	}
 
+	registry, err := go_ens.NewRegistry(client)
+	if err != nil {
+		return fmt.Errorf("error could not get ens registry: %w", err)
+	}
+	owner, err := registry.Owner(name)
+	if err != nil {
+		return fmt.Errorf("error could not get owner of name [%v]: %w", name, err)
+	}
+	resolver, err := registry.ResolverAddress(name)
+	if err != nil {
+		return fmt.Errorf("error could not get resolver of name [%v]: %w", name, err)
+	}
+
 	_, err = WriterDb.Exec(`
 	INSERT INTO ens (
 		name_hash, 
 		ens_name, 
 		address,
 		is_primary_name, 
-		valid_to)
-	VALUES ($1, $2, $3, $4, $5) 
+		valid_to,
+		owner,
+		resolver)
+	VALUES ($1, $2, $3, $4, $5, $6, $7) 
 	ON CONFLICT 
 		(name_hash) 
 	DO UPDATE SET 
 		ens_name = excluded.ens_name,
 		address = excluded.address,
 		is_primary_name = excluded.is_primary_name,
-		valid_to = excluded.valid_to
-	`, nameHash[:], name, addr.Bytes(), isPrimary, expires)
+		valid_to = excluded.valid_to,
+		owner = excluded.owner,
+		resolver = excluded.resolver
+	`, nameHash[:], name, addr.Bytes(), isPrimary, expires, owner.Bytes(), resolver.Bytes())
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%v", err), "invalid byte sequence") {
 			log.Warnf("could not insert ens name [%v]: %v", name, err)
@@ -554,6 +571,7 @@ func validateEnsName(client *ethclient.Client, name string, alreadyChecked *EnsC
 		"address":     addr,
 		"expires":     expires,
 		"reverseName": reverseName,
+		"owner":       owner,
 	}, "validated ens name")
 	return nil
 }
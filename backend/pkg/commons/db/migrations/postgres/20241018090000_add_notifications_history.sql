@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - add notification settings columns';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notifications_do_not_disturb_ts TIMESTAMP WITHOUT TIME ZONE;
+ALTER TABLE users_val_dashboards_groups ADD COLUMN IF NOT EXISTS webhook_target TEXT;
+ALTER TABLE users_val_dashboards_groups ADD COLUMN IF NOT EXISTS webhook_format TEXT;
+ALTER TABLE users_val_dashboards_groups ADD COLUMN IF NOT EXISTS realtime_notifications BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users_acc_dashboards_groups ADD COLUMN IF NOT EXISTS webhook_target TEXT;
+ALTER TABLE users_acc_dashboards_groups ADD COLUMN IF NOT EXISTS webhook_format TEXT;
+ALTER TABLE users_acc_dashboards_groups ADD COLUMN IF NOT EXISTS ignore_spam_transactions BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users_acc_dashboards_groups ADD COLUMN IF NOT EXISTS subscribed_chain_ids BIGINT[] NOT NULL DEFAULT '{}';
+ALTER TABLE notification_queue ADD COLUMN IF NOT EXISTS user_id INT;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'up SQL query - create users_notifications_history table';
+CREATE TABLE IF NOT EXISTS users_notifications_history (
+    id              BIGSERIAL              NOT NULL,
+    user_id         INT                    NOT NULL,
+    subscription_id INT                    NOT NULL,
+    epoch           INT                    NOT NULL,
+    event_name      CHARACTER VARYING(100) NOT NULL,
+    event_filter    TEXT                   NOT NULL DEFAULT '',
+    event_threshold REAL                   NOT NULL DEFAULT 0,
+    dashboard_id    BIGINT,                         -- only set for dashboard subscriptions
+    group_id        INT,                            -- only set for dashboard subscriptions
+    is_account_dashboard BOOLEAN           NOT NULL DEFAULT FALSE,
+    validator_index BIGINT,
+    slot            BIGINT,
+    title           TEXT                   NOT NULL,
+    details         TEXT                   NOT NULL,
+    created_ts      TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_users_notifications_history_user_created ON users_notifications_history (user_id, created_ts);
+CREATE INDEX IF NOT EXISTS idx_users_notifications_history_dashboard ON users_notifications_history (dashboard_id, group_id, epoch);
+CREATE INDEX IF NOT EXISTS idx_notification_queue_user_id_sent ON notification_queue (user_id, sent);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - drop users_notifications_history table';
+DROP TABLE IF EXISTS users_notifications_history;
+DROP INDEX IF EXISTS idx_notification_queue_user_id_sent;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop notification settings columns';
+ALTER TABLE notification_queue DROP COLUMN IF EXISTS user_id;
+ALTER TABLE users_acc_dashboards_groups DROP COLUMN IF EXISTS subscribed_chain_ids;
+ALTER TABLE users_acc_dashboards_groups DROP COLUMN IF EXISTS ignore_spam_transactions;
+ALTER TABLE users_acc_dashboards_groups DROP COLUMN IF EXISTS webhook_format;
+ALTER TABLE users_acc_dashboards_groups DROP COLUMN IF EXISTS webhook_target;
+ALTER TABLE users_val_dashboards_groups DROP COLUMN IF EXISTS realtime_notifications;
+ALTER TABLE users_val_dashboards_groups DROP COLUMN IF EXISTS webhook_format;
+ALTER TABLE users_val_dashboards_groups DROP COLUMN IF EXISTS webhook_target;
+ALTER TABLE users DROP COLUMN IF EXISTS notifications_do_not_disturb_ts;
+-- +goose StatementEnd
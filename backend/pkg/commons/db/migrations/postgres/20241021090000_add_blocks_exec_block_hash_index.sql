@@ -0,0 +1,14 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+SELECT 'create index for block lookups by execution block hash';
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_blocks_exec_block_hash ON public.blocks USING btree (exec_block_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+
+SELECT 'drop index for block lookups by execution block hash';
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_blocks_exec_block_hash;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - add webhook signing secret and retry columns';
+ALTER TABLE users_webhooks ADD COLUMN IF NOT EXISTS secret TEXT;
+ALTER TABLE notification_queue ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE notification_queue ADD COLUMN IF NOT EXISTS next_attempt_ts TIMESTAMP WITHOUT TIME ZONE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'up SQL query - create users_webhooks_deliveries table';
+CREATE TABLE IF NOT EXISTS users_webhooks_deliveries (
+    id               BIGSERIAL              NOT NULL,
+    user_id          INT                    NOT NULL,
+    webhook_id       INT                    NOT NULL,
+    queue_id         INT                    NOT NULL,
+    url              CHARACTER VARYING(1024) NOT NULL,
+    event_name       CHARACTER VARYING(100) NOT NULL,
+    attempt          INT                    NOT NULL,
+    status_code      INT,                            -- not set if no response was received
+    latency_ms       INT                    NOT NULL,
+    response_excerpt TEXT,
+    error            TEXT,
+    success          BOOLEAN                NOT NULL,
+    created_ts       TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_users_webhooks_deliveries_user_id ON users_webhooks_deliveries (user_id, id);
+CREATE INDEX IF NOT EXISTS idx_users_webhooks_deliveries_created_ts ON users_webhooks_deliveries (created_ts);
+CREATE INDEX IF NOT EXISTS idx_notification_queue_next_attempt_ts ON notification_queue (channel, next_attempt_ts) WHERE sent IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - drop users_webhooks_deliveries table';
+DROP INDEX IF EXISTS idx_notification_queue_next_attempt_ts;
+DROP TABLE IF EXISTS users_webhooks_deliveries;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop webhook signing secret and retry columns';
+ALTER TABLE notification_queue DROP COLUMN IF EXISTS next_attempt_ts;
+ALTER TABLE notification_queue DROP COLUMN IF EXISTS attempts;
+ALTER TABLE users_webhooks DROP COLUMN IF EXISTS secret;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - add client credentials and scopes to oauth_apps';
+ALTER TABLE oauth_apps ALTER COLUMN redirect_uri TYPE CHARACTER VARYING(1024);
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS client_id CHARACTER VARYING(64) UNIQUE;
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS client_secret_hash CHARACTER VARYING(64);
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'up SQL query - add pkce and scope columns to oauth_codes';
+ALTER TABLE oauth_codes ADD COLUMN IF NOT EXISTS redirect_uri CHARACTER VARYING(1024);
+ALTER TABLE oauth_codes ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE oauth_codes ADD COLUMN IF NOT EXISTS code_challenge CHARACTER VARYING(128);
+ALTER TABLE oauth_codes ADD COLUMN IF NOT EXISTS code_challenge_method CHARACTER VARYING(10);
+ALTER TABLE oauth_codes ADD COLUMN IF NOT EXISTS expires_ts TIMESTAMP WITHOUT TIME ZONE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_oauth_codes_code ON oauth_codes (code);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'up SQL query - create oauth_tokens table';
+CREATE TABLE IF NOT EXISTS oauth_tokens (
+    id                 BIGSERIAL             NOT NULL,
+    app_id             INT                   NOT NULL,
+    user_id            INT                   NOT NULL,
+    access_token_hash  CHARACTER VARYING(64) NOT NULL UNIQUE,
+    refresh_token_hash CHARACTER VARYING(64) NOT NULL UNIQUE,
+    scopes             TEXT[]                NOT NULL DEFAULT '{}',
+    access_expires_ts  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    refresh_expires_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    revoked            BOOLEAN               NOT NULL DEFAULT 'f',
+    created_ts         TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_oauth_tokens_user_id ON oauth_tokens (user_id, app_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - drop oauth_tokens table';
+DROP TABLE IF EXISTS oauth_tokens;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop pkce and scope columns from oauth_codes';
+DROP INDEX IF EXISTS idx_oauth_codes_code;
+ALTER TABLE oauth_codes DROP COLUMN IF EXISTS expires_ts;
+ALTER TABLE oauth_codes DROP COLUMN IF EXISTS code_challenge_method;
+ALTER TABLE oauth_codes DROP COLUMN IF EXISTS code_challenge;
+ALTER TABLE oauth_codes DROP COLUMN IF EXISTS scopes;
+ALTER TABLE oauth_codes DROP COLUMN IF EXISTS redirect_uri;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop client credentials and scopes from oauth_apps';
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS scopes;
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS client_secret_hash;
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS client_id;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - add name, scope, ip allowlist and revocation to api_keys';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS id SERIAL UNIQUE;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS name CHARACTER VARYING(50) NOT NULL DEFAULT 'default';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS scope CHARACTER VARYING(10) NOT NULL DEFAULT 'write';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS allowed_ips TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS revoked BOOLEAN NOT NULL DEFAULT 'f';
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS created_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW();
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'up SQL query - add index to look up api_statistics by api key';
+CREATE INDEX IF NOT EXISTS idx_api_statistics_apikey_ts ON api_statistics (apikey, ts);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - drop index to look up api_statistics by api key';
+DROP INDEX IF EXISTS idx_api_statistics_apikey_ts;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop name, scope, ip allowlist and revocation from api_keys';
+DROP INDEX IF EXISTS idx_api_keys_user_id;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS created_ts;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS revoked;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS allowed_ips;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS scope;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS name;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS id;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - add owner and resolver to ens';
+ALTER TABLE ens ADD COLUMN IF NOT EXISTS owner bytea;
+ALTER TABLE ens ADD COLUMN IF NOT EXISTS resolver bytea;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - remove owner and resolver from ens';
+ALTER TABLE ens DROP COLUMN IF EXISTS owner;
+ALTER TABLE ens DROP COLUMN IF EXISTS resolver;
+-- +goose StatementEnd
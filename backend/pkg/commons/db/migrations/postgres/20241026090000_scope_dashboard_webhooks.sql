@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query - scope users_webhooks to dashboard groups';
+ALTER TABLE users_webhooks ADD COLUMN IF NOT EXISTS event_filter TEXT; -- dashboard group the webhook belongs to, NULL for account wide webhooks
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_webhooks_event_filter ON users_webhooks (user_id, event_filter) WHERE event_filter IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query - drop dashboard group scope from users_webhooks';
+DROP INDEX IF EXISTS idx_users_webhooks_event_filter;
+ALTER TABLE users_webhooks DROP COLUMN IF EXISTS event_filter;
+-- +goose StatementEnd
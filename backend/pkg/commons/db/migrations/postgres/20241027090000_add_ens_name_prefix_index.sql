@@ -0,0 +1,20 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+
+-- +goose StatementBegin
+SELECT 'up SQL query - add ens name prefix index';
+-- +goose StatementEnd
+-- +goose StatementBegin
+-- idx_ens_name uses the default collation and can't serve LIKE 'prefix%' queries
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_ens_name_prefix ON ens (ens_name text_pattern_ops, valid_to);
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- +goose StatementBegin
+SELECT 'down SQL query - drop ens name prefix index';
+-- +goose StatementEnd
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_ens_name_prefix;
+-- +goose StatementEnd
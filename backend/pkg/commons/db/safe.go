@@ -0,0 +1,390 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	safeContracts "github.com/gobitfly/beaconchain/pkg/commons/contracts/safe"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+
+	gcp_bigtable "cloud.google.com/go/bigtable"
+	"github.com/coocood/freecache"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	SAFE_INDEX        = "SAFE"
+	SAFE_CONFIG_INDEX = "SAFE_CONFIG"
+	SAFE_TX_INDEX     = "SAFE_TX"
+)
+
+// TransformSafe accepts an eth1 block and creates bigtable mutations for events of Safe (formerly Gnosis Safe) multisig wallets.
+// It indexes the setup of a safe, owner and threshold changes, executed safe transactions and on-chain confirmations (approved hashes).
+// Executions sent directly to the safe also get the decoded execTransaction call data and the owners that signed the safe transaction.
+// Setups are only indexed for proxies created by an official proxy factory for an official singleton, the api requires the setup
+// of an address to treat it as a safe.
+// It writes safe events to the table data:
+// Row:    <chainID>:SAFE:<txHash>:<paddedLogIndex>
+// Family: f
+// Column: data
+// Cell:   JSON<Eth1SafeEventIndexed>
+//
+// It indexes setup, owner and threshold change events by:
+// Row:    <chainID>:I:SAFE_CONFIG:<SAFE_ADDRESS>:TIME:<reversePaddedBigtableTimestamp>:<paddedTxIndex>:<PaddedLogIndex>
+// Family: f
+// Column: <chainID>:SAFE:<txHash>:<paddedLogIndex>
+// Cell:   nil
+//
+// It indexes executions by:
+// Row:    <chainID>:I:SAFE:<SAFE_ADDRESS>:TIME:<reversePaddedBigtableTimestamp>:<paddedTxIndex>:<PaddedLogIndex>
+// Family: f
+// Column: <chainID>:SAFE:<txHash>:<paddedLogIndex>
+// Cell:   nil
+//
+// It indexes executions and approved hashes by:
+// Row:    <chainID>:I:SAFE_TX:<SAFE_ADDRESS>:<SAFE_TX_HASH>:TIME:<reversePaddedBigtableTimestamp>:<paddedTxIndex>:<PaddedLogIndex>
+// Family: f
+// Column: <chainID>:SAFE:<txHash>:<paddedLogIndex>
+// Cell:   nil
+func (bigtable *Bigtable) TransformSafe(blk *types.Eth1Block, cache *freecache.Cache) (bulkData *types.BulkMutations, bulkMetadataUpdates *types.BulkMutations, err error) {
+	bulkData = &types.BulkMutations{}
+	bulkMetadataUpdates = &types.BulkMutations{}
+
+	for i, tx := range blk.GetTransactions() {
+		if i >= TX_PER_BLOCK_LIMIT {
+			return nil, nil, fmt.Errorf("unexpected number of transactions in block expected at most %d but got: %v, tx: %x", TX_PER_BLOCK_LIMIT-1, i, tx.GetHash())
+		}
+		iReversed := reversePaddedIndex(i, TX_PER_BLOCK_LIMIT)
+		createdSafes := getCreatedSafes(tx.GetLogs())
+		for j, log := range tx.GetLogs() {
+			if j >= ITX_PER_TX_LIMIT {
+				return nil, nil, fmt.Errorf("unexpected number of logs in block expected at most %d but got: %v tx: %x", ITX_PER_TX_LIMIT-1, j, tx.GetHash())
+			}
+			jReversed := reversePaddedIndex(j, ITX_PER_TX_LIMIT)
+
+			event := parseSafeEvent(log.GetTopics(), log.GetData())
+			if event == nil {
+				continue
+			}
+			if event.Type == types.SafeEventSetup && !createdSafes[common.BytesToAddress(log.GetAddress())] {
+				continue
+			}
+			event.Safe = log.GetAddress()
+			event.TxHash = tx.GetHash()
+			event.TxFrom = tx.GetFrom()
+			event.BlockNumber = blk.GetNumber()
+			event.Time = blk.GetTime().AsTime()
+			event.LogIndex = uint64(j)
+
+			if (event.Type == types.SafeEventExecutionSuccess || event.Type == types.SafeEventExecutionFailure) && bytes.Equal(tx.GetTo(), log.GetAddress()) {
+				decodeSafeExecTransaction(event, tx.GetData())
+			}
+
+			key := fmt.Sprintf("%s:%s:%x:%s", bigtable.chainId, SAFE_INDEX, tx.GetHash(), jReversed)
+
+			b, err := json.Marshal(event)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			mut := gcp_bigtable.NewMutation()
+			mut.Set(DEFAULT_FAMILY, DATA_COLUMN, gcp_bigtable.Timestamp(0), b)
+
+			bulkData.Keys = append(bulkData.Keys, key)
+			bulkData.Muts = append(bulkData.Muts, mut)
+
+			indexes := []string{}
+			switch event.Type {
+			case types.SafeEventSetup, types.SafeEventAddedOwner, types.SafeEventRemovedOwner, types.SafeEventChangedThreshold:
+				indexes = append(indexes, fmt.Sprintf("%s:I:%s:%x:%s:%s:%s:%s", bigtable.chainId, SAFE_CONFIG_INDEX, event.Safe, FILTER_TIME, reversePaddedBigtableTimestamp(blk.GetTime()), iReversed, jReversed))
+			case types.SafeEventExecutionSuccess, types.SafeEventExecutionFailure:
+				indexes = append(indexes,
+					fmt.Sprintf("%s:I:%s:%x:%s:%s:%s:%s", bigtable.chainId, SAFE_INDEX, event.Safe, FILTER_TIME, reversePaddedBigtableTimestamp(blk.GetTime()), iReversed, jReversed),
+					fmt.Sprintf("%s:I:%s:%x:%x:%s:%s:%s:%s", bigtable.chainId, SAFE_TX_INDEX, event.Safe, event.SafeTxHash, FILTER_TIME, reversePaddedBigtableTimestamp(blk.GetTime()), iReversed, jReversed),
+				)
+			case types.SafeEventApproveHash:
+				// safe tx hashes are only unique per safe, anyone can emit events with the hash of another safe's transaction
+				indexes = append(indexes, fmt.Sprintf("%s:I:%s:%x:%x:%s:%s:%s:%s", bigtable.chainId, SAFE_TX_INDEX, event.Safe, event.SafeTxHash, FILTER_TIME, reversePaddedBigtableTimestamp(blk.GetTime()), iReversed, jReversed))
+			}
+
+			for _, idx := range indexes {
+				mut := gcp_bigtable.NewMutation()
+				mut.Set(DEFAULT_FAMILY, key, gcp_bigtable.Timestamp(0), nil)
+
+				bulkData.Keys = append(bulkData.Keys, idx)
+				bulkData.Muts = append(bulkData.Muts, mut)
+			}
+		}
+	}
+
+	return bulkData, bulkMetadataUpdates, nil
+}
+
+// GetSafeTxIndexPrefix returns the prefix of the SAFE_TX index rows of a safe transaction
+func (bigtable *Bigtable) GetSafeTxIndexPrefix(safe []byte, safeTxHash []byte) string {
+	return fmt.Sprintf("%s:I:%s:%x:%x:%s:", bigtable.chainId, SAFE_TX_INDEX, safe, safeTxHash, FILTER_TIME)
+}
+
+// getCreatedSafes returns the proxies created by an official proxy factory for an official singleton in the logs of a transaction
+func getCreatedSafes(logs []*types.Eth1Log) map[common.Address]bool {
+	created := make(map[common.Address]bool)
+	for _, log := range logs {
+		topics, data := log.GetTopics(), log.GetData()
+		if len(topics) == 0 || !bytes.Equal(topics[0], safeContracts.ProxyCreationTopic) || !safeContracts.ProxyFactories[common.BytesToAddress(log.GetAddress())] {
+			continue
+		}
+		var proxy, singleton []byte
+		switch {
+		case len(topics) == 2 && len(data) == 32:
+			proxy, singleton = topics[1], data
+		case len(topics) == 1 && len(data) == 64:
+			proxy, singleton = data[:32], data[32:]
+		default:
+			continue
+		}
+		if safeContracts.Singletons[common.BytesToAddress(singleton)] {
+			created[common.BytesToAddress(proxy)] = true
+		}
+	}
+	return created
+}
+
+// parseSafeEvent decodes a safe event log, returns nil if the log is not a (valid) safe event.
+// Safe v1.4.0 made some of the event parameters indexed, both variants are supported by checking the number of topics.
+func parseSafeEvent(topics [][]byte, data []byte) *types.Eth1SafeEventIndexed {
+	if len(topics) == 0 {
+		return nil
+	}
+	topic := topics[0]
+
+	switch {
+	case bytes.Equal(topic, safeContracts.SafeSetupTopic):
+		if len(topics) != 2 {
+			return nil
+		}
+		values, err := safeContracts.SafeParsedABI.Events["SafeSetup"].Inputs.NonIndexed().Unpack(data)
+		if err != nil || len(values) != 4 {
+			return nil
+		}
+		owners, ok := values[0].([]common.Address)
+		if !ok {
+			return nil
+		}
+		threshold, ok := values[1].(*big.Int)
+		if !ok || !threshold.IsUint64() {
+			return nil
+		}
+		fallbackHandler, ok := values[3].(common.Address)
+		if !ok {
+			return nil
+		}
+		event := &types.Eth1SafeEventIndexed{
+			Type:            types.SafeEventSetup,
+			Owners:          make([][]byte, 0, len(owners)),
+			Threshold:       threshold.Uint64(),
+			Initiator:       common.BytesToAddress(topics[1]).Bytes(),
+			FallbackHandler: fallbackHandler.Bytes(),
+		}
+		for _, owner := range owners {
+			event.Owners = append(event.Owners, owner.Bytes())
+		}
+		return event
+	case bytes.Equal(topic, safeContracts.AddedOwnerTopic), bytes.Equal(topic, safeContracts.RemovedOwnerTopic):
+		eventType := types.SafeEventAddedOwner
+		if bytes.Equal(topic, safeContracts.RemovedOwnerTopic) {
+			eventType = types.SafeEventRemovedOwner
+		}
+		var owner []byte
+		switch {
+		case len(topics) == 2 && len(data) == 0:
+			owner = topics[1]
+		case len(topics) == 1 && len(data) == 32:
+			owner = data
+		default:
+			return nil
+		}
+		return &types.Eth1SafeEventIndexed{
+			Type:  eventType,
+			Owner: common.BytesToAddress(owner).Bytes(),
+		}
+	case bytes.Equal(topic, safeContracts.ChangedThresholdTopic):
+		if len(topics) != 1 || len(data) != 32 {
+			return nil
+		}
+		threshold := new(big.Int).SetBytes(data)
+		if !threshold.IsUint64() {
+			return nil
+		}
+		return &types.Eth1SafeEventIndexed{
+			Type:      types.SafeEventChangedThreshold,
+			Threshold: threshold.Uint64(),
+		}
+	case bytes.Equal(topic, safeContracts.ExecutionSuccessTopic), bytes.Equal(topic, safeContracts.ExecutionFailureTopic):
+		eventType := types.SafeEventExecutionSuccess
+		if bytes.Equal(topic, safeContracts.ExecutionFailureTopic) {
+			eventType = types.SafeEventExecutionFailure
+		}
+		var safeTxHash, payment []byte
+		switch {
+		case len(topics) == 2 && len(data) == 32:
+			safeTxHash, payment = topics[1], data
+		case len(topics) == 1 && len(data) == 64:
+			safeTxHash, payment = data[:32], data[32:]
+		default:
+			return nil
+		}
+		return &types.Eth1SafeEventIndexed{
+			Type:       eventType,
+			SafeTxHash: safeTxHash,
+			Payment:    new(big.Int).SetBytes(payment).Bytes(),
+		}
+	case bytes.Equal(topic, safeContracts.ApproveHashTopic):
+		if len(topics) != 3 {
+			return nil
+		}
+		return &types.Eth1SafeEventIndexed{
+			Type:       types.SafeEventApproveHash,
+			SafeTxHash: topics[1],
+			Owner:      common.BytesToAddress(topics[2]).Bytes(),
+		}
+	}
+	return nil
+}
+
+// decodeSafeExecTransaction adds the decoded execTransaction call and the signers of the safe transaction to an execution event
+func decodeSafeExecTransaction(event *types.Eth1SafeEventIndexed, input []byte) {
+	method := safeContracts.SafeParsedABI.Methods["execTransaction"]
+	if len(input) < 4 || !bytes.Equal(input[:4], method.ID) {
+		return
+	}
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil || len(values) != 10 {
+		return
+	}
+	to, ok1 := values[0].(common.Address)
+	value, ok2 := values[1].(*big.Int)
+	data, ok3 := values[2].([]byte)
+	operation, ok4 := values[3].(uint8)
+	signatures, ok5 := values[9].([]byte)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+		return
+	}
+
+	event.To = to.Bytes()
+	event.Value = value.Bytes()
+	event.Operation = operation
+	if len(data) >= 4 {
+		event.MethodId = data[:4]
+	}
+	event.Signers = recoverSafeSigners(event.SafeTxHash, signatures)
+}
+
+// recoverSafeSigners returns the owners that signed a safe transaction, see Safe.checkNSignatures for the encoding of the signatures
+func recoverSafeSigners(safeTxHash []byte, signatures []byte) []types.Eth1SafeSigner {
+	signers := []types.Eth1SafeSigner{}
+	// the dynamic part of contract signatures follows the static part, its start is the lowest offset referenced by a contract signature
+	staticEnd := len(signatures)
+	for pos := 0; pos+65 <= staticEnd; pos += 65 {
+		r := signatures[pos : pos+32]
+		s := signatures[pos+32 : pos+64]
+		v := signatures[pos+64]
+
+		switch {
+		case v == 0:
+			offset := new(big.Int).SetBytes(s)
+			if offset.IsInt64() && offset.Int64() < int64(staticEnd) {
+				staticEnd = int(offset.Int64())
+			}
+			signers = append(signers, types.Eth1SafeSigner{Owner: common.BytesToAddress(r).Bytes(), Type: types.SafeSignatureContract})
+		case v == 1:
+			signers = append(signers, types.Eth1SafeSigner{Owner: common.BytesToAddress(r).Bytes(), Type: types.SafeSignatureApprovedHash})
+		default:
+			hash := safeTxHash
+			if v > 30 {
+				// eth_sign flow, the owner signed the prefixed safe tx hash
+				hash = crypto.Keccak256(append([]byte("\x19Ethereum Signed Message:\n32"), safeTxHash...))
+				v -= 4
+			}
+			if v < 27 {
+				continue
+			}
+			sig := make([]byte, 65)
+			copy(sig, signatures[pos:pos+64])
+			sig[64] = v - 27
+			pubKey, err := crypto.SigToPub(hash, sig)
+			if err != nil {
+				continue
+			}
+			signers = append(signers, types.Eth1SafeSigner{Owner: crypto.PubkeyToAddress(*pubKey).Bytes(), Type: types.SafeSignatureEOA})
+		}
+	}
+	return signers
+}
+
+// GetSafeEvents returns the safe events of an index prefix (e.g. the prefix of the "SAFE" index of a safe) sorted from new to old, along with the consumed index rows
+func (bigtable *Bigtable) GetSafeEvents(prefix string, limit int64) ([]*types.Eth1SafeEventIndexed, []string, error) {
+	tmr := time.AfterFunc(REPORT_TIMEOUT, func() {
+		log.WarnWithFields(log.Fields{
+			"prefix":   prefix,
+			"limit":    limit,
+			"func":     utils.GetCurrentFuncName(),
+			"duration": REPORT_TIMEOUT,
+		}, "call took longer than expected")
+	})
+	defer tmr.Stop()
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second*30))
+	defer cancel()
+
+	// add \x00 to the row range such that we skip the previous value
+	rowRange := gcp_bigtable.NewRange(prefix+"\x00", prefixSuccessor(prefix, 5))
+	data := make([]*types.Eth1SafeEventIndexed, 0, limit)
+	keys := make([]string, 0, limit)
+	indexes := make([]string, 0, limit)
+
+	keysMap := make(map[string]*types.Eth1SafeEventIndexed, limit)
+	err := bigtable.tableData.ReadRows(ctx, rowRange, func(row gcp_bigtable.Row) bool {
+		keys = append(keys, strings.TrimPrefix(row[DEFAULT_FAMILY][0].Column, "f:"))
+		indexes = append(indexes, row.Key())
+		return true
+	}, gcp_bigtable.LimitRows(limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keys) == 0 {
+		return data, nil, nil
+	}
+
+	indexes, keys = bigtable.rearrangeReversePaddedIndexZero(ctx, indexes, keys)
+
+	err = bigtable.tableData.ReadRows(ctx, gcp_bigtable.RowList(keys), func(row gcp_bigtable.Row) bool {
+		b := &types.Eth1SafeEventIndexed{}
+		err := json.Unmarshal(row[DEFAULT_FAMILY][0].Value, b)
+		if err != nil {
+			log.Error(err, "error parsing Eth1SafeEventIndexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		keysMap[row.Key()] = b
+		return true
+	})
+	if err != nil {
+		log.Error(err, "error reading rows in safe / GetSafeEvents", 0, map[string]interface{}{"prefix": prefix, "limit": limit})
+		return nil, nil, err
+	}
+
+	for _, key := range keys {
+		if d := keysMap[key]; d != nil {
+			data = append(data, d)
+		}
+	}
+
+	return data, indexes, nil
+}
@@ -78,6 +78,11 @@ var (
 		Name: "counter",
 		Help: "Generic counter of events with name in labels",
 	}, []string{"name"})
+	ChainReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "chain_reorg_depth",
+		Help:    "Depth in slots of observed chain reorgs",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+	}, []string{"module"})
 )
 
 func init() {
@@ -119,6 +124,11 @@ func (r *responseWriterDelegator) WriteHeader(code int) {
 	r.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap exposes the underlying writer to http.ResponseController, e.g. for flushing streamed responses
+func (r *responseWriterDelegator) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func (r *responseWriterDelegator) Write(b []byte) (int, error) {
 	if !r.wroteHeader {
 		r.WriteHeader(http.StatusOK)
@@ -172,6 +172,11 @@ func (r *responseWriterDelegator) WriteHeader(code int) {
 	r.wroteHeader = true
 }
 
+// Unwrap exposes the underlying writer to http.ResponseController, e.g. for flushing streamed responses
+func (r *responseWriterDelegator) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func (r *responseWriterDelegator) Status() int {
 	return r.status
 }
@@ -928,7 +933,7 @@ func max(vals ...int64) int64 {
 
 // getKey returns the key used for RateLimiting. It first checks the query params, then the header and finally the ip address.
 func getKey(r *http.Request) (key, ip string) {
-	ip = getIP(r)
+	ip = GetIP(r)
 	key = r.URL.Query().Get("apikey")
 	if key != "" {
 		return key, ip
@@ -969,8 +974,8 @@ func getRoute(r *http.Request) string {
 	return pathTpl
 }
 
-// getIP returns the ip address from the http request
-func getIP(r *http.Request) string {
+// GetIP returns the ip address from the http request
+func GetIP(r *http.Request) string {
 	ips := r.Header.Get("CF-Connecting-IP")
 	if ips == "" {
 		ips = r.Header.Get("X-Forwarded-For")
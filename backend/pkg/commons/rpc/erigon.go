@@ -649,6 +649,38 @@ func (client *ErigonClient) GetERC20TokenBalance(address string, token string) (
 	return balance, nil
 }
 
+// GetNativeBalanceAt returns the ether balance of an address at the end of the given block
+func (client *ErigonClient) GetNativeBalanceAt(ctx context.Context, address []byte, blockNumber uint64) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	return client.ethClient.BalanceAt(ctx, common.BytesToAddress(address), new(big.Int).SetUint64(blockNumber))
+}
+
+// GetERC20TokenBalanceAt returns the token balance of an address at the end of the given block
+func (client *ErigonClient) GetERC20TokenBalanceAt(ctx context.Context, address []byte, token []byte, blockNumber uint64) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	contract, err := erc20.NewErc20(common.BytesToAddress(token), client.ethClient)
+	if err != nil {
+		return nil, err
+	}
+	return contract.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}, common.BytesToAddress(address))
+}
+
+// GetERC20TotalSupplyAt returns the total supply of a token at the end of the given block
+func (client *ErigonClient) GetERC20TotalSupplyAt(ctx context.Context, token []byte, blockNumber uint64) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	contract, err := erc20.NewErc20(common.BytesToAddress(token), client.ethClient)
+	if err != nil {
+		return nil, err
+	}
+	return contract.TotalSupply(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)})
+}
+
 func (client *ErigonClient) GetERC20TokenMetadata(token []byte) (*types.ERC20Metadata, error) {
 	log.Infof("retrieving metadata for token %x", token)
 
@@ -313,6 +313,7 @@ type Config struct {
 
 	ApiKeySecret     string   `yaml:"apiKeySecret" envconfig:"API_KEY_SECRET"`
 	CorsAllowedHosts []string `yaml:"corsAllowedHosts" envconfig:"CORS_ALLOWED_HOSTS"`
+	TrustedProxies   []string `yaml:"trustedProxies" envconfig:"TRUSTED_PROXIES"` // addresses or CIDR ranges whose forwarding headers are trusted
 }
 
 type InternalAlertDiscord struct {
@@ -7,3 +7,55 @@ type GetBlockTimings struct {
 	Receipts time.Duration
 	Traces   time.Duration
 }
+
+type SafeEventType string
+
+const (
+	SafeEventSetup            SafeEventType = "setup"
+	SafeEventAddedOwner       SafeEventType = "added_owner"
+	SafeEventRemovedOwner     SafeEventType = "removed_owner"
+	SafeEventChangedThreshold SafeEventType = "changed_threshold"
+	SafeEventExecutionSuccess SafeEventType = "execution_success"
+	SafeEventExecutionFailure SafeEventType = "execution_failure"
+	SafeEventApproveHash      SafeEventType = "approve_hash"
+)
+
+type SafeSignatureType string
+
+const (
+	SafeSignatureEOA          SafeSignatureType = "signature"
+	SafeSignatureApprovedHash SafeSignatureType = "approved_hash"
+	SafeSignatureContract     SafeSignatureType = "contract_signature"
+)
+
+type Eth1SafeSigner struct {
+	Owner []byte            `json:"owner"`
+	Type  SafeSignatureType `json:"type"`
+}
+
+// Eth1SafeEventIndexed is a single event of a Safe (formerly Gnosis Safe) multisig wallet, fields are only set if they apply to the event type
+type Eth1SafeEventIndexed struct {
+	Type        SafeEventType `json:"type"`
+	Safe        []byte        `json:"safe"`
+	TxHash      []byte        `json:"tx_hash"`
+	TxFrom      []byte        `json:"tx_from"`
+	BlockNumber uint64        `json:"block_number"`
+	Time        time.Time     `json:"time"`
+	LogIndex    uint64        `json:"log_index"`
+
+	// setup and owner / threshold changes
+	Owners          [][]byte `json:"owners,omitempty"`
+	Owner           []byte   `json:"owner,omitempty"`
+	Threshold       uint64   `json:"threshold,omitempty"`
+	Initiator       []byte   `json:"initiator,omitempty"`
+	FallbackHandler []byte   `json:"fallback_handler,omitempty"`
+
+	// executions and confirmations
+	SafeTxHash []byte           `json:"safe_tx_hash,omitempty"`
+	Payment    []byte           `json:"payment,omitempty"`
+	To         []byte           `json:"to,omitempty"`
+	Value      []byte           `json:"value,omitempty"`
+	Operation  uint8            `json:"operation,omitempty"`
+	MethodId   []byte           `json:"method_id,omitempty"`
+	Signers    []Eth1SafeSigner `json:"signers,omitempty"`
+}
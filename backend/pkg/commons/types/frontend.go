@@ -5,7 +5,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"html/template"
+	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -52,6 +54,17 @@ const (
 	//nolint:misspell
 	RocketpoolCollateralMaxReached EventName = "rocketpool_colleteral_max"
 	SyncCommitteeSoon              EventName = "validator_synccommittee_soon"
+
+	ValidatorUpcomingProposalEventName         EventName = "validator_proposal_upcoming"
+	ValidatorGroupIsOfflineEventName           EventName = "validator_group_is_offline"
+	NetworkGasAboveThresholdEventName          EventName = "network_gas_above_threshold"
+	NetworkGasBelowThresholdEventName          EventName = "network_gas_below_threshold"
+	NetworkParticipationRateThresholdEventName EventName = "network_participation_rate_threshold"
+	IncomingTransactionEventName               EventName = "incoming_tx"
+	OutgoingTransactionEventName               EventName = "outgoing_tx"
+	ERC20TokenTransferEventName                EventName = "transfer_erc20"
+	ERC721TokenTransferEventName               EventName = "transfer_erc721"
+	ERC1155TokenTransferEventName              EventName = "transfer_erc1155"
 )
 
 var MachineEvents = []EventName{
@@ -105,6 +118,16 @@ var EventLabel map[EventName]string = map[EventName]string{
 	RocketpoolCollateralMinReached:                   "You reached the Rocket Pool min RPL collateral",
 	RocketpoolCollateralMaxReached:                   "You reached the Rocket Pool max RPL collateral",
 	SyncCommitteeSoon:                                "Your validator(s) will soon be part of the sync committee",
+	ValidatorUpcomingProposalEventName:               "Your validator(s) will soon propose a block",
+	ValidatorGroupIsOfflineEventName:                 "A share of your validator group is offline",
+	NetworkGasAboveThresholdEventName:                "The network gas price is above your threshold",
+	NetworkGasBelowThresholdEventName:                "The network gas price is below your threshold",
+	NetworkParticipationRateThresholdEventName:       "The network participation rate is below your threshold",
+	IncomingTransactionEventName:                     "Your account(s) received a transaction",
+	OutgoingTransactionEventName:                     "Your account(s) sent a transaction",
+	ERC20TokenTransferEventName:                      "Your account(s) transferred ERC20 tokens",
+	ERC721TokenTransferEventName:                     "Your account(s) transferred ERC721 tokens",
+	ERC1155TokenTransferEventName:                    "Your account(s) transferred ERC1155 tokens",
 }
 
 func IsUserIndexed(event EventName) bool {
@@ -154,6 +177,16 @@ var EventNames = []EventName{
 	RocketpoolCollateralMinReached,
 	RocketpoolCollateralMaxReached,
 	SyncCommitteeSoon,
+	ValidatorUpcomingProposalEventName,
+	ValidatorGroupIsOfflineEventName,
+	NetworkGasAboveThresholdEventName,
+	NetworkGasBelowThresholdEventName,
+	NetworkParticipationRateThresholdEventName,
+	IncomingTransactionEventName,
+	OutgoingTransactionEventName,
+	ERC20TokenTransferEventName,
+	ERC721TokenTransferEventName,
+	ERC1155TokenTransferEventName,
 }
 
 type EventNameDesc struct {
@@ -253,6 +286,36 @@ type Notification interface {
 
 // func UnMarschal
 
+// Dashboard subscriptions are stored with an event filter of the form "vdb:<dashboard_id>:<group_id>"
+// (or "adb:..." for account dashboards) instead of a single pubkey or address.
+// They are expanded to the entities of the group when notifications are collected.
+const (
+	ValidatorDashboardEventPrefix = "vdb"
+	AccountDashboardEventPrefix   = "adb"
+)
+
+// DashboardEventFilter returns the event filter used for subscriptions of a dashboard group
+func DashboardEventFilter(prefix string, dashboardId, groupId uint64) string {
+	return fmt.Sprintf("%s:%d:%d", prefix, dashboardId, groupId)
+}
+
+// ParseDashboardEventFilter parses an event filter created by DashboardEventFilter
+func ParseDashboardEventFilter(eventFilter string) (dashboardId uint64, groupId uint64, err error) {
+	parts := strings.Split(eventFilter, ":")
+	if len(parts) != 3 || (parts[0] != ValidatorDashboardEventPrefix && parts[0] != AccountDashboardEventPrefix) {
+		return 0, 0, errors.Errorf("invalid dashboard event filter %v", eventFilter)
+	}
+	dashboardId, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid dashboard id in event filter %v", eventFilter)
+	}
+	groupId, err = strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid group id in event filter %v", eventFilter)
+	}
+	return dashboardId, groupId, nil
+}
+
 type Subscription struct {
 	ID          *uint64    `db:"id,omitempty"`
 	UserID      *uint64    `db:"user_id,omitempty"`
@@ -362,8 +425,11 @@ type TransitWebhook struct {
 	Created sql.NullTime `db:"created"`
 	Sent    sql.NullTime `db:"sent"`
 	// Delivered sql.NullTime          `db:"delivered"`
-	Channel string                `db:"channel"`
-	Content TransitWebhookContent `db:"content"`
+	Channel  string                `db:"channel"`
+	Content  TransitWebhookContent `db:"content"`
+	Attempts uint64                `db:"attempts"`
+	UserId   sql.NullInt64         `db:"user_id"`
+	Secret   sql.NullString        `db:"secret"` // signing secret of the webhook, never part of the content
 }
 
 type TransitWebhookContent struct {
@@ -468,6 +534,7 @@ type UserWebhook struct {
 	Request     sql.NullString `db:"request" json:"request"`
 	Destination sql.NullString `db:"destination" json:"destination"`
 	EventNames  pq.StringArray `db:"event_names" json:"-"`
+	EventFilter sql.NullString `db:"event_filter" json:"-"` // set for webhooks of a dashboard group
 }
 
 type UserWebhookSubscriptions struct {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type NodeJobStatus string
@@ -22,11 +23,15 @@ type NodeJobType string
 
 const BLSToExecutionChangesNodeJobType NodeJobType = "BLS_TO_EXECUTION_CHANGES"
 const VoluntaryExitsNodeJobType NodeJobType = "VOLUNTARY_EXITS"
+const AttestationsNodeJobType NodeJobType = "ATTESTATIONS"
+const ExecutionTransactionNodeJobType NodeJobType = "EXECUTION_TRANSACTION"
 const UnknownNodeJobType NodeJobType = "UNKNOWN"
 
 var NodeJobTypes = []NodeJobType{
 	BLSToExecutionChangesNodeJobType,
 	VoluntaryExitsNodeJobType,
+	AttestationsNodeJobType,
+	ExecutionTransactionNodeJobType,
 }
 
 func NewNodeJob(data []byte) (*NodeJob, error) {
@@ -99,6 +104,31 @@ func (nj *NodeJob) ParseData() error {
 			return nj.SanitizeRawData()
 		}
 	}
+	{
+		d := []*phase0.Attestation{}
+		err := json.Unmarshal(nj.RawData, &d)
+		if err == nil && len(d) > 0 {
+			if nj.Type != "" && nj.Type != UnknownNodeJobType && nj.Type != AttestationsNodeJobType {
+				return fmt.Errorf("nodejob.RawData mismatches nodejob.Type (%v)", nj.Type)
+			}
+			nj.Type = AttestationsNodeJobType
+			nj.Data = d
+			return nj.SanitizeRawData()
+		}
+	}
+	{
+		// raw signed execution layer transaction, hex encoded
+		var d hexutil.Bytes
+		err := json.Unmarshal(nj.RawData, &d)
+		if err == nil && len(d) > 0 {
+			if nj.Type != "" && nj.Type != UnknownNodeJobType && nj.Type != ExecutionTransactionNodeJobType {
+				return fmt.Errorf("nodejob.RawData mismatches nodejob.Type (%v)", nj.Type)
+			}
+			nj.Type = ExecutionTransactionNodeJobType
+			nj.Data = d
+			return nj.SanitizeRawData()
+		}
+	}
 	return CreateNodeJobUserError{Message: "can not unmarshal data: invalid json"}
 }
 
@@ -120,3 +150,13 @@ func (nj NodeJob) GetVoluntaryExitsNodeJobData() (*phase0.SignedVoluntaryExit, b
 	d, ok := nj.Data.(*phase0.SignedVoluntaryExit)
 	return d, ok
 }
+
+func (nj NodeJob) GetAttestationsNodeJobData() ([]*phase0.Attestation, bool) {
+	d, ok := nj.Data.([]*phase0.Attestation)
+	return d, ok
+}
+
+func (nj NodeJob) GetExecutionTransactionNodeJobData() (hexutil.Bytes, bool) {
+	d, ok := nj.Data.(hexutil.Bytes)
+	return d, ok
+}
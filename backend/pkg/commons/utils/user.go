@@ -3,6 +3,7 @@ package utils
 import (
 	securerand "crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"math/big"
 	"slices"
 	"strings"
@@ -47,6 +48,15 @@ func GenerateRandomBytesSecure(n int) ([]byte, error) {
 	return b, nil
 }
 
+// GenerateWebhookSecret generates the secret used to sign the requests of a user webhook
+func GenerateWebhookSecret() (string, error) {
+	b, err := GenerateRandomBytesSecure(32)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // As a safety precaution we don't want to expose the full email address via the API
 // We can rest assured that even if a user session ever leaks, no personal data is provided via api that could link the users addresses or validators to them
 func CensorEmail(mail string) string {
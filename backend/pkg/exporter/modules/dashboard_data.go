@@ -85,6 +85,7 @@ type dashboardData struct {
 	epochToDay        *epochToDayAggregator
 	dayUp             *dayUpAggregator
 	headEpochQueue    chan uint64
+	reorgEpochQueue   chan uint64
 	backFillCompleted bool
 	responseCache     ResponseCache
 }
@@ -109,6 +110,9 @@ func NewDashboardDataModule(moduleContext ModuleContext) ModuleInterface {
 	// This channel is used to queue up epochs from chain head that need to be exported
 	temp.headEpochQueue = make(chan uint64, 100)
 
+	// This channel is used to queue up the first epoch of reorgs that affect already exported epochs
+	temp.reorgEpochQueue = make(chan uint64, 100)
+
 	// Indicates whether the initial backfill - which is checked when starting the exporter - has completed
 	// and the exporter can start listening for new head epochs to be processed
 	temp.backFillCompleted = false
@@ -164,7 +168,14 @@ func (d *dashboardData) Init() error {
 func (d *dashboardData) processHeadQueue() {
 	reachedHead := false
 	for {
-		epoch := <-d.headEpochQueue
+		var epoch uint64
+		select {
+		case fromEpoch := <-d.reorgEpochQueue:
+			// Reorgs are handled in the same routine as head epochs so they never run concurrently with the head export and aggregation
+			d.processReorg(fromEpoch)
+			continue
+		case epoch = <-d.headEpochQueue:
+		}
 
 		// After initial sync or long downtime first head processing might take a long time, so by the time we finished
 		// the queue might have filled up significantly. To get back on head more quickly we skip some epochs and let the backfill handle those
@@ -997,6 +1008,35 @@ func (d *dashboardData) OnHead(event *constypes.StandardEventHeadResponse) error
 }
 
 func (d *dashboardData) OnChainReorg(event *constypes.StandardEventChainReorg) error {
+	metrics.Tasks.WithLabelValues("exporter_v2dash_reorg").Inc()
+	metrics.ChainReorgDepth.WithLabelValues("exporter_v2dash").Observe(float64(event.Depth))
+
+	firstReorgedEpoch := getFirstReorgedEpoch(event.Slot, event.Depth)
+	d.log.InfoWithFields(map[string]interface{}{"slot": event.Slot, "depth": event.Depth, "epoch": event.Epoch, "first_reorged_epoch": firstReorgedEpoch}, "chain reorg detected")
+
+	if !d.backFillCompleted {
+		return nil // backfill only exports finalized epochs, nothing to do
+	}
+
+	latestExported, err := edb.GetLatestDashboardEpoch()
+	if err != nil {
+		return err
+	}
+
+	if firstReorgedEpoch > latestExported {
+		return nil // reorg only affects epochs that have not been exported yet
+	}
+
+	d.log.Warnf("chain reorg of depth %d at slot %d affects exported dashboard epochs %d-%d, queuing re-export", event.Depth, event.Slot, firstReorgedEpoch, latestExported)
+	metrics.Tasks.WithLabelValues("exporter_v2dash_reorg_reexport").Inc()
+
+	// do not block the event loop
+	select {
+	case d.reorgEpochQueue <- firstReorgedEpoch:
+	default:
+		d.log.Warnf("reorg queue is full, dropping reorg from epoch %d", firstReorgedEpoch)
+	}
+
 	return nil
 }
 
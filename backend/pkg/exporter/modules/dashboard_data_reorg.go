@@ -0,0 +1,313 @@
+package modules
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	edb "github.com/gobitfly/beaconchain/pkg/exporter/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+/**
+This file handles chain reorgs that affect epochs which have already been exported to the dashboard tables.
+
+The aggregated tables (total, hourly, daily and the rolling windows) are built by adding epoch data to their head. When a reorg replaces
+slots of an epoch that has already been exported, the stale epoch data is subtracted again from the head of every aggregated table
+and the head bounds (epoch_end) are moved back to the first reorged epoch. Afterwards the stale epochs are removed from the epoch table,
+so the regular backfill picks them up again from the node and the next aggregation adds the canonical data back on top.
+*/
+
+// All aggregated tables that are fed from the epoch table
+var reorgAggregateTables = []string{
+	edb.RollingTotalWriterTableName,
+	edb.HourWriterTableName,
+	edb.DayWriterTableName,
+	edb.RollingDailyWriterTable,
+	edb.RollingWeeklyWriterTable,
+	edb.RollingMonthlyWriterTable,
+	edb.RollingNinetyDaysWriterTable,
+}
+
+// Returns the first epoch whose slots have been replaced by the reorg
+func getFirstReorgedEpoch(headSlot, depth uint64) uint64 {
+	firstReorgedSlot := uint64(0)
+	if headSlot+1 > depth {
+		firstReorgedSlot = headSlot + 1 - depth
+	}
+	return firstReorgedSlot / utils.Config.Chain.ClConfig.SlotsPerEpoch
+}
+
+// Drains all pending reorgs and re-exports everything from the oldest reorged epoch onwards, blocks until done
+func (d *dashboardData) processReorg(fromEpoch uint64) {
+	for len(d.reorgEpochQueue) > 0 {
+		fromEpoch = min(fromEpoch, <-d.reorgEpochQueue)
+	}
+
+	startTime := time.Now()
+	stage := 0
+	var latestExportedEpoch uint64
+	for { // retry until no errors occur
+		if stage <= 0 {
+			var err error
+			latestExportedEpoch, err = edb.GetLatestDashboardEpoch()
+			if err != nil {
+				d.log.Error(err, "failed to get latest dashboard epoch", 0)
+				time.Sleep(time.Second * 10)
+				continue
+			}
+
+			if fromEpoch > latestExportedEpoch {
+				d.log.Infof("reorg from epoch %d does not affect exported dashboard data (latest exported: %d)", fromEpoch, latestExportedEpoch)
+				return
+			}
+			stage = 1
+		}
+
+		// Remove the stale epochs from all tables
+		if stage <= 1 {
+			err := d.revertEpochsFrom(fromEpoch, latestExportedEpoch)
+			if err != nil {
+				d.log.Error(err, "failed to revert reorged epochs", 0, map[string]interface{}{"epoch": fromEpoch})
+				metrics.Errors.WithLabelValues("exporter_v2dash_reorg_revert_fail").Inc()
+				time.Sleep(time.Second * 10)
+				continue
+			}
+			stage = 2
+		}
+
+		// Export the canonical epochs again
+		if stage <= 2 {
+			_, err := d.backfillHeadEpochData(&latestExportedEpoch)
+			if err != nil {
+				d.log.Error(err, "failed to re-export reorged epochs", 0, map[string]interface{}{"epoch": fromEpoch})
+				metrics.Errors.WithLabelValues("exporter_v2dash_reorg_backfill_fail").Inc()
+				time.Sleep(time.Second * 10)
+				continue
+			}
+			stage = 3
+		}
+
+		// Bring all aggregates back on par with the epoch table
+		if stage <= 3 {
+			err := d.aggregatePerEpoch(true, false)
+			if err != nil {
+				d.log.Error(err, "failed to aggregate re-exported epochs", 0, map[string]interface{}{"epoch": fromEpoch})
+				metrics.Errors.WithLabelValues("exporter_v2dash_reorg_agg_fail").Inc()
+				time.Sleep(time.Second * 10)
+				continue
+			}
+			stage = 4
+		}
+
+		break
+	}
+
+	d.log.Infof("[time] re-exported reorged dashboard epochs %d-%d in %v", fromEpoch, latestExportedEpoch, time.Since(startTime))
+	metrics.TaskDuration.WithLabelValues("exporter_v2dash_reorg").Observe(time.Since(startTime).Seconds())
+}
+
+// Subtracts the epochs fromEpoch to latestExportedEpoch (both inclusive) from all aggregated tables and deletes them from the epoch table.
+// Everything happens in one transaction so the aggregates never diverge from the epoch table.
+func (d *dashboardData) revertEpochsFrom(fromEpoch, latestExportedEpoch uint64) error {
+	d.log.Infof("reverting reorged dashboard epochs %d-%d", fromEpoch, latestExportedEpoch)
+
+	tx, err := db.AlloyWriter.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer utils.Rollback(tx)
+
+	// aggregates must be reverted before the epochs are deleted since the stale epoch data is needed to subtract it again
+	for _, tableName := range reorgAggregateTables {
+		err = d.removeFromHead(tx, tableName, fromEpoch)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to revert %s", tableName))
+		}
+	}
+
+	_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE epoch >= $1`, edb.EpochWriterTableName), fromEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete reorged epochs")
+	}
+
+	return tx.Commit()
+}
+
+// Removes all epochs from fromEpoch onwards from the head of an aggregated table.
+// Aggregates that start within the reorged range only contain stale data and are deleted, the aggregate containing fromEpoch
+// gets the stale epochs subtracted and its epoch_end moved back to fromEpoch.
+// Note that slashed, slashed_by and last_executed_duty_epoch can not be subtracted, they are overwritten once the canonical epochs are added again.
+func (d *dashboardData) removeFromHead(tx *sqlx.Tx, tableName string, fromEpoch uint64) error {
+	result, err := tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE epoch_start >= $1`, tableName), fromEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete reorged aggregates")
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	// only epochs that have actually been added to the aggregate must be subtracted
+	var headEpochEnd sql.NullInt64
+	err = tx.Get(&headEpochEnd, fmt.Sprintf(`SELECT max(epoch_end) FROM %s WHERE epoch_start < $1 AND epoch_end > $1`, tableName), fromEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to get aggregate head bounds")
+	}
+
+	if !headEpochEnd.Valid {
+		d.log.Infof("reorg revert %s, deleted %d rows, no aggregate head to revert", tableName, deleted)
+		return nil
+	}
+
+	result, err = tx.Exec(fmt.Sprintf(`
+		WITH
+			head_balance_ends as (
+				SELECT validator_index, balance_end FROM %[2]s WHERE epoch = $1 - 1 -- end balance of the last canonical epoch
+			),
+			aggregate_head as (
+				SELECT
+					validator_index,
+					SUM(attestations_source_reward) as attestations_source_reward,
+					SUM(attestations_target_reward) as attestations_target_reward,
+					SUM(attestations_head_reward) as attestations_head_reward,
+					SUM(attestations_inactivity_reward) as attestations_inactivity_reward,
+					SUM(attestations_inclusion_reward) as attestations_inclusion_reward,
+					SUM(attestations_reward) as attestations_reward,
+					SUM(attestations_ideal_source_reward) as attestations_ideal_source_reward,
+					SUM(attestations_ideal_target_reward) as attestations_ideal_target_reward,
+					SUM(attestations_ideal_head_reward) as attestations_ideal_head_reward,
+					SUM(attestations_ideal_inactivity_reward) as attestations_ideal_inactivity_reward,
+					SUM(attestations_ideal_inclusion_reward) as attestations_ideal_inclusion_reward,
+					SUM(attestations_ideal_reward) as attestations_ideal_reward,
+					SUM(blocks_scheduled) as blocks_scheduled,
+					SUM(blocks_proposed) as blocks_proposed,
+					SUM(blocks_cl_reward) as blocks_cl_reward,
+					SUM(blocks_cl_attestations_reward) as blocks_cl_attestations_reward,
+					SUM(blocks_cl_sync_aggregate_reward) as blocks_cl_sync_aggregate_reward,
+					SUM(sync_scheduled) as sync_scheduled,
+					SUM(sync_executed) as sync_executed,
+					SUM(sync_rewards) as sync_rewards,
+					SUM(deposits_count) as deposits_count,
+					SUM(deposits_amount) as deposits_amount,
+					SUM(withdrawals_count) as withdrawals_count,
+					SUM(withdrawals_amount) as withdrawals_amount,
+					SUM(inclusion_delay_sum) as inclusion_delay_sum,
+					SUM(blocks_expected) as blocks_expected,
+					SUM(sync_committees_expected) as sync_committees_expected,
+					SUM(attestations_scheduled) as attestations_scheduled,
+					SUM(attestations_executed) as attestations_executed,
+					SUM(attestation_head_executed) as attestation_head_executed,
+					SUM(attestation_source_executed) as attestation_source_executed,
+					SUM(attestation_target_executed) as attestation_target_executed,
+					SUM(optimal_inclusion_delay_sum) as optimal_inclusion_delay_sum,
+					SUM(slasher_reward) as slasher_reward
+				FROM %[2]s
+				WHERE epoch >= $1 AND epoch < $2
+				GROUP BY validator_index
+			),
+			result as (
+				SELECT
+					aggregate_head.validator_index as validator_index,
+					COALESCE(aggregate_head.attestations_source_reward, 0) as attestations_source_reward,
+					COALESCE(aggregate_head.attestations_target_reward, 0) as attestations_target_reward,
+					COALESCE(aggregate_head.attestations_head_reward, 0) as attestations_head_reward,
+					COALESCE(aggregate_head.attestations_inactivity_reward, 0) as attestations_inactivity_reward,
+					COALESCE(aggregate_head.attestations_inclusion_reward, 0) as attestations_inclusion_reward,
+					COALESCE(aggregate_head.attestations_reward, 0) as attestations_reward,
+					COALESCE(aggregate_head.attestations_ideal_source_reward, 0) as attestations_ideal_source_reward,
+					COALESCE(aggregate_head.attestations_ideal_target_reward, 0) as attestations_ideal_target_reward,
+					COALESCE(aggregate_head.attestations_ideal_head_reward, 0) as attestations_ideal_head_reward,
+					COALESCE(aggregate_head.attestations_ideal_inactivity_reward, 0) as attestations_ideal_inactivity_reward,
+					COALESCE(aggregate_head.attestations_ideal_inclusion_reward, 0) as attestations_ideal_inclusion_reward,
+					COALESCE(aggregate_head.attestations_ideal_reward, 0) as attestations_ideal_reward,
+					COALESCE(aggregate_head.blocks_scheduled, 0) as blocks_scheduled,
+					COALESCE(aggregate_head.blocks_proposed, 0) as blocks_proposed,
+					COALESCE(aggregate_head.blocks_cl_reward, 0) as blocks_cl_reward,
+					COALESCE(aggregate_head.blocks_cl_attestations_reward, 0) as blocks_cl_attestations_reward,
+					COALESCE(aggregate_head.blocks_cl_sync_aggregate_reward, 0) as blocks_cl_sync_aggregate_reward,
+					COALESCE(aggregate_head.sync_scheduled, 0) as sync_scheduled,
+					COALESCE(aggregate_head.sync_executed, 0) as sync_executed,
+					COALESCE(aggregate_head.sync_rewards, 0) as sync_rewards,
+					head_balance_ends.balance_end,
+					COALESCE(aggregate_head.deposits_count, 0) as deposits_count,
+					COALESCE(aggregate_head.deposits_amount, 0) as deposits_amount,
+					COALESCE(aggregate_head.withdrawals_count, 0) as withdrawals_count,
+					COALESCE(aggregate_head.withdrawals_amount, 0) as withdrawals_amount,
+					COALESCE(aggregate_head.inclusion_delay_sum, 0) as inclusion_delay_sum,
+					COALESCE(aggregate_head.blocks_expected, 0) as blocks_expected,
+					COALESCE(aggregate_head.sync_committees_expected, 0) as sync_committees_expected,
+					COALESCE(aggregate_head.attestations_scheduled, 0) as attestations_scheduled,
+					COALESCE(aggregate_head.attestations_executed, 0) as attestations_executed,
+					COALESCE(aggregate_head.attestation_head_executed, 0) as attestation_head_executed,
+					COALESCE(aggregate_head.attestation_source_executed, 0) as attestation_source_executed,
+					COALESCE(aggregate_head.attestation_target_executed, 0) as attestation_target_executed,
+					COALESCE(aggregate_head.optimal_inclusion_delay_sum, 0) as optimal_inclusion_delay_sum,
+					COALESCE(aggregate_head.slasher_reward, 0) as slasher_reward
+				FROM aggregate_head
+				LEFT JOIN head_balance_ends ON aggregate_head.validator_index = head_balance_ends.validator_index
+			)
+			UPDATE %[1]s AS v SET
+					attestations_source_reward = NULLIF(COALESCE(v.attestations_source_reward, 0) - result.attestations_source_reward, 0),
+					attestations_target_reward = NULLIF(COALESCE(v.attestations_target_reward, 0) - result.attestations_target_reward, 0),
+					attestations_head_reward = NULLIF(COALESCE(v.attestations_head_reward, 0) - result.attestations_head_reward, 0),
+					attestations_inactivity_reward = NULLIF(COALESCE(v.attestations_inactivity_reward, 0) - result.attestations_inactivity_reward, 0),
+					attestations_inclusion_reward = NULLIF(COALESCE(v.attestations_inclusion_reward, 0) - result.attestations_inclusion_reward, 0),
+					attestations_reward = NULLIF(COALESCE(v.attestations_reward, 0) - result.attestations_reward, 0),
+					attestations_ideal_source_reward = NULLIF(COALESCE(v.attestations_ideal_source_reward, 0) - result.attestations_ideal_source_reward, 0),
+					attestations_ideal_target_reward = NULLIF(COALESCE(v.attestations_ideal_target_reward, 0) - result.attestations_ideal_target_reward, 0),
+					attestations_ideal_head_reward = NULLIF(COALESCE(v.attestations_ideal_head_reward, 0) - result.attestations_ideal_head_reward, 0),
+					attestations_ideal_inactivity_reward = NULLIF(COALESCE(v.attestations_ideal_inactivity_reward, 0) - result.attestations_ideal_inactivity_reward, 0),
+					attestations_ideal_inclusion_reward = NULLIF(COALESCE(v.attestations_ideal_inclusion_reward, 0) - result.attestations_ideal_inclusion_reward, 0),
+					attestations_ideal_reward = NULLIF(COALESCE(v.attestations_ideal_reward, 0) - result.attestations_ideal_reward, 0),
+					blocks_scheduled = NULLIF(COALESCE(v.blocks_scheduled, 0) - result.blocks_scheduled, 0),
+					blocks_proposed = NULLIF(COALESCE(v.blocks_proposed, 0) - result.blocks_proposed, 0),
+					blocks_cl_reward = NULLIF(COALESCE(v.blocks_cl_reward, 0) - result.blocks_cl_reward, 0),
+					blocks_cl_attestations_reward = NULLIF(COALESCE(v.blocks_cl_attestations_reward, 0) - result.blocks_cl_attestations_reward, 0),
+					blocks_cl_sync_aggregate_reward = NULLIF(COALESCE(v.blocks_cl_sync_aggregate_reward, 0) - result.blocks_cl_sync_aggregate_reward, 0),
+					sync_scheduled = NULLIF(COALESCE(v.sync_scheduled, 0) - result.sync_scheduled, 0),
+					sync_executed = NULLIF(COALESCE(v.sync_executed, 0) - result.sync_executed, 0),
+					sync_rewards = NULLIF(COALESCE(v.sync_rewards, 0) - result.sync_rewards, 0),
+					balance_end = COALESCE(result.balance_end, v.balance_end),
+					deposits_count = NULLIF(COALESCE(v.deposits_count, 0) - result.deposits_count, 0),
+					deposits_amount = NULLIF(COALESCE(v.deposits_amount, 0) - result.deposits_amount, 0),
+					withdrawals_count = NULLIF(COALESCE(v.withdrawals_count, 0) - result.withdrawals_count, 0),
+					withdrawals_amount = NULLIF(COALESCE(v.withdrawals_amount, 0) - result.withdrawals_amount, 0),
+					inclusion_delay_sum = NULLIF(COALESCE(v.inclusion_delay_sum, 0) - result.inclusion_delay_sum, 0),
+					blocks_expected = NULLIF(COALESCE(v.blocks_expected, 0) - result.blocks_expected, 0),
+					sync_committees_expected = NULLIF(COALESCE(v.sync_committees_expected, 0) - result.sync_committees_expected, 0),
+					attestations_scheduled = NULLIF(COALESCE(v.attestations_scheduled, 0) - result.attestations_scheduled, 0),
+					attestations_executed = NULLIF(COALESCE(v.attestations_executed, 0) - result.attestations_executed, 0),
+					attestation_head_executed = NULLIF(COALESCE(v.attestation_head_executed, 0) - result.attestation_head_executed, 0),
+					attestation_source_executed = NULLIF(COALESCE(v.attestation_source_executed, 0) - result.attestation_source_executed, 0),
+					attestation_target_executed = NULLIF(COALESCE(v.attestation_target_executed, 0) - result.attestation_target_executed, 0),
+					optimal_inclusion_delay_sum = NULLIF(COALESCE(v.optimal_inclusion_delay_sum, 0) - result.optimal_inclusion_delay_sum, 0),
+					slasher_reward = NULLIF(COALESCE(v.slasher_reward, 0) - result.slasher_reward, 0),
+					epoch_end = $1
+				FROM result
+				WHERE v.validator_index = result.validator_index AND v.epoch_start < $1 AND v.epoch_end > $1;
+	`, tableName, edb.EpochWriterTableName), fromEpoch, headEpochEnd.Int64)
+	if err != nil {
+		return errors.Wrap(err, "failed to revert aggregate head")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	// validators without any data in the reorged epochs still need their bounds moved back
+	_, err = tx.Exec(fmt.Sprintf(`UPDATE %s SET epoch_end = $1 WHERE epoch_start < $1 AND epoch_end > $1`, tableName), fromEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to revert aggregate head bounds")
+	}
+
+	d.log.Infof("reorg revert %s, deleted %d rows, reverted epochs %d-%d (excl) of %d rows", tableName, deleted, fromEpoch, headEpochEnd.Int64, rowsAffected)
+
+	return nil
+}
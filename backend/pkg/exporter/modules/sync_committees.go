@@ -27,6 +27,18 @@ func syncCommitteesExporter(rpcClient rpc.Client) {
 	}
 }
 
+// periods that have been exported from a state that was not finalized yet, they are replaced once the state is finalized.
+// Only accessed by the exporter goroutine, nil until the first run.
+var unfinalizedSyncCommitteePeriods map[uint64]bool
+
+// the committee of a period is elected at the first epoch of the previous period
+func syncCommitteeElectionEpoch(p uint64) uint64 {
+	if p == 0 {
+		return 0
+	}
+	return utils.FirstEpochOfSyncPeriod(p - 1)
+}
+
 func exportSyncCommittees(rpcClient rpc.Client) error {
 	var dbPeriods []uint64
 	err := db.WriterDb.Select(&dbPeriods, `SELECT period FROM sync_committees GROUP BY period`)
@@ -37,12 +49,39 @@ func exportSyncCommittees(rpcClient rpc.Client) error {
 	for _, p := range dbPeriods {
 		dbPeriodsMap[p] = true
 	}
-	currEpoch := cache.LatestFinalizedEpoch.Get()
-	if currEpoch > 0 { // guard against underflows
-		currEpoch = currEpoch - 1
-	}
+	// the committee of the next period is elected at the first epoch of the current period,
+	// so follow the head instead of waiting for finalization to make it available right away
+	currEpoch := cache.LatestEpoch.Get()
+	finalizedEpoch := cache.LatestFinalizedEpoch.Get()
 	lastPeriod := utils.SyncPeriodOfEpoch(currEpoch) + 1 // we can look into the future
 	firstPeriod := utils.SyncPeriodOfEpoch(utils.Config.Chain.ClConfig.AltairForkEpoch)
+
+	if unfinalizedSyncCommitteePeriods == nil {
+		// it is unknown which state the stored periods have been exported from, check all whose election wasn't finalized long ago
+		unfinalizedSyncCommitteePeriods = make(map[uint64]bool)
+		for _, p := range dbPeriods {
+			if p >= utils.SyncPeriodOfEpoch(finalizedEpoch) {
+				unfinalizedSyncCommitteePeriods[p] = true
+			}
+		}
+	}
+	for p := range unfinalizedSyncCommitteePeriods {
+		if syncCommitteeElectionEpoch(p) > finalizedEpoch {
+			continue
+		}
+		t0 := time.Now()
+		err = replaceSyncCommitteeAtPeriod(rpcClient, p)
+		if err != nil {
+			return fmt.Errorf("error replacing sync-committee at period %v: %w", p, err)
+		}
+		delete(unfinalizedSyncCommitteePeriods, p)
+		log.InfoWithFields(log.Fields{
+			"period":   p,
+			"epoch":    utils.FirstEpochOfSyncPeriod(p),
+			"duration": time.Since(t0),
+		}, "replaced sync_committee with the finalized one")
+	}
+
 	for p := firstPeriod; p <= lastPeriod; p++ {
 		_, exists := dbPeriodsMap[p]
 		if !exists {
@@ -51,6 +90,9 @@ func exportSyncCommittees(rpcClient rpc.Client) error {
 			if err != nil {
 				return fmt.Errorf("error exporting sync-committee at period %v: %w", p, err)
 			}
+			if syncCommitteeElectionEpoch(p) > finalizedEpoch {
+				unfinalizedSyncCommitteePeriods[p] = true
+			}
 			log.InfoWithFields(log.Fields{
 				"period":   p,
 				"epoch":    utils.FirstEpochOfSyncPeriod(p),
@@ -61,6 +103,30 @@ func exportSyncCommittees(rpcClient rpc.Client) error {
 	return nil
 }
 
+// replaceSyncCommitteeAtPeriod replaces the stored committee of a period, used once the state it was elected in is finalized
+func replaceSyncCommitteeAtPeriod(rpcClient rpc.Client, p uint64) error {
+	tx, err := db.WriterDb.Beginx()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := tx.Rollback()
+		if err != nil && !errors.Is(err, sql.ErrTxDone) {
+			log.Error(err, "error rolling back transaction", 0)
+		}
+	}()
+
+	_, err = tx.Exec(`DELETE FROM sync_committees WHERE period = $1`, p)
+	if err != nil {
+		return err
+	}
+	err = ExportSyncCommitteeAtPeriod(rpcClient, p, tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func ExportSyncCommitteeAtPeriod(rpcClient rpc.Client, p uint64, providedTx *sqlx.Tx) error {
 	data, err := GetSyncCommitteAtPeriod(rpcClient, p)
 	if err != nil {
@@ -129,7 +195,7 @@ func GetSyncCommitteAtPeriod(rpcClient rpc.Client, p uint64) ([]SyncCommittee, e
 		return nil, err
 	}
 
-	result := make([]SyncCommittee, len(c.Validators))
+	result := make([]SyncCommittee, 0, len(c.Validators))
 	for i, idxStr := range c.Validators {
 		result = append(result, SyncCommittee{
 			Period:         p,
@@ -2,7 +2,9 @@ package nodejobs
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"errors"
 
 	"fmt"
 	"io"
@@ -11,6 +13,10 @@ import (
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/types"
@@ -91,6 +97,10 @@ func CreateNodeJob(data []byte) (*types.NodeJob, error) {
 		return CreateBLSToExecutionChangesNodeJob(j)
 	case types.VoluntaryExitsNodeJobType:
 		return CreateVoluntaryExitNodeJob(j)
+	case types.AttestationsNodeJobType:
+		return CreateAttestationsNodeJob(j)
+	case types.ExecutionTransactionNodeJobType:
+		return CreateExecutionTransactionNodeJob(j)
 	}
 }
 
@@ -104,6 +114,14 @@ func UpdateNodeJobs() error {
 	if err != nil {
 		return fmt.Errorf("error updating voluntary-exit-job: %w", err)
 	}
+	err = UpdateAttestationsNodeJobs()
+	if err != nil {
+		return fmt.Errorf("error updating attestations-job: %w", err)
+	}
+	err = UpdateExecutionTransactionNodeJobs()
+	if err != nil {
+		return fmt.Errorf("error updating execution-transaction-job: %w", err)
+	}
 	return nil
 }
 
@@ -117,6 +135,14 @@ func SubmitNodeJobs() error {
 	if err != nil {
 		return err
 	}
+	err = SubmitAttestationsNodeJobs()
+	if err != nil {
+		return err
+	}
+	err = SubmitExecutionTransactionNodeJobs()
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -293,7 +319,7 @@ func UpdateBLSToExecutionChangesNodeJob(job *types.NodeJob) error {
 func SubmitBLSToExecutionChangesNodeJobs() error {
 	maxSubmittedJobs := 1000
 	jobs := []*types.NodeJob{}
-	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit $4-(select count(*) from node_jobs where type = $1 and status = $3)`, types.BLSToExecutionChangesNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit greatest(0, $4-(select count(*) from node_jobs where type = $1 and status = $3))`, types.BLSToExecutionChangesNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
 	if err != nil {
 		return err
 	}
@@ -347,7 +373,7 @@ func CreateVoluntaryExitNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
 
 	njd, ok := nj.GetVoluntaryExitsNodeJobData()
 	if !ok {
-		return nil, fmt.Errorf("invalid job")
+		return nil, types.CreateNodeJobUserError{Message: "invalid data"}
 	}
 
 	vali := struct {
@@ -355,22 +381,25 @@ func CreateVoluntaryExitNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
 		Status string `db:"status"`
 	}{}
 	err := db.WriterDb.Get(&vali, `select pubkey, status from validators where validatorindex = $1`, njd.Message.ValidatorIndex)
+	if err == sql.ErrNoRows {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("validator with index %v not found", uint64(njd.Message.ValidatorIndex))}
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	switch constypes.ValidatorDbStatus(vali.Status) {
 	case constypes.DbExited, constypes.DbExitingOffline, constypes.DbExitingOnline:
-		return nil, fmt.Errorf("validator has exited")
+		return nil, types.CreateNodeJobUserError{Message: "validator has exited"}
 	case constypes.DbSlashed, constypes.DbSlashingOffline, constypes.DbSlashingOnline:
-		return nil, fmt.Errorf("validator has been slashed")
+		return nil, types.CreateNodeJobUserError{Message: "validator has been slashed"}
 	default:
 	}
 
 	forkVersion := utils.ForkVersionAtEpoch(uint64(njd.Message.Epoch))
 	err = utils.VerifyVoluntaryExitSignature(njd, forkVersion.CurrentVersion, vali.Pubkey)
 	if err != nil {
-		return nil, err
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("can not verify signature: %v", err)}
 	}
 
 	_, err = db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, now())`, nj.ID, nj.Type, nj.Status, nj.RawData)
@@ -430,7 +459,7 @@ func UpdateVoluntaryExitNodeJob(job *types.NodeJob) error {
 func SubmitVoluntaryExitNodeJobs() error {
 	maxSubmittedJobs := 100
 	jobs := []*types.NodeJob{}
-	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit $4-(select count(*) from node_jobs where type = $1 and status = $3)`, types.VoluntaryExitsNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit greatest(0, $4-(select count(*) from node_jobs where type = $1 and status = $3))`, types.VoluntaryExitsNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
 	if err != nil {
 		return err
 	}
@@ -474,3 +503,345 @@ func SubmitVoluntaryExitNodeJob(job *types.NodeJob) error {
 	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": jobStatus}, "submitted node_job")
 	return nil
 }
+
+// CreateAttestationsNodeJob only checks that the attestations can still be included, the signatures are verified by the node on submission
+func CreateAttestationsNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
+	if len(nj.RawData) > 1e6 {
+		return nil, types.CreateNodeJobUserError{Message: "data-size exceeds maximum of 1MB"}
+	}
+	nj.ID = uuid.New().String()
+	nj.Status = types.PendingNodeJobStatus
+
+	d, ok := nj.GetAttestationsNodeJobData()
+	if !ok {
+		return nil, types.CreateNodeJobUserError{Message: "invalid data"}
+	}
+
+	currentSlot := utils.TimeToSlot(uint64(time.Now().Unix()))
+	for _, att := range d {
+		if att.Data == nil || att.Data.Target == nil || att.AggregationBits.Count() == 0 {
+			return nil, types.CreateNodeJobUserError{Message: "attestation without data or aggregation bits"}
+		}
+		slot := uint64(att.Data.Slot)
+		if slot > currentSlot {
+			return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("attestation for future slot %v", slot)}
+		}
+		if slot+utils.Config.Chain.ClConfig.SlotsPerEpoch < currentSlot {
+			return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("attestation for slot %v is too old to be included", slot)}
+		}
+		if uint64(att.Data.Target.Epoch) != utils.EpochOfSlot(slot) {
+			return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("target epoch of attestation for slot %v does not match", slot)}
+		}
+	}
+
+	_, err := db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, now())`, nj.ID, nj.Type, nj.Status, nj.RawData)
+	if err != nil {
+		return nil, err
+	}
+	log.InfoWithFields(log.Fields{"id": nj.ID, "type": nj.Type, "attestations": len(d)}, "created node_job")
+	return nj, nil
+}
+
+func UpdateAttestationsNodeJobs() error {
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2`, types.AttestationsNodeJobType, types.SubmittedToNodeNodeJobStatus)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		err := job.ParseData()
+		if err != nil {
+			return err
+		}
+		err = UpdateAttestationsNodeJob(job)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAttestationsNodeJob checks the inclusion of the attestations once none of them can be included anymore,
+// the job is completed if all attestations have been included in canonical blocks and failed otherwise
+func UpdateAttestationsNodeJob(job *types.NodeJob) error {
+	jobData, ok := job.GetAttestationsNodeJobData()
+	if !ok {
+		return fmt.Errorf("invalid job-data")
+	}
+	lastSlot := uint64(0)
+	for _, att := range jobData {
+		lastSlot = max(lastSlot, uint64(att.Data.Slot))
+	}
+	lastInclusionSlot := lastSlot + utils.Config.Chain.ClConfig.SlotsPerEpoch
+	if lastInclusionSlot >= utils.TimeToSlot(uint64(time.Now().Unix())) {
+		return nil
+	}
+	// wait until the blocks of the inclusion window have been exported
+	var lastExportedSlot uint64
+	err := db.WriterDb.Get(&lastExportedSlot, `select coalesce(max(slot), 0) from blocks`)
+	if err != nil {
+		return err
+	}
+	if lastExportedSlot <= lastInclusionSlot {
+		return nil
+	}
+
+	job.Status = types.CompletedNodeJobStatus
+	for _, att := range jobData {
+		var includedBits [][]byte
+		err := db.WriterDb.Select(&includedBits, `
+			select ba.aggregationbits
+			from blocks_attestations ba
+			inner join blocks b on b.blockroot = ba.block_root and b.status = '1'
+			where ba.block_slot > $1 and ba.block_slot <= $2 and ba.slot = $1 and ba.committeeindex = $3 and ba.beaconblockroot = $4`,
+			att.Data.Slot, uint64(att.Data.Slot)+utils.Config.Chain.ClConfig.SlotsPerEpoch, att.Data.Index, att.Data.BeaconBlockRoot[:])
+		if err != nil {
+			return err
+		}
+		if !isBitlistIncluded(att.AggregationBits, includedBits) {
+			job.Status = types.FailedNodeJobStatus
+			log.WarnWithFields(log.Fields{"jobID": job.ID, "jobType": job.Type, "slot": att.Data.Slot, "committeeIndex": att.Data.Index}, "attestation of node_job was not included")
+			break
+		}
+	}
+	job.CompletedTime.Time = time.Now()
+	job.CompletedTime.Valid = true
+	_, err = db.WriterDb.Exec(`update node_jobs set status = $1, completed_time = $2 where id = $3`, job.Status, job.CompletedTime.Time, job.ID)
+	return err
+}
+
+// isBitlistIncluded returns true if every bit set in bits is set in at least one of the included bitlists
+func isBitlistIncluded(bits []byte, included [][]byte) bool {
+	covered := make([]byte, len(bits))
+	for _, inc := range included {
+		if len(inc) != len(bits) {
+			continue // different committee size, can't be an aggregate of the same committee
+		}
+		for i := range inc {
+			covered[i] |= inc[i]
+		}
+	}
+	for i := range bits {
+		if bits[i]&^covered[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func SubmitAttestationsNodeJobs() error {
+	maxSubmittedJobs := 1000
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit greatest(0, $4-(select count(*) from node_jobs where type = $1 and status = $3))`, types.AttestationsNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		err = job.ParseData()
+		if err != nil {
+			return err
+		}
+		err = SubmitAttestationsNodeJob(job)
+		if err != nil {
+			return fmt.Errorf("error calling SubmitAttestationsNodeJob for job %v: %w", job.ID, err)
+		}
+	}
+	return nil
+}
+
+func SubmitAttestationsNodeJob(job *types.NodeJob) error {
+	client := &http.Client{Timeout: time.Second * 10}
+	url := fmt.Sprintf("%s/eth/v1/beacon/pool/attestations", utils.Config.NodeJobsProcessor.ClEndpoint)
+	resp, err := client.Post(url, "application/json", bytes.NewReader(job.RawData))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	jobStatus := types.SubmittedToNodeNodeJobStatus
+	if resp.StatusCode != http.StatusOK {
+		d, _ := io.ReadAll(resp.Body)
+		if len(d) > 1000 {
+			d = d[:1000]
+		}
+		jobStatus = types.FailedNodeJobStatus
+		log.WarnWithFields(log.Fields{"res": string(d), "status": resp.Status, "jobID": job.ID, "jobType": job.Type}, "failed submitting a job")
+	}
+	job.Status = jobStatus
+	job.SubmittedToNodeTime.Time = time.Now()
+	job.SubmittedToNodeTime.Valid = true
+	_, err = db.WriterDb.Exec(`update node_jobs set status = $1, submitted_to_node_time = $2 where id = $3`, job.Status, job.SubmittedToNodeTime.Time, job.ID)
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": jobStatus}, "submitted node_job")
+	return nil
+}
+
+func CreateExecutionTransactionNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
+	if len(nj.RawData) > 3e5 {
+		return nil, types.CreateNodeJobUserError{Message: "data-size exceeds maximum of 300KB"}
+	}
+	nj.ID = uuid.New().String()
+	nj.Status = types.PendingNodeJobStatus
+
+	d, ok := nj.GetExecutionTransactionNodeJobData()
+	if !ok {
+		return nil, types.CreateNodeJobUserError{Message: "invalid data"}
+	}
+	tx := new(gethtypes.Transaction)
+	err := tx.UnmarshalBinary(d)
+	if err != nil {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("can not decode transaction: %v", err)}
+	}
+	if tx.ChainId().Uint64() != utils.Config.Chain.ClConfig.DepositChainID {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("transaction is signed for chain %v", tx.ChainId())}
+	}
+	_, err = gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("can not verify signature: %v", err)}
+	}
+
+	_, err = db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, now())`, nj.ID, nj.Type, nj.Status, nj.RawData)
+	if err != nil {
+		return nil, err
+	}
+	log.InfoWithFields(log.Fields{"id": nj.ID, "type": nj.Type, "hash": tx.Hash().Hex()}, "created node_job")
+	return nj, nil
+}
+
+func UpdateExecutionTransactionNodeJobs() error {
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2`, types.ExecutionTransactionNodeJobType, types.SubmittedToNodeNodeJobStatus)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	client, err := ethclient.Dial(utils.Config.NodeJobsProcessor.ElEndpoint)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	for _, job := range jobs {
+		err := job.ParseData()
+		if err != nil {
+			return err
+		}
+		err = UpdateExecutionTransactionNodeJob(client, job)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transactions that haven't been included within this duration after submission are considered dropped
+const executionTransactionNodeJobTimeout = time.Hour
+
+// UpdateExecutionTransactionNodeJob completes the job once the transaction has been included in a block, regardless of its execution status.
+// The job fails if another transaction with the same nonce has been included or if the transaction wasn't included in time.
+func UpdateExecutionTransactionNodeJob(client *ethclient.Client, job *types.NodeJob) error {
+	jobData, ok := job.GetExecutionTransactionNodeJobData()
+	if !ok {
+		return fmt.Errorf("invalid job-data")
+	}
+	tx := new(gethtypes.Transaction)
+	err := tx.UnmarshalBinary(jobData)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	job.Status = types.CompletedNodeJobStatus
+	_, err = client.TransactionReceipt(ctx, tx.Hash())
+	if errors.Is(err, ethereum.NotFound) {
+		sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return err
+		}
+		// the receipt is checked first, so a used nonce means the transaction has been replaced
+		nonce, err := client.NonceAt(ctx, sender, nil)
+		if err != nil {
+			return err
+		}
+		switch {
+		case nonce > tx.Nonce():
+			log.WarnWithFields(log.Fields{"jobID": job.ID, "jobType": job.Type, "hash": tx.Hash().Hex()}, "transaction of node_job has been replaced")
+		case job.SubmittedToNodeTime.Valid && time.Since(job.SubmittedToNodeTime.Time) > executionTransactionNodeJobTimeout:
+			log.WarnWithFields(log.Fields{"jobID": job.ID, "jobType": job.Type, "hash": tx.Hash().Hex()}, "transaction of node_job was not included in time")
+		default:
+			return nil
+		}
+		job.Status = types.FailedNodeJobStatus
+	} else if err != nil {
+		return err
+	}
+	job.CompletedTime.Time = time.Now()
+	job.CompletedTime.Valid = true
+	_, err = db.WriterDb.Exec(`update node_jobs set status = $1, completed_time = $2 where id = $3`, job.Status, job.CompletedTime.Time, job.ID)
+	return err
+}
+
+func SubmitExecutionTransactionNodeJobs() error {
+	maxSubmittedJobs := 1000
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit greatest(0, $4-(select count(*) from node_jobs where type = $1 and status = $3))`, types.ExecutionTransactionNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	client, err := ethclient.Dial(utils.Config.NodeJobsProcessor.ElEndpoint)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	for _, job := range jobs {
+		err = job.ParseData()
+		if err != nil {
+			return err
+		}
+		err = SubmitExecutionTransactionNodeJob(client, job)
+		if err != nil {
+			return fmt.Errorf("error calling SubmitExecutionTransactionNodeJob for job %v: %w", job.ID, err)
+		}
+	}
+	return nil
+}
+
+func SubmitExecutionTransactionNodeJob(client *ethclient.Client, job *types.NodeJob) error {
+	jobData, ok := job.GetExecutionTransactionNodeJobData()
+	if !ok {
+		return fmt.Errorf("invalid job-data")
+	}
+	tx := new(gethtypes.Transaction)
+	err := tx.UnmarshalBinary(jobData)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	jobStatus := types.SubmittedToNodeNodeJobStatus
+	err = client.SendTransaction(ctx, tx)
+	var rpcErr gethrpc.Error
+	if errors.As(err, &rpcErr) {
+		// the node rejected the transaction, e.g. because of a nonce or fee issue
+		jobStatus = types.FailedNodeJobStatus
+		log.WarnWithFields(log.Fields{"res": rpcErr.Error(), "jobID": job.ID, "jobType": job.Type}, "failed submitting a job")
+	} else if err != nil {
+		// only this job fails, the others of the batch are still submitted
+		jobStatus = types.FailedNodeJobStatus
+		log.WarnWithFields(log.Fields{"error": err.Error(), "jobID": job.ID, "jobType": job.Type}, "failed submitting a job")
+	}
+	job.Status = jobStatus
+	job.SubmittedToNodeTime.Time = time.Now()
+	job.SubmittedToNodeTime.Valid = true
+	_, err = db.WriterDb.Exec(`update node_jobs set status = $1, submitted_to_node_time = $2 where id = $3`, job.Status, job.SubmittedToNodeTime.Time, job.ID)
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": jobStatus}, "submitted node_job")
+	return nil
+}
@@ -2,8 +2,11 @@ package notification
 
 import (
 	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/lib/pq"
@@ -12,7 +15,7 @@ import (
 func GetSubsForEventFilter(eventName types.EventName) ([][]byte, map[string][]types.Subscription, error) {
 	var subs []types.Subscription
 	subQuery := `
-		SELECT id, user_id, event_filter, last_sent_epoch, created_epoch, event_threshold, ENCODE(unsubscribe_hash, 'hex') as unsubscribe_hash, internal_state from users_subscriptions where event_name = $1
+		SELECT id, user_id, event_filter, last_sent_ts, last_sent_epoch, created_epoch, event_threshold, ENCODE(unsubscribe_hash, 'hex') as unsubscribe_hash, internal_state from users_subscriptions where event_name = $1
 		`
 
 	subMap := make(map[string][]types.Subscription, 0)
@@ -22,26 +25,114 @@ func GetSubsForEventFilter(eventName types.EventName) ([][]byte, map[string][]ty
 	}
 
 	filtersEncode := make([][]byte, 0, len(subs))
-	for _, sub := range subs {
+	addSub := func(sub types.Subscription) {
 		if _, ok := subMap[sub.EventFilter]; !ok {
 			subMap[sub.EventFilter] = make([]types.Subscription, 0)
+			b, _ := hex.DecodeString(sub.EventFilter)
+			filtersEncode = append(filtersEncode, b)
 		}
 		subMap[sub.EventFilter] = append(subMap[sub.EventFilter], types.Subscription{
-			UserID:         sub.UserID,
-			ID:             sub.ID,
-			LastEpoch:      sub.LastEpoch,
-			EventFilter:    sub.EventFilter,
-			CreatedEpoch:   sub.CreatedEpoch,
-			EventThreshold: sub.EventThreshold,
-			State:          sub.State,
+			UserID:          sub.UserID,
+			ID:              sub.ID,
+			LastSent:        sub.LastSent,
+			LastEpoch:       sub.LastEpoch,
+			EventFilter:     sub.EventFilter,
+			CreatedEpoch:    sub.CreatedEpoch,
+			EventThreshold:  sub.EventThreshold,
+			UnsubscribeHash: sub.UnsubscribeHash,
+			State:           sub.State,
 		})
+	}
+
+	dashboardSubs := make([]types.Subscription, 0)
+	for _, sub := range subs {
+		if strings.HasPrefix(sub.EventFilter, types.ValidatorDashboardEventPrefix+":") {
+			dashboardSubs = append(dashboardSubs, sub)
+			continue
+		}
+		addSub(sub)
+	}
 
-		b, _ := hex.DecodeString(sub.EventFilter)
-		filtersEncode = append(filtersEncode, b)
+	if len(dashboardSubs) > 0 {
+		// dashboard subscriptions are expanded to one subscription per validator of the group
+		pubkeysByFilter, err := getValidatorDashboardGroupPubkeys(dashboardSubs)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, sub := range dashboardSubs {
+			for _, pubkey := range pubkeysByFilter[sub.EventFilter] {
+				validatorSub := sub
+				validatorSub.EventFilter = pubkey
+				addSub(validatorSub)
+			}
+		}
 	}
+
 	return filtersEncode, subMap, nil
 }
 
+// getValidatorDashboardGroupPubkeys returns the hex encoded pubkeys of all validators of the dashboard groups referenced by the subscriptions, keyed by event filter
+func getValidatorDashboardGroupPubkeys(subs []types.Subscription) (map[string][]string, error) {
+	result := make(map[string][]string, len(subs))
+	if db.AlloyReader == nil {
+		log.Warnf("skipping %v validator dashboard subscriptions, no dashboard database connection", len(subs))
+		return result, nil
+	}
+
+	dashboardIds := make([]int64, 0, len(subs))
+	for _, sub := range subs {
+		dashboardId, _, err := types.ParseDashboardEventFilter(sub.EventFilter)
+		if err != nil {
+			log.Warnf("skipping subscription %v: %v", sub.ID, err)
+			continue
+		}
+		dashboardIds = append(dashboardIds, int64(dashboardId))
+	}
+
+	var validators []struct {
+		DashboardId    uint64 `db:"dashboard_id"`
+		GroupId        uint64 `db:"group_id"`
+		ValidatorIndex uint64 `db:"validator_index"`
+	}
+	err := db.AlloyReader.Select(&validators, `
+		SELECT dashboard_id, group_id, validator_index
+		FROM users_val_dashboards_validators
+		WHERE dashboard_id = ANY($1)`, pq.Int64Array(dashboardIds))
+	if err != nil {
+		return nil, fmt.Errorf("error getting validators of dashboard subscriptions: %w", err)
+	}
+	if len(validators) == 0 {
+		return result, nil
+	}
+
+	indices := make([]int64, 0, len(validators))
+	for _, v := range validators {
+		indices = append(indices, int64(v.ValidatorIndex))
+	}
+	var pubkeys []struct {
+		Index  uint64 `db:"validatorindex"`
+		Pubkey string `db:"pubkey"`
+	}
+	err = db.WriterDb.Select(&pubkeys, `SELECT validatorindex, ENCODE(pubkey, 'hex') AS pubkey FROM validators WHERE validatorindex = ANY($1)`, pq.Int64Array(indices))
+	if err != nil {
+		return nil, fmt.Errorf("error getting pubkeys of dashboard validators: %w", err)
+	}
+	pubkeyByIndex := make(map[uint64]string, len(pubkeys))
+	for _, p := range pubkeys {
+		pubkeyByIndex[p.Index] = p.Pubkey
+	}
+
+	for _, v := range validators {
+		pubkey, ok := pubkeyByIndex[v.ValidatorIndex]
+		if !ok {
+			continue
+		}
+		filter := types.DashboardEventFilter(types.ValidatorDashboardEventPrefix, v.DashboardId, v.GroupId)
+		result[filter] = append(result[filter], pubkey)
+	}
+	return result, nil
+}
+
 func GetUserPushTokenByIds(ids []uint64) (map[uint64][]string, error) {
 	pushByID := map[uint64][]string{}
 	if len(ids) == 0 {
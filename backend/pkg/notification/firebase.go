@@ -24,14 +24,7 @@ func isRelevantError(response *messaging.SendResponse) bool {
 	return false
 }
 
-func SendPushBatch(messages []*messaging.Message) error {
-	credentialsPath := utils.Config.Notifications.FirebaseCredentialsPath
-	if credentialsPath == "" {
-		log.Error(nil, "firebase credentials path not provided, disabling push notifications", 0)
-		return nil
-	}
-
-	ctx := context.Background()
+func newMessagingClient(ctx context.Context, credentialsPath string) (*messaging.Client, error) {
 	var opt option.ClientOption
 
 	if strings.Contains(credentialsPath, ".json") && len(credentialsPath) < 200 {
@@ -40,16 +33,31 @@ func SendPushBatch(messages []*messaging.Message) error {
 		opt = option.WithCredentialsJSON([]byte(credentialsPath))
 	}
 
-	app, err := firebase.NewApp(context.Background(), nil, opt)
+	app, err := firebase.NewApp(ctx, nil, opt)
 	if err != nil {
 		log.Error(nil, "error initializing app", 0)
-		return err
+		return nil, err
 	}
 
 	client, err := app.Messaging(ctx)
 	if err != nil {
 		log.Error(nil, "error initializing messaging", 0)
-		return err
+		return nil, err
+	}
+	return client, nil
+}
+
+// SendPushBatch sends the messages, retrying relevant failures, and returns the number of delivered messages
+func SendPushBatch(ctx context.Context, messages []*messaging.Message) (int, error) {
+	credentialsPath := utils.Config.Notifications.FirebaseCredentialsPath
+	if credentialsPath == "" {
+		log.Error(nil, "firebase credentials path not provided, disabling push notifications", 0)
+		return 0, nil
+	}
+
+	client, err := newMessagingClient(ctx, credentialsPath)
+	if err != nil {
+		return 0, err
 	}
 
 	var waitBeforeTryInSeconds = []time.Duration{0 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second}
@@ -59,13 +67,17 @@ func SendPushBatch(messages []*messaging.Message) error {
 	currentMessages := messages
 	tries := 0
 	for _, s := range waitBeforeTryInSeconds {
-		time.Sleep(s)
+		select {
+		case <-ctx.Done():
+			return resultSuccessCount, ctx.Err()
+		case <-time.After(s):
+		}
 		tries++
 
-		result, err = client.SendAll(context.Background(), currentMessages)
+		result, err = client.SendAll(ctx, currentMessages)
 		if err != nil {
 			log.Error(nil, "error sending push notifications", 0)
-			return err
+			return resultSuccessCount, err
 		}
 
 		resultSuccessCount += result.SuccessCount
@@ -97,5 +109,5 @@ func SendPushBatch(messages []*messaging.Message) error {
 	}
 
 	log.Infof("sent %d firebase notifications in %d of %d tries. successful: %d | failed: %d", len(messages), tries, len(waitBeforeTryInSeconds), resultSuccessCount, resultFailureCount)
-	return nil
+	return resultSuccessCount, nil
 }
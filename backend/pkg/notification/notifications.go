@@ -3,6 +3,7 @@ package notification
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
 	"crypto/sha256"
 	"database/sql"
 	"database/sql/driver"
@@ -37,6 +38,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -288,6 +290,13 @@ func collectNotifications(epoch uint64) (map[uint64]map[types.EventName][]types.
 	}
 	log.Infof("collecting validator got slashed notifications took: %v", time.Since(start))
 
+	err = collectValidatorDidSlashNotifications(notificationsByUserID, epoch)
+	if err != nil {
+		metrics.Errors.WithLabelValues("notifications_collect_validator_did_slash").Inc()
+		return nil, fmt.Errorf("error collecting validator_did_slash notifications: %v", err)
+	}
+	log.Infof("collecting validator did slash notifications took: %v", time.Since(start))
+
 	err = collectWithdrawalNotifications(notificationsByUserID, epoch)
 	if err != nil {
 		metrics.Errors.WithLabelValues("notifications_collect_validator_withdrawal").Inc()
@@ -416,7 +425,8 @@ func queueNotifications(notificationsByUserID map[uint64]map[types.EventName][]t
 			for _, ev := range events {
 				isDuplicate := false
 				for _, fe := range filteredEvents {
-					if fe.GetSubscriptionID() == ev.GetSubscriptionID() {
+					// dashboard subscriptions share one subscription id for all validators of a group
+					if fe.GetSubscriptionID() == ev.GetSubscriptionID() && fe.GetEventFilter() == ev.GetEventFilter() {
 						isDuplicate = true
 					}
 				}
@@ -428,17 +438,29 @@ func queueNotifications(notificationsByUserID map[uint64]map[types.EventName][]t
 		}
 	}
 
-	err := queueEmailNotifications(notificationsByUserID, useDB)
+	err := saveNotificationHistory(notificationsByUserID, useDB)
+	if err != nil {
+		log.Error(err, "error saving notification history", 0)
+	}
+
+	// notifications of users in do-not-disturb mode are only recorded in the history
+	dispatchableNotificationsByUserID, err := filterDoNotDisturbUsers(notificationsByUserID, useDB)
+	if err != nil {
+		log.Error(err, "error filtering do not disturb users", 0)
+		dispatchableNotificationsByUserID = notificationsByUserID
+	}
+
+	err = queueEmailNotifications(dispatchableNotificationsByUserID, useDB)
 	if err != nil {
 		log.Error(err, "error queuing email notifications", 0)
 	}
 
-	err = queuePushNotification(notificationsByUserID, useDB)
+	err = queuePushNotification(dispatchableNotificationsByUserID, useDB)
 	if err != nil {
 		log.Error(err, "error queuing push notifications", 0)
 	}
 
-	err = queueWebhookNotifications(notificationsByUserID, useDB)
+	err = queueWebhookNotifications(dispatchableNotificationsByUserID, useDB)
 	if err != nil {
 		log.Error(err, "error queuing webhook notifications", 0)
 	}
@@ -495,6 +517,115 @@ func queueNotifications(notificationsByUserID map[uint64]map[types.EventName][]t
 	}
 }
 
+// saveNotificationHistory persists all collected notifications so users can browse them after the queue has been cleaned up
+func saveNotificationHistory(notificationsByUserID map[uint64]map[types.EventName][]types.Notification, useDB *sqlx.DB) error {
+	subIds := make([]int64, 0)
+	for _, events := range notificationsByUserID {
+		for _, notifications := range events {
+			for _, n := range notifications {
+				subIds = append(subIds, int64(n.GetSubscriptionID()))
+			}
+		}
+	}
+	if len(subIds) == 0 {
+		return nil
+	}
+
+	var subs []struct {
+		Id             uint64  `db:"id"`
+		EventFilter    string  `db:"event_filter"`
+		EventThreshold float64 `db:"event_threshold"`
+	}
+	err := useDB.Select(&subs, `SELECT id, event_filter, COALESCE(event_threshold, 0) AS event_threshold FROM users_subscriptions WHERE id = ANY($1)`, pq.Int64Array(subIds))
+	if err != nil {
+		return fmt.Errorf("error getting subscriptions for notification history: %w", err)
+	}
+	subById := make(map[uint64]int, len(subs))
+	for i, sub := range subs {
+		subById[sub.Id] = i
+	}
+
+	tx, err := useDB.Beginx()
+	if err != nil {
+		return fmt.Errorf("error starting notification history transaction: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	stmt, err := tx.Preparex(`
+		INSERT INTO users_notifications_history
+			(user_id, subscription_id, epoch, event_name, event_filter, event_threshold, dashboard_id, group_id, is_account_dashboard, validator_index, slot, title, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`)
+	if err != nil {
+		return fmt.Errorf("error preparing notification history insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for userId, events := range notificationsByUserID {
+		for eventName, notifications := range events {
+			for _, n := range notifications {
+				var dashboardId, groupId, validatorIndex, slot sql.NullInt64
+				var threshold float64
+				isAccountDashboard := false
+				if i, ok := subById[n.GetSubscriptionID()]; ok {
+					threshold = subs[i].EventThreshold
+					if d, g, err := types.ParseDashboardEventFilter(subs[i].EventFilter); err == nil {
+						dashboardId = sql.NullInt64{Int64: int64(d), Valid: true}
+						groupId = sql.NullInt64{Int64: int64(g), Valid: true}
+						isAccountDashboard = strings.HasPrefix(subs[i].EventFilter, types.AccountDashboardEventPrefix)
+					}
+				}
+				switch v := n.(type) {
+				case *validatorProposalNotification:
+					validatorIndex = sql.NullInt64{Int64: int64(v.ValidatorIndex), Valid: true}
+					slot = sql.NullInt64{Int64: int64(v.Slot), Valid: true}
+				case *validatorIsOfflineNotification:
+					validatorIndex = sql.NullInt64{Int64: int64(v.ValidatorIndex), Valid: true}
+				case *validatorAttestationNotification:
+					validatorIndex = sql.NullInt64{Int64: int64(v.ValidatorIndex), Valid: true}
+				case *validatorGotSlashedNotification:
+					validatorIndex = sql.NullInt64{Int64: int64(v.ValidatorIndex), Valid: true}
+				case *validatorWithdrawalNotification:
+					validatorIndex = sql.NullInt64{Int64: int64(v.ValidatorIndex), Valid: true}
+					slot = sql.NullInt64{Int64: int64(v.Slot), Valid: true}
+				}
+
+				_, err = stmt.Exec(userId, n.GetSubscriptionID(), n.GetEpoch(), eventName, n.GetEventFilter(), threshold, dashboardId, groupId, isAccountDashboard, validatorIndex, slot, n.GetTitle(), n.GetInfo(false))
+				if err != nil {
+					return fmt.Errorf("error inserting notification history: %w", err)
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// filterDoNotDisturbUsers returns the notifications of all users that have not enabled the do-not-disturb mode
+func filterDoNotDisturbUsers(notificationsByUserID map[uint64]map[types.EventName][]types.Notification, useDB *sqlx.DB) (map[uint64]map[types.EventName][]types.Notification, error) {
+	userIds := make([]int64, 0, len(notificationsByUserID))
+	for userId := range notificationsByUserID {
+		userIds = append(userIds, int64(userId))
+	}
+
+	var doNotDisturbUserIds []uint64
+	err := useDB.Select(&doNotDisturbUserIds, `SELECT id FROM users WHERE id = ANY($1) AND notifications_do_not_disturb_ts > NOW()`, pq.Int64Array(userIds))
+	if err != nil {
+		return nil, err
+	}
+	if len(doNotDisturbUserIds) == 0 {
+		return notificationsByUserID, nil
+	}
+
+	result := make(map[uint64]map[types.EventName][]types.Notification, len(notificationsByUserID))
+	for userId, events := range notificationsByUserID {
+		result[userId] = events
+	}
+	for _, userId := range doNotDisturbUserIds {
+		delete(result, userId)
+	}
+	return result, nil
+}
+
 func dispatchNotifications(useDB *sqlx.DB) error {
 	err := sendEmailNotifications(useDB)
 	if err != nil {
@@ -521,7 +652,8 @@ func dispatchNotifications(useDB *sqlx.DB) error {
 
 // garbageCollectNotificationQueue deletes entries from the notification queue that have been processed
 func garbageCollectNotificationQueue(useDB *sqlx.DB) error {
-	rows, err := useDB.Exec(`DELETE FROM notification_queue WHERE (sent < now() - INTERVAL '30 minutes') OR (created < now() - INTERVAL '1 hour')`)
+	// sent items are kept for a day as they are used for the per user channel statistics
+	rows, err := useDB.Exec(`DELETE FROM notification_queue WHERE (sent < now() - INTERVAL '24 hours') OR (sent IS NULL AND created < now() - INTERVAL '1 hour')`)
 	if err != nil {
 		return fmt.Errorf("error deleting from notification_queue %w", err)
 	}
@@ -530,6 +662,16 @@ func garbageCollectNotificationQueue(useDB *sqlx.DB) error {
 
 	log.Infof("deleted %v rows from the notification_queue", rowsAffected)
 
+	// the webhook delivery log is kept for a week so users can debug their endpoints
+	rows, err = useDB.Exec(`DELETE FROM users_webhooks_deliveries WHERE created_ts < now() - INTERVAL '7 days'`)
+	if err != nil {
+		return fmt.Errorf("error deleting from users_webhooks_deliveries %w", err)
+	}
+
+	rowsAffected, _ = rows.RowsAffected()
+
+	log.Infof("deleted %v rows from the users_webhooks_deliveries", rowsAffected)
+
 	return nil
 }
 
@@ -559,7 +701,7 @@ func queuePushNotification(notificationsByUserID map[uint64]map[types.EventName]
 			continue
 		}
 
-		go func(userTokens []string, userNotifications map[types.EventName][]types.Notification) {
+		go func(userID uint64, userTokens []string, userNotifications map[types.EventName][]types.Notification) {
 			var batch []*messaging.Message
 			for event, ns := range userNotifications {
 				for _, n := range ns {
@@ -594,12 +736,12 @@ func queuePushNotification(notificationsByUserID map[uint64]map[types.EventName]
 				Messages: batch,
 			}
 
-			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content) VALUES ($1, 'push', $2)`, time.Now(), transitPushContent)
+			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content, user_id) VALUES ($1, 'push', $2, $3)`, time.Now(), transitPushContent, userID)
 			if err != nil {
 				log.Error(err, "error writing transit push notification to db", 0)
 				return
 			}
-		}(userTokens, userNotifications)
+		}(userID, userTokens, userNotifications)
 	}
 	return nil
 }
@@ -629,7 +771,7 @@ func sendPushNotifications(useDB *sqlx.DB) error {
 				end = len(n.Content.Messages)
 			}
 
-			err = SendPushBatch(n.Content.Messages[start:end])
+			_, err = SendPushBatch(context.Background(), n.Content.Messages[start:end])
 			if err != nil {
 				metrics.Errors.WithLabelValues("notifications_send_push_batch").Inc()
 				log.Error(err, "error sending firebase batch job", 0)
@@ -665,7 +807,7 @@ func queueEmailNotifications(notificationsByUserID map[uint64]map[types.EventNam
 			// metrics.Errors.WithLabelValues("notifications_mail_not_found").Inc()
 			continue
 		}
-		go func(userEmail string, userNotifications map[types.EventName][]types.Notification) {
+		go func(userID uint64, userEmail string, userNotifications map[types.EventName][]types.Notification) {
 			attachments := []types.EmailAttachment{}
 
 			var msg types.Email
@@ -798,11 +940,11 @@ func queueEmailNotifications(notificationsByUserID map[uint64]map[types.EventNam
 				Attachments: attachments,
 			}
 
-			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content) VALUES ($1, 'email', $2)`, time.Now(), transitEmailContent)
+			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content, user_id) VALUES ($1, 'email', $2, $3)`, time.Now(), transitEmailContent, userID)
 			if err != nil {
 				log.Error(err, "error writing transit email to db", 0)
 			}
-		}(userEmail, userNotifications)
+		}(userID, userEmail, userNotifications)
 	}
 	return nil
 }
@@ -842,6 +984,10 @@ func sendEmailNotifications(useDb *sqlx.DB) error {
 }
 
 func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventName][]types.Notification, useDB *sqlx.DB) error {
+	eventFilterBySubId, err := getSubscriptionEventFilters(notificationsByUserID, useDB)
+	if err != nil {
+		return err
+	}
 	for userID, userNotifications := range notificationsByUserID {
 		var webhooks []types.UserWebhook
 		err := useDB.Select(&webhooks, `
@@ -851,7 +997,8 @@ func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventN
 				url,
 				retries,
 				event_names,
-				destination
+				destination,
+				event_filter
 			FROM
 				users_webhooks
 			WHERE
@@ -899,6 +1046,10 @@ func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventN
 					}
 
 					for _, n := range notifications {
+						// webhooks of a dashboard group only receive the notifications of that group
+						if w.EventFilter.Valid && eventFilterBySubId[n.GetSubscriptionID()] != w.EventFilter.String {
+							continue
+						}
 						if w.Destination.Valid && w.Destination.String == "webhook_discord" {
 							if _, exists := discordNotifMap[w.ID]; !exists {
 								discordNotifMap[w.ID] = make([]types.TransitDiscordContent, 0)
@@ -959,7 +1110,7 @@ func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventN
 		}
 		// process notifs
 		for _, n := range notifs {
-			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content) VALUES (now(), $1, $2);`, n.Channel, n.Content)
+			_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content, user_id) VALUES (now(), $1, $2, $3);`, n.Channel, n.Content, userID)
 			if err != nil {
 				log.Error(err, "error inserting into webhooks_queue", 0)
 			} else {
@@ -969,7 +1120,7 @@ func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventN
 		// process discord notifs
 		for _, dNotifs := range discordNotifMap {
 			for _, n := range dNotifs {
-				_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content) VALUES (now(), 'webhook_discord', $1);`, n)
+				_, err = useDB.Exec(`INSERT INTO notification_queue (created, channel, content, user_id) VALUES (now(), 'webhook_discord', $1, $2);`, n, userID)
 				if err != nil {
 					log.Error(err, "error inserting into webhooks_queue (discord)", 0)
 					continue
@@ -982,96 +1133,220 @@ func queueWebhookNotifications(notificationsByUserID map[uint64]map[types.EventN
 	return nil
 }
 
+// getSubscriptionEventFilters returns the event filter of the subscriptions the notifications were created for.
+// Dashboard subscriptions are expanded to their validators, so this is the only way to map a notification back to its dashboard group.
+func getSubscriptionEventFilters(notificationsByUserID map[uint64]map[types.EventName][]types.Notification, useDB *sqlx.DB) (map[uint64]string, error) {
+	subIds := make([]int64, 0)
+	for _, events := range notificationsByUserID {
+		for _, notifications := range events {
+			for _, n := range notifications {
+				subIds = append(subIds, int64(n.GetSubscriptionID()))
+			}
+		}
+	}
+	result := make(map[uint64]string, len(subIds))
+	if len(subIds) == 0 {
+		return result, nil
+	}
+
+	var subs []struct {
+		Id          uint64 `db:"id"`
+		EventFilter string `db:"event_filter"`
+	}
+	err := useDB.Select(&subs, `SELECT id, event_filter FROM users_subscriptions WHERE id = ANY($1)`, pq.Int64Array(subIds))
+	if err != nil {
+		return nil, fmt.Errorf("error getting subscription event filters: %w", err)
+	}
+	for _, sub := range subs {
+		result[sub.Id] = sub.EventFilter
+	}
+	return result, nil
+}
+
+// webhook requests are retried with an exponential backoff (webhookRetryBaseDelay * 2^(attempt-1)) until webhookMaxAttempts is reached
+const (
+	webhookMaxAttempts          = 6
+	webhookRetryBaseDelay       = time.Second * 15
+	webhookSendParallelism      = 10
+	webhookResponseExcerptLimit = 1024
+
+	WebhookSignatureHeader = "X-Beaconchain-Signature"
+	WebhookTimestampHeader = "X-Beaconchain-Timestamp"
+)
+
+// webhookRetryDelay returns the delay before the next delivery attempt after the given (1-based) attempt failed
+func webhookRetryDelay(attempt uint64) time.Duration {
+	if attempt == 0 {
+		attempt = 1
+	}
+	return webhookRetryBaseDelay * time.Duration(1<<(attempt-1))
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" using the secret of the webhook.
+// Receivers can verify a request by computing the same signature from the WebhookTimestampHeader and the raw request body.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getWebhookSecret returns the signing secret of a webhook, webhooks created before signing was introduced get one assigned
+func getWebhookSecret(useDB *sqlx.DB, n types.TransitWebhook) (string, error) {
+	if n.Secret.Valid && n.Secret.String != "" {
+		return n.Secret.String, nil
+	}
+	secret, err := utils.GenerateWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+	// another sender could have assigned a secret in the meantime, always use the stored one
+	err = useDB.Get(&secret, `UPDATE users_webhooks SET secret = COALESCE(secret, $2) WHERE id = $1 RETURNING secret`, n.Content.Webhook.ID, secret)
+	if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
 func sendWebhookNotifications(useDB *sqlx.DB) error {
 	var notificationQueueItem []types.TransitWebhook
 
 	err := useDB.Select(&notificationQueueItem, `SELECT
-		id,
-		created,
-		sent,
-		channel,
-		content
-	FROM notification_queue WHERE sent IS null AND channel = 'webhook' ORDER BY created ASC`)
+		q.id,
+		q.created,
+		q.sent,
+		q.channel,
+		q.content,
+		q.attempts,
+		COALESCE(q.user_id, w.user_id) AS user_id,
+		w.secret
+	FROM notification_queue q
+	LEFT JOIN users_webhooks w ON w.id = (q.content->'Webhook'->>'id')::INT
+	WHERE q.sent IS null AND q.channel = 'webhook' AND (q.next_attempt_ts IS NULL OR q.next_attempt_ts <= now())
+	ORDER BY q.created ASC`)
 	if err != nil {
 		return fmt.Errorf("error querying notification queue, err: %w", err)
 	}
-	client := &http.Client{Timeout: time.Second * 30}
+	client := NewWebhookClient(time.Second * 30)
 
 	log.Infof("processing %v webhook notifications", len(notificationQueueItem))
 
+	g := errgroup.Group{}
+	g.SetLimit(webhookSendParallelism)
 	for _, n := range notificationQueueItem {
-		// do not retry after 5 attempts
-		if n.Content.Webhook.Retries > 5 {
-			_, err := db.FrontendWriterDB.Exec(`DELETE FROM notification_queue WHERE id = $1`, n.Id)
+		// do not retry after the max attempts have been reached
+		if n.Attempts >= webhookMaxAttempts {
+			_, err := useDB.Exec(`DELETE FROM notification_queue WHERE id = $1`, n.Id)
 			if err != nil {
 				return fmt.Errorf("error deleting from notification queue: %w", err)
 			}
 			continue
 		}
 
-		reqBody := new(bytes.Buffer)
-
-		err := json.NewEncoder(reqBody).Encode(n.Content)
-		if err != nil {
-			log.Error(err, "error marshalling webhook event", 0)
-		}
-
 		_, err = url.Parse(n.Content.Webhook.Url)
 		if err != nil {
-			_, err := db.FrontendWriterDB.Exec(`DELETE FROM notification_queue WHERE id = $1`, n.Id)
+			_, err := useDB.Exec(`DELETE FROM notification_queue WHERE id = $1`, n.Id)
 			if err != nil {
 				return fmt.Errorf("error deleting from notification queue: %w", err)
 			}
 			continue
 		}
 
-		go func(n types.TransitWebhook) {
-			if n.Content.Webhook.Retries > 0 {
-				time.Sleep(time.Duration(n.Content.Webhook.Retries) * time.Second)
-			}
-			resp, err := client.Post(n.Content.Webhook.Url, "application/json", reqBody)
-			if err != nil {
-				log.Error(err, "error sending webhook request", 0)
-				return
-			} else {
-				metrics.NotificationsSent.WithLabelValues("webhook", resp.Status).Inc()
-			}
-			defer resp.Body.Close()
+		g.Go(func() error {
+			sendWebhookNotification(useDB, client, n)
+			return nil
+		})
+	}
+	_ = g.Wait() // errors are logged and persisted per delivery attempt
 
-			_, err = useDB.Exec(`UPDATE notification_queue SET sent = now() WHERE id = $1`, n.Id)
-			if err != nil {
-				log.Error(err, "error updating notification_queue table", 0)
-				return
-			}
+	return nil
+}
 
-			if resp != nil && resp.StatusCode < 400 {
-				_, err = useDB.Exec(`UPDATE users_webhooks SET retries = 0, last_sent = now() WHERE id = $1;`, n.Content.Webhook.ID)
-				if err != nil {
-					log.Error(err, "error updating users_webhooks table", 0)
-					return
-				}
-			} else {
-				var errResp types.ErrorResponse
+// sendWebhookNotification performs a single delivery attempt, logs it and schedules the next attempt if it failed
+func sendWebhookNotification(useDB *sqlx.DB, client *http.Client, n types.TransitWebhook) {
+	attempt := n.Attempts + 1
 
-				if resp != nil {
-					b, err := io.ReadAll(resp.Body)
-					if err != nil {
-						log.Error(err, "error reading body", 0)
-					}
+	reqBody, err := json.Marshal(n.Content)
+	if err != nil {
+		log.Error(err, "error marshalling webhook event", 0)
+		return
+	}
 
-					errResp.Status = resp.Status
-					errResp.Body = string(b)
-				}
+	secret, err := getWebhookSecret(useDB, n)
+	if err != nil {
+		log.Error(err, "error getting webhook secret", 0, map[string]interface{}{"webhook_id": n.Content.Webhook.ID})
+		return
+	}
 
-				_, err = useDB.Exec(`UPDATE users_webhooks SET retries = retries + 1, last_sent = now(), request = $2, response = $3 WHERE id = $1;`, n.Content.Webhook.ID, n.Content, errResp)
-				if err != nil {
-					log.Error(err, "error updating users_webhooks table", 0)
-					return
-				}
+	var statusCode sql.NullInt64
+	var responseExcerpt, deliveryErr sql.NullString
+	var errResp types.ErrorResponse
+	start := time.Now()
+
+	req, err := http.NewRequest(http.MethodPost, n.Content.Webhook.Url, bytes.NewReader(reqBody))
+	if err == nil {
+		timestamp := time.Now().Unix()
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(secret, timestamp, reqBody))
+
+		var resp *http.Response
+		resp, err = client.Do(req)
+		if err == nil {
+			defer resp.Body.Close()
+			metrics.NotificationsSent.WithLabelValues("webhook", resp.Status).Inc()
+
+			statusCode = sql.NullInt64{Int64: int64(resp.StatusCode), Valid: true}
+			b, readErr := io.ReadAll(io.LimitReader(resp.Body, webhookResponseExcerptLimit))
+			if readErr != nil {
+				log.Error(readErr, "error reading body", 0)
 			}
-		}(n)
+			responseExcerpt = sql.NullString{String: strings.ToValidUTF8(string(b), ""), Valid: len(b) > 0}
+			errResp.Status = resp.Status
+			errResp.Body = responseExcerpt.String
+		}
+	}
+	latency := time.Since(start)
+	if err != nil {
+		log.Error(err, "error sending webhook request", 0)
+		deliveryErr = sql.NullString{String: err.Error(), Valid: true}
+		errResp.Body = err.Error()
+	}
+	success := statusCode.Valid && statusCode.Int64 < 400
+
+	_, err = useDB.Exec(`
+		INSERT INTO users_webhooks_deliveries (user_id, webhook_id, queue_id, url, event_name, attempt, status_code, latency_ms, response_excerpt, error, success)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		n.UserId, n.Content.Webhook.ID, n.Id, n.Content.Webhook.Url, n.Content.Event.Name, attempt, statusCode, latency.Milliseconds(), responseExcerpt, deliveryErr, success)
+	if err != nil {
+		log.Error(err, "error inserting into users_webhooks_deliveries table", 0)
+	}
+
+	if success {
+		_, err = useDB.Exec(`UPDATE notification_queue SET sent = now(), attempts = $2 WHERE id = $1`, n.Id, attempt)
+		if err != nil {
+			log.Error(err, "error updating notification_queue table", 0)
+			return
+		}
+		_, err = useDB.Exec(`UPDATE users_webhooks SET retries = 0, last_sent = now() WHERE id = $1;`, n.Content.Webhook.ID)
+		if err != nil {
+			log.Error(err, "error updating users_webhooks table", 0)
+		}
+		return
+	}
+
+	// persist the next attempt so retries survive restarts and do not block the sender
+	nextAttempt := time.Now().Add(webhookRetryDelay(attempt))
+	_, err = useDB.Exec(`UPDATE notification_queue SET attempts = $2, next_attempt_ts = $3 WHERE id = $1`, n.Id, attempt, nextAttempt.UTC())
+	if err != nil {
+		log.Error(err, "error updating notification_queue table", 0)
+		return
+	}
+	_, err = useDB.Exec(`UPDATE users_webhooks SET retries = retries + 1, last_sent = now(), request = $2, response = $3 WHERE id = $1;`, n.Content.Webhook.ID, n.Content, errResp)
+	if err != nil {
+		log.Error(err, "error updating users_webhooks table", 0)
 	}
-	return nil
 }
 
 func sendDiscordNotifications(useDB *sqlx.DB) error {
@@ -1087,7 +1362,7 @@ func sendDiscordNotifications(useDB *sqlx.DB) error {
 	if err != nil {
 		return fmt.Errorf("error querying notification queue, err: %w", err)
 	}
-	client := &http.Client{Timeout: time.Second * 30}
+	client := NewWebhookClient(time.Second * 30)
 
 	log.Infof("processing %v discord webhook notifications", len(notificationQueueItem))
 	webhookMap := make(map[uint64]types.UserWebhook)
@@ -1485,7 +1760,7 @@ func collectAttestationAndOfflineValidatorNotifications(notificationsByUserID ma
 			}
 			isDuplicate := false
 			for _, userEvent := range notificationsByUserID[*sub.UserID][n.GetEventName()] {
-				if userEvent.GetSubscriptionID() == n.SubscriptionID {
+				if userEvent.GetSubscriptionID() == n.SubscriptionID && userEvent.GetEventFilter() == n.GetEventFilter() {
 					isDuplicate = true
 				}
 			}
@@ -1605,7 +1880,7 @@ func collectAttestationAndOfflineValidatorNotifications(notificationsByUserID ma
 			}
 			isDuplicate := false
 			for _, userEvent := range notificationsByUserID[*sub.UserID][n.GetEventName()] {
-				if userEvent.GetSubscriptionID() == n.SubscriptionID {
+				if userEvent.GetSubscriptionID() == n.SubscriptionID && userEvent.GetEventFilter() == n.GetEventFilter() {
 					isDuplicate = true
 					break
 				}
@@ -1664,7 +1939,7 @@ func collectAttestationAndOfflineValidatorNotifications(notificationsByUserID ma
 			}
 			isDuplicate := false
 			for _, userEvent := range notificationsByUserID[*sub.UserID][n.GetEventName()] {
-				if userEvent.GetSubscriptionID() == n.SubscriptionID {
+				if userEvent.GetSubscriptionID() == n.SubscriptionID && userEvent.GetEventFilter() == n.GetEventFilter() {
 					isDuplicate = true
 					break
 				}
@@ -1903,58 +2178,141 @@ func collectValidatorGotSlashedNotifications(notificationsByUserID map[uint64]ma
 	if err != nil {
 		return fmt.Errorf("error getting slashed validators from database, err: %w", err)
 	}
-	query := ""
-	resultsLen := len(dbResult)
-	for i, event := range dbResult {
-		query += fmt.Sprintf(`SELECT %d AS ref, id, user_id, ENCODE(unsubscribe_hash, 'hex') AS unsubscribe_hash from users_subscriptions where event_name = $1 AND event_filter = '%x'`, i, event.SlashedValidatorPubkey)
-		if i < resultsLen-1 {
-			query += " UNION "
+	_, subMap, err := GetSubsForEventFilter(types.ValidatorGotSlashedEventName)
+	if err != nil {
+		return fmt.Errorf("error getting subscriptions for slashed validators %w", err)
+	}
+
+	for _, event := range dbResult {
+		subscribers, ok := subMap[hex.EncodeToString(event.SlashedValidatorPubkey)]
+		if !ok {
+			continue
+		}
+
+		log.Infof("creating %v notification for validator %v in epoch %v", event.SlashedValidatorPubkey, event.Reason, epoch)
+
+		for _, sub := range subscribers {
+			n := &validatorGotSlashedNotification{
+				SubscriptionID:  *sub.ID,
+				Slasher:         event.SlasherIndex,
+				Epoch:           event.Epoch,
+				Reason:          event.Reason,
+				ValidatorIndex:  event.SlashedValidatorIndex,
+				EventFilter:     hex.EncodeToString(event.SlashedValidatorPubkey),
+				UnsubscribeHash: sub.UnsubscribeHash,
+			}
+
+			if _, exists := notificationsByUserID[*sub.UserID]; !exists {
+				notificationsByUserID[*sub.UserID] = map[types.EventName][]types.Notification{}
+			}
+			if _, exists := notificationsByUserID[*sub.UserID][n.GetEventName()]; !exists {
+				notificationsByUserID[*sub.UserID][n.GetEventName()] = []types.Notification{}
+			}
+			notificationsByUserID[*sub.UserID][n.GetEventName()] = append(notificationsByUserID[*sub.UserID][n.GetEventName()], n)
+			metrics.NotificationsCollected.WithLabelValues(string(n.GetEventName())).Inc()
 		}
 	}
 
-	if query == "" {
-		return nil
+	return nil
+}
+
+type validatorDidSlashNotification struct {
+	SubscriptionID  uint64
+	ValidatorIndex  uint64
+	Epoch           uint64
+	Slashed         uint64
+	Reason          string
+	EventFilter     string
+	UnsubscribeHash sql.NullString
+}
+
+func (n *validatorDidSlashNotification) GetLatestState() string {
+	return ""
+}
+
+func (n *validatorDidSlashNotification) GetUnsubscribeHash() string {
+	if n.UnsubscribeHash.Valid {
+		return n.UnsubscribeHash.String
 	}
+	return ""
+}
 
-	var subscribers []struct {
-		Ref             uint64         `db:"ref"`
-		Id              uint64         `db:"id"`
-		UserId          uint64         `db:"user_id"`
-		UnsubscribeHash sql.NullString `db:"unsubscribe_hash"`
+func (n *validatorDidSlashNotification) GetEmailAttachment() *types.EmailAttachment {
+	return nil
+}
+
+func (n *validatorDidSlashNotification) GetSubscriptionID() uint64 {
+	return n.SubscriptionID
+}
+
+func (n *validatorDidSlashNotification) GetEpoch() uint64 {
+	return n.Epoch
+}
+
+func (n *validatorDidSlashNotification) GetEventName() types.EventName {
+	return types.ValidatorDidSlashEventName
+}
+
+func (n *validatorDidSlashNotification) GetInfo(includeUrl bool) string {
+	generalPart := fmt.Sprintf(`Validator %v has slashed validator %v at epoch %v for %s.`, n.ValidatorIndex, n.Slashed, n.Epoch, n.Reason)
+	if includeUrl {
+		return generalPart + getUrlPart(n.ValidatorIndex)
 	}
+	return generalPart
+}
 
-	name := string(types.ValidatorGotSlashedEventName)
-	if utils.Config.Chain.ClConfig.ConfigName != "" {
-		name = utils.Config.Chain.ClConfig.ConfigName + ":" + name
+func (n *validatorDidSlashNotification) GetTitle() string {
+	return "Validator has Slashed"
+}
+
+func (n *validatorDidSlashNotification) GetEventFilter() string {
+	return n.EventFilter
+}
+
+func (n *validatorDidSlashNotification) GetInfoMarkdown() string {
+	generalPart := fmt.Sprintf(`Validator [%[1]v](https://%[5]v/validator/%[1]v) has slashed validator [%[2]v](https://%[5]v/validator/%[2]v) at epoch [%[3]v](https://%[5]v/epoch/%[3]v) for %[4]s.`, n.ValidatorIndex, n.Slashed, n.Epoch, n.Reason, utils.Config.Frontend.SiteDomain)
+	return generalPart
+}
+
+// collectValidatorDidSlashNotifications notifies the subscribers of the slasher, i.e. the proposer that included the slashing and received the whistleblower reward
+func collectValidatorDidSlashNotifications(notificationsByUserID map[uint64]map[types.EventName][]types.Notification, epoch uint64) error {
+	dbResult, err := db.GetValidatorsGotSlashed(epoch)
+	if err != nil {
+		return fmt.Errorf("error getting slashed validators from database, err: %w", err)
 	}
-	err = db.FrontendWriterDB.Select(&subscribers, query, name)
+	_, subMap, err := GetSubsForEventFilter(types.ValidatorDidSlashEventName)
 	if err != nil {
-		return fmt.Errorf("error querying subscribers, err: %w", err)
+		return fmt.Errorf("error getting subscriptions for slashing validators %w", err)
 	}
 
-	for _, sub := range subscribers {
-		event := dbResult[sub.Ref]
+	for _, event := range dbResult {
+		subscribers, ok := subMap[hex.EncodeToString(event.SlasherPubkey)]
+		if !ok {
+			continue
+		}
 
-		log.Infof("creating %v notification for validator %v in epoch %v", event.SlashedValidatorPubkey, event.Reason, epoch)
+		log.Infof("creating %v notification for slasher %v in epoch %v", event.Reason, event.SlasherIndex, epoch)
 
-		n := &validatorGotSlashedNotification{
-			SubscriptionID:  sub.Id,
-			Slasher:         event.SlasherIndex,
-			Epoch:           event.Epoch,
-			Reason:          event.Reason,
-			ValidatorIndex:  event.SlashedValidatorIndex,
-			EventFilter:     hex.EncodeToString(event.SlashedValidatorPubkey),
-			UnsubscribeHash: sub.UnsubscribeHash,
-		}
+		for _, sub := range subscribers {
+			n := &validatorDidSlashNotification{
+				SubscriptionID:  *sub.ID,
+				ValidatorIndex:  event.SlasherIndex,
+				Epoch:           event.Epoch,
+				Slashed:         event.SlashedValidatorIndex,
+				Reason:          event.Reason,
+				EventFilter:     hex.EncodeToString(event.SlasherPubkey),
+				UnsubscribeHash: sub.UnsubscribeHash,
+			}
 
-		if _, exists := notificationsByUserID[sub.UserId]; !exists {
-			notificationsByUserID[sub.UserId] = map[types.EventName][]types.Notification{}
-		}
-		if _, exists := notificationsByUserID[sub.UserId][n.GetEventName()]; !exists {
-			notificationsByUserID[sub.UserId][n.GetEventName()] = []types.Notification{}
+			if _, exists := notificationsByUserID[*sub.UserID]; !exists {
+				notificationsByUserID[*sub.UserID] = map[types.EventName][]types.Notification{}
+			}
+			if _, exists := notificationsByUserID[*sub.UserID][n.GetEventName()]; !exists {
+				notificationsByUserID[*sub.UserID][n.GetEventName()] = []types.Notification{}
+			}
+			notificationsByUserID[*sub.UserID][n.GetEventName()] = append(notificationsByUserID[*sub.UserID][n.GetEventName()], n)
+			metrics.NotificationsCollected.WithLabelValues(string(n.GetEventName())).Inc()
 		}
-		notificationsByUserID[sub.UserId][n.GetEventName()] = append(notificationsByUserID[sub.UserId][n.GetEventName()], n)
-		metrics.NotificationsCollected.WithLabelValues(string(n.GetEventName())).Inc()
 	}
 
 	return nil
@@ -3136,26 +3494,33 @@ func collectSyncCommittee(notificationsByUserID map[uint64]map[types.EventName][
 		pubKeys = append(pubKeys, val.PubKey)
 	}
 
-	var dbResult []struct {
-		SubscriptionID  uint64         `db:"id"`
-		UserID          uint64         `db:"user_id"`
-		EventFilter     string         `db:"event_filter"`
-		UnsubscribeHash sql.NullString `db:"unsubscribe_hash"`
+	_, subMap, err := GetSubsForEventFilter(eventName)
+	if err != nil {
+		return fmt.Errorf("error getting subscriptions for sync committee %w", err)
 	}
 
-	err = db.FrontendWriterDB.Select(&dbResult, `
-				SELECT us.id, us.user_id, us.event_filter, ENCODE(us.unsubscribe_hash, 'hex') as unsubscribe_hash
-				FROM users_subscriptions AS us
-				WHERE us.event_name=$1 AND (us.last_sent_ts <= NOW() - INTERVAL '26 hours' OR us.last_sent_ts IS NULL) AND event_filter = ANY($2);
-				`,
-		utils.GetNetwork()+":"+string(eventName), pq.StringArray(pubKeys),
-	)
-
-	if err != nil {
-		return err
+	type dbResult struct {
+		SubscriptionID  uint64
+		UserID          uint64
+		EventFilter     string
+		UnsubscribeHash sql.NullString
+	}
+	results := make([]dbResult, 0)
+	for _, pubkey := range pubKeys {
+		for _, sub := range subMap[pubkey] {
+			if sub.LastSent != nil && sub.LastSent.After(time.Now().Add(-26*time.Hour)) {
+				continue
+			}
+			results = append(results, dbResult{
+				SubscriptionID:  *sub.ID,
+				UserID:          *sub.UserID,
+				EventFilter:     sub.EventFilter,
+				UnsubscribeHash: sub.UnsubscribeHash,
+			})
+		}
 	}
 
-	for _, r := range dbResult {
+	for _, r := range results {
 		n := &rocketpoolNotification{
 			SubscriptionID:  r.SubscriptionID,
 			UserID:          r.UserID,
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	body := []byte(`{"event":{"name":"test"}}`)
+	signature := SignWebhookPayload("secret", 1700000000, body)
+
+	// receivers compute the signature over "<timestamp>.<body>"
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), signature)
+
+	assert.Equal(t, signature, SignWebhookPayload("secret", 1700000000, body), "signing must be deterministic")
+	assert.NotEqual(t, signature, SignWebhookPayload("other secret", 1700000000, body))
+	assert.NotEqual(t, signature, SignWebhookPayload("secret", 1700000001, body))
+	assert.NotEqual(t, signature, SignWebhookPayload("secret", 1700000000, []byte(`{"event":{"name":"tampered"}}`)))
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, webhookRetryBaseDelay, webhookRetryDelay(0))
+	assert.Equal(t, webhookRetryBaseDelay, webhookRetryDelay(1))
+	assert.Equal(t, 2*webhookRetryBaseDelay, webhookRetryDelay(2))
+	assert.Equal(t, 4*webhookRetryBaseDelay, webhookRetryDelay(3))
+
+	var total time.Duration
+	for attempt := uint64(1); attempt < webhookMaxAttempts; attempt++ {
+		assert.Greater(t, webhookRetryDelay(attempt+1), webhookRetryDelay(attempt))
+		total += webhookRetryDelay(attempt)
+	}
+	assert.Less(t, total, time.Hour, "all retries should happen within an hour")
+}
+
+func TestIsWebhookAddressAllowed(t *testing.T) {
+	for addr, allowed := range map[string]bool{
+		"1.1.1.1":              true,
+		"2606:4700::1111":      true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.0.0.1":             false,
+		"172.16.5.4":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false, // cloud metadata
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::":                   false,
+		"fc00::1":              false,
+		"fe80::1":              false,
+		"::ffff:127.0.0.1":     false,
+		"::ffff:8.8.8.8":       true,
+		"64:ff9b::a00:1":       false,
+		"224.0.0.1":            false,
+		"ff02::1":              false,
+		"2001:4860:4860::8888": true,
+	} {
+		assert.Equal(t, allowed, isWebhookAddressAllowed(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestWebhookDialControl(t *testing.T) {
+	assert.NoError(t, webhookDialControl("tcp4", "1.1.1.1:443", nil))
+	assert.ErrorIs(t, webhookDialControl("tcp4", "127.0.0.1:443", nil), errWebhookAddressNotAllowed)
+	assert.ErrorIs(t, webhookDialControl("tcp6", "[::1]:80", nil), errWebhookAddressNotAllowed)
+	assert.ErrorIs(t, webhookDialControl("tcp", "localhost:80", nil), errWebhookAddressNotAllowed)
+}
@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"firebase.google.com/go/messaging"
+	"github.com/gobitfly/beaconchain/pkg/commons/mail"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+// test notifications let users verify their notification channels, they are sent synchronously and bypass the notification queue
+
+const (
+	testNotificationTitle = "Test notification"
+	testNotificationBody  = "This is a test notification. If you can read this, your notification channel is set up correctly."
+)
+
+// TestNotificationResult is the outcome of sending a test notification
+type TestNotificationResult struct {
+	Delivered     bool
+	StatusCode    int    // only set for webhooks
+	ProviderError string // error returned by the provider or the receiving endpoint
+}
+
+// SendTestEmail sends a sample notification email, it counts towards the daily mail limit of the address
+func SendTestEmail(to string) (*TestNotificationResult, error) {
+	msg := types.Email{
+		Title:                 fmt.Sprintf("%s%s", getNetwork(), testNotificationTitle),
+		Body:                  template.HTML(testNotificationBody),
+		SubscriptionManageURL: template.HTML(fmt.Sprintf(`<a href="https://%[1]s/notifications">https://%[1]s/notifications</a>`, utils.Config.Frontend.SiteDomain)),
+	}
+	err := mail.SendMailRateLimited(to, fmt.Sprintf("%s: %s", utils.Config.Frontend.SiteDomain, testNotificationTitle), msg, []types.EmailAttachment{})
+	if err != nil {
+		if _, ok := err.(*types.RateLimitError); ok {
+			return nil, err
+		}
+		return &TestNotificationResult{ProviderError: err.Error()}, nil
+	}
+	return &TestNotificationResult{Delivered: true}, nil
+}
+
+// SendTestPush sends a sample push notification to the given device tokens, it is delivered if at least one device accepted it
+func SendTestPush(ctx context.Context, tokens []string) (*TestNotificationResult, error) {
+	if len(tokens) == 0 {
+		return &TestNotificationResult{ProviderError: "no paired device with enabled notifications found"}, nil
+	}
+	if utils.Config.Notifications.FirebaseCredentialsPath == "" {
+		return nil, fmt.Errorf("firebase credentials path not provided, push notifications are disabled")
+	}
+
+	messages := make([]*messaging.Message, 0, len(tokens))
+	for _, token := range tokens {
+		message := new(messaging.Message)
+		message.Notification = &messaging.Notification{
+			Title: fmt.Sprintf("%s%s", getNetwork(), testNotificationTitle),
+			Body:  testNotificationBody,
+		}
+		message.Token = token
+
+		message.APNS = new(messaging.APNSConfig)
+		message.APNS.Payload = new(messaging.APNSPayload)
+		message.APNS.Payload.Aps = new(messaging.Aps)
+		message.APNS.Payload.Aps.Sound = "default"
+
+		messages = append(messages, message)
+	}
+
+	delivered, err := SendPushBatch(ctx, messages)
+	if err != nil {
+		return &TestNotificationResult{ProviderError: err.Error()}, nil
+	}
+	if delivered == 0 {
+		return &TestNotificationResult{ProviderError: "none of the paired devices accepted the notification"}, nil
+	}
+	return &TestNotificationResult{Delivered: true}, nil
+}
+
+// SendTestWebhook sends a sample event to the given url, either in the webhook format or as a discord message.
+// It uses the same client and signature headers as the notification senders, secret is the signing secret of the webhook.
+func SendTestWebhook(ctx context.Context, url, secret string, isDiscordWebhook bool) (*TestNotificationResult, error) {
+	var reqBody []byte
+	var err error
+	if isDiscordWebhook {
+		reqBody, err = json.Marshal(types.DiscordReq{
+			Username: utils.Config.Frontend.SiteDomain,
+			Embeds: []types.DiscordEmbed{{
+				Type:        "rich",
+				Color:       "16745472",
+				Title:       fmt.Sprintf("%s%s", getNetwork(), testNotificationTitle),
+				Description: testNotificationBody,
+			}},
+		})
+	} else {
+		reqBody, err = json.Marshal(types.TransitWebhookContent{
+			Webhook: types.UserWebhook{Url: url},
+			Event: types.WebhookEvent{
+				Network:     utils.GetNetwork(),
+				Name:        "test",
+				Title:       testNotificationTitle,
+				Description: testNotificationBody,
+			},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling test webhook event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return &TestNotificationResult{ProviderError: err.Error()}, nil
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if !isDiscordWebhook {
+		timestamp := time.Now().Unix()
+		req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(secret, timestamp, reqBody))
+	}
+
+	resp, err := NewWebhookClient(time.Second * 10).Do(req)
+	if err != nil {
+		if errors.Is(err, errWebhookAddressNotAllowed) {
+			return &TestNotificationResult{ProviderError: "webhook url resolves to a non-public address"}, nil
+		}
+		return &TestNotificationResult{ProviderError: "webhook endpoint could not be reached"}, nil
+	}
+	defer resp.Body.Close()
+
+	// the response body is not returned, it could leak content of the receiving endpoint
+	result := &TestNotificationResult{
+		Delivered:  resp.StatusCode < 400,
+		StatusCode: resp.StatusCode,
+	}
+	if !result.Delivered {
+		result.ProviderError = resp.Status
+	}
+	return result, nil
+}
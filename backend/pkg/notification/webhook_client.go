@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+var errWebhookAddressNotAllowed = errors.New("webhook address not allowed")
+
+// webhook urls are provided by users, so they must not be able to reach services on the internal network of the notifier
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier grade nat
+	netip.MustParsePrefix("192.0.0.0/24"),  // ietf protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // nat64, embeds ipv4 addresses
+}
+
+// isWebhookAddressAllowed returns false for loopback, private, link-local (incl. cloud metadata endpoints) and other non-public addresses
+func isWebhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// webhookDialControl is checked for every connection after the host has been resolved, so dns rebinding and redirects can't bypass it
+func webhookDialControl(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errWebhookAddressNotAllowed, address)
+	}
+	if !isWebhookAddressAllowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errWebhookAddressNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// NewWebhookClient returns the http client used to deliver webhooks to user provided urls
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: time.Second * 10,
+		Control: webhookDialControl,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// no proxy, the dialer has to see the actual destination
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: time.Second * 10,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     time.Second * 90,
+		},
+	}
+}
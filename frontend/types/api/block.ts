@@ -1,6 +1,6 @@
 // Code generated by tygo. DO NOT EDIT.
 /* eslint-disable */
-import type { ApiDataResponse, Hash, Address, ClElValue } from './common'
+import type { ApiDataResponse, Hash, Address, ClElValue, ApiPagingResponse } from './common'
 
 //////////
 // source: block.go
@@ -152,6 +152,11 @@ export interface BlockAttestationTableRow {
   signature: Hash;
 }
 export type InternalGetBlockAttestationsResponse = ApiDataResponse<BlockAttestationTableRow[]>;
+export interface NetworkAggregatedAttestationTableRow {
+  included_in_slot: number /* uint64 */;
+  attestation: BlockAttestationTableRow;
+}
+export type GetNetworkAggregatedAttestationsResponse = ApiPagingResponse<NetworkAggregatedAttestationTableRow>;
 export interface BlockWithdrawalTableRow {
   /**
    * no design present yet, TODO confirm this
@@ -186,3 +191,39 @@ export interface BlockBlobTableRow {
   data: string;
 }
 export type InternalGetBlockBlobsResponse = ApiDataResponse<BlockBlobTableRow[]>;
+/**
+ * ------------------------------------------------------------
+ * Slot and block listings
+ */
+export interface SlotTableRow {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  time: number /* int64 */;
+  status: 'proposed' | 'orphaned' | 'missed' | 'scheduled';
+  proposer: number /* uint64 */;
+  block_root?: Hash;
+  block?: number /* uint64 */;
+  graffiti?: string;
+  attestations: number /* uint64 */;
+  deposits: number /* uint64 */;
+  withdrawals: number /* uint64 */;
+  voluntary_exits: number /* uint64 */;
+  proposer_slashings: number /* uint64 */;
+  attester_slashings: number /* uint64 */;
+  sync_participation: number /* float64 */;
+}
+export type GetNetworkSlotsResponse = ApiPagingResponse<SlotTableRow>;
+export interface BlockTableRow {
+  block: number /* uint64 */;
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  time: number /* int64 */;
+  proposer: number /* uint64 */;
+  hash: Hash;
+  fee_recipient: Address;
+  transactions: number /* uint64 */;
+  gas_used: number /* uint64 */;
+  gas_limit: number /* uint64 */;
+  base_fee_per_gas: string /* decimal.Decimal */;
+}
+export type GetNetworkBlocksResponse = ApiPagingResponse<BlockTableRow>;
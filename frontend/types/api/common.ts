@@ -110,6 +110,7 @@ export interface ChartHistorySeconds {
   hourly: number /* uint64 */;
   daily: number /* uint64 */;
   weekly: number /* uint64 */;
+  monthly: number /* uint64 */;
 }
 export interface IndexBlocks {
   index: number /* uint64 */;
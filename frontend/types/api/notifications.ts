@@ -66,6 +66,7 @@ export interface NotificationValidatorDashboardDetail {
   proposal_done: IndexBlocks[];
   upcoming_proposals: number /* uint64 */[]; // slot numbers
   slashed: number /* uint64 */[]; // validator indices
+  has_slashed: number /* uint64 */[]; // validator indices
   sync_committee: number /* uint64 */[]; // validator indices
   attestation_missed: IndexBlocks[];
   withdrawal: IndexBlocks[];
@@ -205,6 +206,7 @@ export interface NotificationSettingsDashboardsTableRow {
   dashboard_id: number /* uint64 */;
   group_id: number /* uint64 */;
   group_name: string;
+  webhook_secret?: string; // used to sign the webhook requests of this group, empty until a webhook is set
   /**
    * if it's a validator dashboard, SubscribedEvents is NotificationSettingsAccountDashboard, otherwise NotificationSettingsValidatorDashboard
    */
@@ -212,3 +214,30 @@ export interface NotificationSettingsDashboardsTableRow {
   chain_ids: number /* uint64 */[];
 }
 export type InternalGetUserNotificationSettingsDashboardsResponse = ApiPagingResponse<NotificationSettingsDashboardsTableRow>;
+/**
+ * ------------------------------------------------------------
+ * Webhook Deliveries
+ */
+export interface NotificationWebhookDelivery {
+  id: number /* uint64 */;
+  timestamp: number /* int64 */;
+  webhook_url: string;
+  event_type: string;
+  attempt: number /* uint64 */;
+  status_code?: number /* uint64 */; // not set if no response was received
+  latency_ms: number /* uint64 */;
+  is_success: boolean;
+  response_excerpt?: string;
+  error?: string;
+}
+export type InternalGetUserNotificationWebhookDeliveriesResponse = ApiPagingResponse<NotificationWebhookDelivery>;
+/**
+ * ------------------------------------------------------------
+ * Test Notifications
+ */
+export interface NotificationTestResult {
+  is_delivered: boolean;
+  status_code?: number /* uint64 */; // only set for webhooks that responded
+  provider_error?: string;
+}
+export type InternalPostUserNotificationsTestResponse = ApiDataResponse<NotificationTestResult>;
@@ -106,6 +106,9 @@ export interface PremiumPerks {
   validator_dashboards: number /* uint64 */;
   validators_per_dashboard: number /* uint64 */;
   validator_groups_per_dashboard: number /* uint64 */;
+  account_dashboards: number /* uint64 */;
+  accounts_per_dashboard: number /* uint64 */;
+  account_groups_per_dashboard: number /* uint64 */;
   share_custom_dashboards: boolean;
   manage_dashboard_via_api: boolean;
   bulk_adding: boolean;
@@ -134,3 +137,65 @@ export interface OAuthAppData {
   RedirectURI: string;
   Active: boolean;
 }
+/**
+ * --------------------------------------
+ * API Keys
+ */
+export interface ApiKey {
+  id: number /* uint64 */;
+  name: string;
+  key: string;
+  scope: 'read' | 'write';
+  allowed_ips: string[]; // empty if the key can be used from any ip
+  created_at: number /* int64 */;
+  expires_at?: number /* int64 */;
+}
+export type InternalGetApiKeysResponse = ApiDataResponse<ApiKey[]>;
+export type InternalPostApiKeysResponse = ApiDataResponse<ApiKey>;
+export interface ApiKeyUsageDay {
+  timestamp: number /* int64 */;
+  requests: number /* uint64 */;
+}
+export interface ApiKeyEndpointUsage {
+  endpoint: string;
+  requests: number /* uint64 */;
+}
+export interface ApiKeyUsage {
+  total_requests: number /* uint64 */;
+  days: ApiKeyUsageDay[];
+  endpoints: ApiKeyEndpointUsage[];
+}
+export type InternalGetApiKeyUsageResponse = ApiDataResponse<ApiKeyUsage>;
+/**
+ * --------------------------------------
+ * OAuth
+ */
+export interface OAuthApp {
+  id: number /* uint64 */;
+  name: string;
+  client_id: string;
+  client_secret?: string; // only returned once, on registration
+  redirect_uri: string;
+  scopes: string[];
+  created_at: number /* int64 */;
+}
+export type InternalPostOauthAppsResponse = ApiDataResponse<OAuthApp>;
+export type InternalGetOauthAppsResponse = ApiDataResponse<OAuthApp[]>;
+export interface OAuthAuthorization {
+  redirect_uri: string;
+}
+export type InternalPostOauthAuthorizeResponse = ApiDataResponse<OAuthAuthorization>;
+/**
+ * OAuthTokenResponse and OAuthErrorResponse must conform to RFC 6749, so they aren't wrapped in ApiDataResponse
+ */
+export interface OAuthTokenResponse {
+  access_token: string;
+  token_type: string;
+  expires_in: number /* uint64 */;
+  refresh_token: string;
+  scope: string;
+}
+export interface OAuthErrorResponse {
+  error: string;
+  error_description?: string;
+}
@@ -175,14 +175,14 @@ export interface VDBHeatmapEvents {
 export interface VDBHeatmapCell {
   x: number /* int64 */; // Timestamp
   y: number /* uint64 */; // Group ID
-  value: number /* float64 */; // Attestaton Rewards
+  value: number /* float64 */; // Efficiency
   events?: VDBHeatmapEvents;
 }
 export interface VDBHeatmap {
   timestamps: number /* int64 */[]; // X-Axis Categories (unix timestamp)
   group_ids: number /* uint64 */[]; // Y-Axis Categories
   data: VDBHeatmapCell[];
-  aggregation: 'epoch' | 'hourly' | 'daily' | 'weekly';
+  aggregation: 'epoch' | 'hourly' | 'daily' | 'weekly' | 'monthly';
 }
 export type GetValidatorDashboardHeatmapResponse = ApiDataResponse<VDBHeatmap>;
 export interface VDBHeatmapTooltipData {
@@ -319,6 +319,7 @@ export interface VDBManageValidatorsTableRow {
   status: 'slashed' | 'exited' | 'deposited' | 'pending' | 'slashing_offline' | 'slashing_online' | 'exiting_offline' | 'exiting_online' | 'active_offline' | 'active_online';
   queue_position?: number /* uint64 */;
   withdrawal_credential: Hash;
+  withdrawal_address?: Address; // only set for execution layer withdrawal credentials
 }
 export type GetValidatorDashboardValidatorsResponse = ApiPagingResponse<VDBManageValidatorsTableRow>;
 /**